@@ -122,6 +122,100 @@ func (x *Dataset) GetPayload() []byte {
 	return nil
 }
 
+type HistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *HistoryEntry) Reset() {
+	*x = HistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEntry) ProtoMessage() {}
+
+func (x *HistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HistoryEntry) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Comment) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 type MutateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -135,7 +229,7 @@ type MutateRequest struct {
 func (x *MutateRequest) Reset() {
 	*x = MutateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[2]
+		mi := &file_biblio_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -148,7 +242,7 @@ func (x *MutateRequest) String() string {
 func (*MutateRequest) ProtoMessage() {}
 
 func (x *MutateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[2]
+	mi := &file_biblio_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +255,7 @@ func (x *MutateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MutateRequest.ProtoReflect.Descriptor instead.
 func (*MutateRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{2}
+	return file_biblio_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MutateRequest) GetId() string {
@@ -200,7 +294,7 @@ type MutateResponse struct {
 func (x *MutateResponse) Reset() {
 	*x = MutateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[3]
+		mi := &file_biblio_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -213,7 +307,7 @@ func (x *MutateResponse) String() string {
 func (*MutateResponse) ProtoMessage() {}
 
 func (x *MutateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[3]
+	mi := &file_biblio_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +320,7 @@ func (x *MutateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MutateResponse.ProtoReflect.Descriptor instead.
 func (*MutateResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{3}
+	return file_biblio_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *MutateResponse) GetResponse() isMutateResponse_Response {
@@ -277,7 +371,7 @@ type GetFileRequest struct {
 func (x *GetFileRequest) Reset() {
 	*x = GetFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[4]
+		mi := &file_biblio_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -290,7 +384,7 @@ func (x *GetFileRequest) String() string {
 func (*GetFileRequest) ProtoMessage() {}
 
 func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[4]
+	mi := &file_biblio_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +397,7 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{4}
+	return file_biblio_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetFileRequest) GetSha256() string {
@@ -324,7 +418,7 @@ type GetFileResponse struct {
 func (x *GetFileResponse) Reset() {
 	*x = GetFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[5]
+		mi := &file_biblio_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +431,7 @@ func (x *GetFileResponse) String() string {
 func (*GetFileResponse) ProtoMessage() {}
 
 func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[5]
+	mi := &file_biblio_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +444,7 @@ func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
 func (*GetFileResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{5}
+	return file_biblio_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetFileResponse) GetChunk() []byte {
@@ -371,7 +465,7 @@ type ExistsFileRequest struct {
 func (x *ExistsFileRequest) Reset() {
 	*x = ExistsFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[6]
+		mi := &file_biblio_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +478,7 @@ func (x *ExistsFileRequest) String() string {
 func (*ExistsFileRequest) ProtoMessage() {}
 
 func (x *ExistsFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[6]
+	mi := &file_biblio_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +491,7 @@ func (x *ExistsFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExistsFileRequest.ProtoReflect.Descriptor instead.
 func (*ExistsFileRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{6}
+	return file_biblio_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ExistsFileRequest) GetSha256() string {
@@ -418,7 +512,7 @@ type ExistsFileResponse struct {
 func (x *ExistsFileResponse) Reset() {
 	*x = ExistsFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[7]
+		mi := &file_biblio_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +525,7 @@ func (x *ExistsFileResponse) String() string {
 func (*ExistsFileResponse) ProtoMessage() {}
 
 func (x *ExistsFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[7]
+	mi := &file_biblio_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +538,7 @@ func (x *ExistsFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExistsFileResponse.ProtoReflect.Descriptor instead.
 func (*ExistsFileResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{7}
+	return file_biblio_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ExistsFileResponse) GetExists() bool {
@@ -465,7 +559,7 @@ type AddFileRequest struct {
 func (x *AddFileRequest) Reset() {
 	*x = AddFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[8]
+		mi := &file_biblio_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -478,7 +572,7 @@ func (x *AddFileRequest) String() string {
 func (*AddFileRequest) ProtoMessage() {}
 
 func (x *AddFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[8]
+	mi := &file_biblio_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +585,7 @@ func (x *AddFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileRequest.ProtoReflect.Descriptor instead.
 func (*AddFileRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{8}
+	return file_biblio_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AddFileRequest) GetChunk() []byte {
@@ -516,7 +610,7 @@ type AddFileResponse struct {
 func (x *AddFileResponse) Reset() {
 	*x = AddFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[9]
+		mi := &file_biblio_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -529,7 +623,7 @@ func (x *AddFileResponse) String() string {
 func (*AddFileResponse) ProtoMessage() {}
 
 func (x *AddFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[9]
+	mi := &file_biblio_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +636,7 @@ func (x *AddFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileResponse.ProtoReflect.Descriptor instead.
 func (*AddFileResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{9}
+	return file_biblio_proto_rawDescGZIP(), []int{11}
 }
 
 func (m *AddFileResponse) GetResponse() isAddFileResponse_Response {
@@ -593,7 +687,7 @@ type GetPublicationRequest struct {
 func (x *GetPublicationRequest) Reset() {
 	*x = GetPublicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[10]
+		mi := &file_biblio_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +700,7 @@ func (x *GetPublicationRequest) String() string {
 func (*GetPublicationRequest) ProtoMessage() {}
 
 func (x *GetPublicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[10]
+	mi := &file_biblio_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +713,7 @@ func (x *GetPublicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicationRequest.ProtoReflect.Descriptor instead.
 func (*GetPublicationRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{10}
+	return file_biblio_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPublicationRequest) GetId() string {
@@ -644,7 +738,7 @@ type GetPublicationResponse struct {
 func (x *GetPublicationResponse) Reset() {
 	*x = GetPublicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[11]
+		mi := &file_biblio_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +751,7 @@ func (x *GetPublicationResponse) String() string {
 func (*GetPublicationResponse) ProtoMessage() {}
 
 func (x *GetPublicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[11]
+	mi := &file_biblio_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +764,7 @@ func (x *GetPublicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicationResponse.ProtoReflect.Descriptor instead.
 func (*GetPublicationResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{11}
+	return file_biblio_proto_rawDescGZIP(), []int{13}
 }
 
 func (m *GetPublicationResponse) GetResponse() isGetPublicationResponse_Response {
@@ -714,12 +808,14 @@ type GetAllPublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	At string `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
 }
 
 func (x *GetAllPublicationsRequest) Reset() {
 	*x = GetAllPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[12]
+		mi := &file_biblio_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +828,7 @@ func (x *GetAllPublicationsRequest) String() string {
 func (*GetAllPublicationsRequest) ProtoMessage() {}
 
 func (x *GetAllPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[12]
+	mi := &file_biblio_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +841,14 @@ func (x *GetAllPublicationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPublicationsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{12}
+	return file_biblio_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAllPublicationsRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
 }
 
 type GetAllPublicationsResponse struct {
@@ -763,7 +866,7 @@ type GetAllPublicationsResponse struct {
 func (x *GetAllPublicationsResponse) Reset() {
 	*x = GetAllPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[13]
+		mi := &file_biblio_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +879,7 @@ func (x *GetAllPublicationsResponse) String() string {
 func (*GetAllPublicationsResponse) ProtoMessage() {}
 
 func (x *GetAllPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[13]
+	mi := &file_biblio_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +892,7 @@ func (x *GetAllPublicationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPublicationsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{13}
+	return file_biblio_proto_rawDescGZIP(), []int{15}
 }
 
 func (m *GetAllPublicationsResponse) GetResponse() isGetAllPublicationsResponse_Response {
@@ -842,7 +945,7 @@ type SearchPublicationsRequest struct {
 func (x *SearchPublicationsRequest) Reset() {
 	*x = SearchPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[14]
+		mi := &file_biblio_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -855,7 +958,7 @@ func (x *SearchPublicationsRequest) String() string {
 func (*SearchPublicationsRequest) ProtoMessage() {}
 
 func (x *SearchPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[14]
+	mi := &file_biblio_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +971,7 @@ func (x *SearchPublicationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPublicationsRequest.ProtoReflect.Descriptor instead.
 func (*SearchPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{14}
+	return file_biblio_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SearchPublicationsRequest) GetQuery() string {
@@ -906,7 +1009,7 @@ type SearchPublicationsResponse struct {
 func (x *SearchPublicationsResponse) Reset() {
 	*x = SearchPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[15]
+		mi := &file_biblio_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -919,7 +1022,7 @@ func (x *SearchPublicationsResponse) String() string {
 func (*SearchPublicationsResponse) ProtoMessage() {}
 
 func (x *SearchPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[15]
+	mi := &file_biblio_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +1035,7 @@ func (x *SearchPublicationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPublicationsResponse.ProtoReflect.Descriptor instead.
 func (*SearchPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{15}
+	return file_biblio_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SearchPublicationsResponse) GetHits() []*Publication {
@@ -974,7 +1077,7 @@ type UpdatePublicationRequest struct {
 func (x *UpdatePublicationRequest) Reset() {
 	*x = UpdatePublicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[16]
+		mi := &file_biblio_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -987,7 +1090,7 @@ func (x *UpdatePublicationRequest) String() string {
 func (*UpdatePublicationRequest) ProtoMessage() {}
 
 func (x *UpdatePublicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[16]
+	mi := &file_biblio_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1103,7 @@ func (x *UpdatePublicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePublicationRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePublicationRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{16}
+	return file_biblio_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdatePublicationRequest) GetPublication() *Publication {
@@ -1025,7 +1128,7 @@ type UpdatePublicationResponse struct {
 func (x *UpdatePublicationResponse) Reset() {
 	*x = UpdatePublicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[17]
+		mi := &file_biblio_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1038,7 +1141,7 @@ func (x *UpdatePublicationResponse) String() string {
 func (*UpdatePublicationResponse) ProtoMessage() {}
 
 func (x *UpdatePublicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[17]
+	mi := &file_biblio_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,7 +1154,7 @@ func (x *UpdatePublicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePublicationResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePublicationResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{17}
+	return file_biblio_proto_rawDescGZIP(), []int{19}
 }
 
 func (m *UpdatePublicationResponse) GetResponse() isUpdatePublicationResponse_Response {
@@ -1096,13 +1199,14 @@ type AddPublicationsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Publication *Publication `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
+	Publication     *Publication `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
+	CheckDuplicates bool         `protobuf:"varint,2,opt,name=check_duplicates,json=checkDuplicates,proto3" json:"check_duplicates,omitempty"`
 }
 
 func (x *AddPublicationsRequest) Reset() {
 	*x = AddPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[18]
+		mi := &file_biblio_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1115,7 +1219,7 @@ func (x *AddPublicationsRequest) String() string {
 func (*AddPublicationsRequest) ProtoMessage() {}
 
 func (x *AddPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[18]
+	mi := &file_biblio_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1232,7 @@ func (x *AddPublicationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPublicationsRequest.ProtoReflect.Descriptor instead.
 func (*AddPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{18}
+	return file_biblio_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AddPublicationsRequest) GetPublication() *Publication {
@@ -1138,6 +1242,13 @@ func (x *AddPublicationsRequest) GetPublication() *Publication {
 	return nil
 }
 
+func (x *AddPublicationsRequest) GetCheckDuplicates() bool {
+	if x != nil {
+		return x.CheckDuplicates
+	}
+	return false
+}
+
 type AddPublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1153,7 +1264,7 @@ type AddPublicationsResponse struct {
 func (x *AddPublicationsResponse) Reset() {
 	*x = AddPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[19]
+		mi := &file_biblio_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1166,7 +1277,7 @@ func (x *AddPublicationsResponse) String() string {
 func (*AddPublicationsResponse) ProtoMessage() {}
 
 func (x *AddPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[19]
+	mi := &file_biblio_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1290,7 @@ func (x *AddPublicationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPublicationsResponse.ProtoReflect.Descriptor instead.
 func (*AddPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{19}
+	return file_biblio_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *AddPublicationsResponse) GetResponse() isAddPublicationsResponse_Response {
@@ -1230,7 +1341,7 @@ type ImportPublicationsRequest struct {
 func (x *ImportPublicationsRequest) Reset() {
 	*x = ImportPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[20]
+		mi := &file_biblio_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1243,7 +1354,7 @@ func (x *ImportPublicationsRequest) String() string {
 func (*ImportPublicationsRequest) ProtoMessage() {}
 
 func (x *ImportPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[20]
+	mi := &file_biblio_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1367,7 @@ func (x *ImportPublicationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportPublicationsRequest.ProtoReflect.Descriptor instead.
 func (*ImportPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{20}
+	return file_biblio_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ImportPublicationsRequest) GetPublication() *Publication {
@@ -1281,7 +1392,7 @@ type ImportPublicationsResponse struct {
 func (x *ImportPublicationsResponse) Reset() {
 	*x = ImportPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[21]
+		mi := &file_biblio_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1294,7 +1405,7 @@ func (x *ImportPublicationsResponse) String() string {
 func (*ImportPublicationsResponse) ProtoMessage() {}
 
 func (x *ImportPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[21]
+	mi := &file_biblio_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1418,7 @@ func (x *ImportPublicationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportPublicationsResponse.ProtoReflect.Descriptor instead.
 func (*ImportPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{21}
+	return file_biblio_proto_rawDescGZIP(), []int{23}
 }
 
 func (m *ImportPublicationsResponse) GetResponse() isImportPublicationsResponse_Response {
@@ -1358,7 +1469,7 @@ type GetPublicationHistoryRequest struct {
 func (x *GetPublicationHistoryRequest) Reset() {
 	*x = GetPublicationHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[22]
+		mi := &file_biblio_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1371,7 +1482,7 @@ func (x *GetPublicationHistoryRequest) String() string {
 func (*GetPublicationHistoryRequest) ProtoMessage() {}
 
 func (x *GetPublicationHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[22]
+	mi := &file_biblio_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +1495,7 @@ func (x *GetPublicationHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicationHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetPublicationHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{22}
+	return file_biblio_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetPublicationHistoryRequest) GetId() string {
@@ -1409,7 +1520,7 @@ type GetPublicationHistoryResponse struct {
 func (x *GetPublicationHistoryResponse) Reset() {
 	*x = GetPublicationHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[23]
+		mi := &file_biblio_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1422,7 +1533,7 @@ func (x *GetPublicationHistoryResponse) String() string {
 func (*GetPublicationHistoryResponse) ProtoMessage() {}
 
 func (x *GetPublicationHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[23]
+	mi := &file_biblio_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +1546,7 @@ func (x *GetPublicationHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicationHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetPublicationHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{23}
+	return file_biblio_proto_rawDescGZIP(), []int{25}
 }
 
 func (m *GetPublicationHistoryResponse) GetResponse() isGetPublicationHistoryResponse_Response {
@@ -1475,7 +1586,7 @@ func (*GetPublicationHistoryResponse_Publication) isGetPublicationHistoryRespons
 
 func (*GetPublicationHistoryResponse_Error) isGetPublicationHistoryResponse_Response() {}
 
-type PurgePublicationRequest struct {
+type GetPublicationChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1483,23 +1594,23 @@ type PurgePublicationRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PurgePublicationRequest) Reset() {
-	*x = PurgePublicationRequest{}
+func (x *GetPublicationChangesRequest) Reset() {
+	*x = GetPublicationChangesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[24]
+		mi := &file_biblio_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PurgePublicationRequest) String() string {
+func (x *GetPublicationChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgePublicationRequest) ProtoMessage() {}
+func (*GetPublicationChangesRequest) ProtoMessage() {}
 
-func (x *PurgePublicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[24]
+func (x *GetPublicationChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,47 +1621,47 @@ func (x *PurgePublicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgePublicationRequest.ProtoReflect.Descriptor instead.
-func (*PurgePublicationRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetPublicationChangesRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicationChangesRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PurgePublicationRequest) GetId() string {
+func (x *GetPublicationChangesRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type PurgePublicationResponse struct {
+type GetPublicationChangesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*PurgePublicationResponse_Ok
-	//	*PurgePublicationResponse_Error
-	Response isPurgePublicationResponse_Response `protobuf_oneof:"response"`
+	//	*GetPublicationChangesResponse_Entry
+	//	*GetPublicationChangesResponse_Error
+	Response isGetPublicationChangesResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *PurgePublicationResponse) Reset() {
-	*x = PurgePublicationResponse{}
+func (x *GetPublicationChangesResponse) Reset() {
+	*x = GetPublicationChangesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[25]
+		mi := &file_biblio_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PurgePublicationResponse) String() string {
+func (x *GetPublicationChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgePublicationResponse) ProtoMessage() {}
+func (*GetPublicationChangesResponse) ProtoMessage() {}
 
-func (x *PurgePublicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[25]
+func (x *GetPublicationChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,73 +1672,75 @@ func (x *PurgePublicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgePublicationResponse.ProtoReflect.Descriptor instead.
-func (*PurgePublicationResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetPublicationChangesResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicationChangesResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{27}
 }
 
-func (m *PurgePublicationResponse) GetResponse() isPurgePublicationResponse_Response {
+func (m *GetPublicationChangesResponse) GetResponse() isGetPublicationChangesResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *PurgePublicationResponse) GetOk() bool {
-	if x, ok := x.GetResponse().(*PurgePublicationResponse_Ok); ok {
-		return x.Ok
+func (x *GetPublicationChangesResponse) GetEntry() *HistoryEntry {
+	if x, ok := x.GetResponse().(*GetPublicationChangesResponse_Entry); ok {
+		return x.Entry
 	}
-	return false
+	return nil
 }
 
-func (x *PurgePublicationResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*PurgePublicationResponse_Error); ok {
+func (x *GetPublicationChangesResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetPublicationChangesResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isPurgePublicationResponse_Response interface {
-	isPurgePublicationResponse_Response()
+type isGetPublicationChangesResponse_Response interface {
+	isGetPublicationChangesResponse_Response()
 }
 
-type PurgePublicationResponse_Ok struct {
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
+type GetPublicationChangesResponse_Entry struct {
+	Entry *HistoryEntry `protobuf:"bytes,1,opt,name=entry,proto3,oneof"`
 }
 
-type PurgePublicationResponse_Error struct {
+type GetPublicationChangesResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*PurgePublicationResponse_Ok) isPurgePublicationResponse_Response() {}
+func (*GetPublicationChangesResponse_Entry) isGetPublicationChangesResponse_Response() {}
 
-func (*PurgePublicationResponse_Error) isPurgePublicationResponse_Response() {}
+func (*GetPublicationChangesResponse_Error) isGetPublicationChangesResponse_Response() {}
 
-type PurgeAllPublicationsRequest struct {
+type RestorePublicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Confirm bool `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *PurgeAllPublicationsRequest) Reset() {
-	*x = PurgeAllPublicationsRequest{}
+func (x *RestorePublicationRequest) Reset() {
+	*x = RestorePublicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[26]
+		mi := &file_biblio_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PurgeAllPublicationsRequest) String() string {
+func (x *RestorePublicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeAllPublicationsRequest) ProtoMessage() {}
+func (*RestorePublicationRequest) ProtoMessage() {}
 
-func (x *PurgeAllPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[26]
+func (x *RestorePublicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,47 +1751,61 @@ func (x *PurgeAllPublicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeAllPublicationsRequest.ProtoReflect.Descriptor instead.
-func (*PurgeAllPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RestorePublicationRequest.ProtoReflect.Descriptor instead.
+func (*RestorePublicationRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PurgeAllPublicationsRequest) GetConfirm() bool {
+func (x *RestorePublicationRequest) GetId() string {
 	if x != nil {
-		return x.Confirm
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type PurgeAllPublicationsResponse struct {
+func (x *RestorePublicationRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *RestorePublicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestorePublicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*PurgeAllPublicationsResponse_Ok
-	//	*PurgeAllPublicationsResponse_Error
-	Response isPurgeAllPublicationsResponse_Response `protobuf_oneof:"response"`
+	//	*RestorePublicationResponse_Publication
+	//	*RestorePublicationResponse_Error
+	Response isRestorePublicationResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *PurgeAllPublicationsResponse) Reset() {
-	*x = PurgeAllPublicationsResponse{}
+func (x *RestorePublicationResponse) Reset() {
+	*x = RestorePublicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[27]
+		mi := &file_biblio_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PurgeAllPublicationsResponse) String() string {
+func (x *RestorePublicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeAllPublicationsResponse) ProtoMessage() {}
+func (*RestorePublicationResponse) ProtoMessage() {}
 
-func (x *PurgeAllPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[27]
+func (x *RestorePublicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,122 +1816,73 @@ func (x *PurgeAllPublicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeAllPublicationsResponse.ProtoReflect.Descriptor instead.
-func (*PurgeAllPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RestorePublicationResponse.ProtoReflect.Descriptor instead.
+func (*RestorePublicationResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{29}
 }
 
-func (m *PurgeAllPublicationsResponse) GetResponse() isPurgeAllPublicationsResponse_Response {
+func (m *RestorePublicationResponse) GetResponse() isRestorePublicationResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *PurgeAllPublicationsResponse) GetOk() bool {
-	if x, ok := x.GetResponse().(*PurgeAllPublicationsResponse_Ok); ok {
-		return x.Ok
+func (x *RestorePublicationResponse) GetPublication() *Publication {
+	if x, ok := x.GetResponse().(*RestorePublicationResponse_Publication); ok {
+		return x.Publication
 	}
-	return false
+	return nil
 }
 
-func (x *PurgeAllPublicationsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*PurgeAllPublicationsResponse_Error); ok {
+func (x *RestorePublicationResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*RestorePublicationResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isPurgeAllPublicationsResponse_Response interface {
-	isPurgeAllPublicationsResponse_Response()
+type isRestorePublicationResponse_Response interface {
+	isRestorePublicationResponse_Response()
 }
 
-type PurgeAllPublicationsResponse_Ok struct {
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
+type RestorePublicationResponse_Publication struct {
+	Publication *Publication `protobuf:"bytes,1,opt,name=publication,proto3,oneof"`
 }
 
-type PurgeAllPublicationsResponse_Error struct {
+type RestorePublicationResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*PurgeAllPublicationsResponse_Ok) isPurgeAllPublicationsResponse_Response() {}
-
-func (*PurgeAllPublicationsResponse_Error) isPurgeAllPublicationsResponse_Response() {}
-
-type ValidatePublicationsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Publication *Publication `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
-}
-
-func (x *ValidatePublicationsRequest) Reset() {
-	*x = ValidatePublicationsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ValidatePublicationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidatePublicationsRequest) ProtoMessage() {}
-
-func (x *ValidatePublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidatePublicationsRequest.ProtoReflect.Descriptor instead.
-func (*ValidatePublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{28}
-}
+func (*RestorePublicationResponse_Publication) isRestorePublicationResponse_Response() {}
 
-func (x *ValidatePublicationsRequest) GetPublication() *Publication {
-	if x != nil {
-		return x.Publication
-	}
-	return nil
-}
+func (*RestorePublicationResponse_Error) isRestorePublicationResponse_Response() {}
 
-type ValidateResults struct {
+type GetPublicationCommentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seq     int32  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ValidateResults) Reset() {
-	*x = ValidateResults{}
+func (x *GetPublicationCommentsRequest) Reset() {
+	*x = GetPublicationCommentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[29]
+		mi := &file_biblio_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidateResults) String() string {
+func (x *GetPublicationCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateResults) ProtoMessage() {}
+func (*GetPublicationCommentsRequest) ProtoMessage() {}
 
-func (x *ValidateResults) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[29]
+func (x *GetPublicationCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1815,61 +1893,47 @@ func (x *ValidateResults) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateResults.ProtoReflect.Descriptor instead.
-func (*ValidateResults) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *ValidateResults) GetSeq() int32 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
+// Deprecated: Use GetPublicationCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicationCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ValidateResults) GetId() string {
+func (x *GetPublicationCommentsRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ValidateResults) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type ValidatePublicationsResponse struct {
+type GetPublicationCommentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*ValidatePublicationsResponse_Results
-	//	*ValidatePublicationsResponse_Error
-	Response isValidatePublicationsResponse_Response `protobuf_oneof:"response"`
+	//	*GetPublicationCommentsResponse_Comment
+	//	*GetPublicationCommentsResponse_Error
+	Response isGetPublicationCommentsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *ValidatePublicationsResponse) Reset() {
-	*x = ValidatePublicationsResponse{}
+func (x *GetPublicationCommentsResponse) Reset() {
+	*x = GetPublicationCommentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[30]
+		mi := &file_biblio_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatePublicationsResponse) String() string {
+func (x *GetPublicationCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatePublicationsResponse) ProtoMessage() {}
+func (*GetPublicationCommentsResponse) ProtoMessage() {}
 
-func (x *ValidatePublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[30]
+func (x *GetPublicationCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,71 +1944,77 @@ func (x *ValidatePublicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatePublicationsResponse.ProtoReflect.Descriptor instead.
-func (*ValidatePublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetPublicationCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicationCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{31}
 }
 
-func (m *ValidatePublicationsResponse) GetResponse() isValidatePublicationsResponse_Response {
+func (m *GetPublicationCommentsResponse) GetResponse() isGetPublicationCommentsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ValidatePublicationsResponse) GetResults() *ValidateResults {
-	if x, ok := x.GetResponse().(*ValidatePublicationsResponse_Results); ok {
-		return x.Results
+func (x *GetPublicationCommentsResponse) GetComment() *Comment {
+	if x, ok := x.GetResponse().(*GetPublicationCommentsResponse_Comment); ok {
+		return x.Comment
 	}
 	return nil
 }
 
-func (x *ValidatePublicationsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*ValidatePublicationsResponse_Error); ok {
+func (x *GetPublicationCommentsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetPublicationCommentsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isValidatePublicationsResponse_Response interface {
-	isValidatePublicationsResponse_Response()
+type isGetPublicationCommentsResponse_Response interface {
+	isGetPublicationCommentsResponse_Response()
 }
 
-type ValidatePublicationsResponse_Results struct {
-	Results *ValidateResults `protobuf:"bytes,1,opt,name=results,proto3,oneof"`
+type GetPublicationCommentsResponse_Comment struct {
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3,oneof"`
 }
 
-type ValidatePublicationsResponse_Error struct {
+type GetPublicationCommentsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ValidatePublicationsResponse_Results) isValidatePublicationsResponse_Response() {}
+func (*GetPublicationCommentsResponse_Comment) isGetPublicationCommentsResponse_Response() {}
 
-func (*ValidatePublicationsResponse_Error) isValidatePublicationsResponse_Response() {}
+func (*GetPublicationCommentsResponse_Error) isGetPublicationCommentsResponse_Response() {}
 
-type ReindexPublicationsRequest struct {
+type AddPublicationCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId   string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	UserId     string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Body       string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Visibility string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
 }
 
-func (x *ReindexPublicationsRequest) Reset() {
-	*x = ReindexPublicationsRequest{}
+func (x *AddPublicationCommentRequest) Reset() {
+	*x = AddPublicationCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[31]
+		mi := &file_biblio_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReindexPublicationsRequest) String() string {
+func (x *AddPublicationCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexPublicationsRequest) ProtoMessage() {}
+func (*AddPublicationCommentRequest) ProtoMessage() {}
 
-func (x *ReindexPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[31]
+func (x *AddPublicationCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,40 +2025,75 @@ func (x *ReindexPublicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexPublicationsRequest.ProtoReflect.Descriptor instead.
-func (*ReindexPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AddPublicationCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddPublicationCommentRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{32}
 }
 
-type ReindexPublicationsResponse struct {
+func (x *AddPublicationCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddPublicationCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AddPublicationCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddPublicationCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *AddPublicationCommentRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type AddPublicationCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*ReindexPublicationsResponse_Message
-	//	*ReindexPublicationsResponse_Error
-	Response isReindexPublicationsResponse_Response `protobuf_oneof:"response"`
+	//	*AddPublicationCommentResponse_Comment
+	//	*AddPublicationCommentResponse_Error
+	Response isAddPublicationCommentResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *ReindexPublicationsResponse) Reset() {
-	*x = ReindexPublicationsResponse{}
+func (x *AddPublicationCommentResponse) Reset() {
+	*x = AddPublicationCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[32]
+		mi := &file_biblio_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReindexPublicationsResponse) String() string {
+func (x *AddPublicationCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexPublicationsResponse) ProtoMessage() {}
+func (*AddPublicationCommentResponse) ProtoMessage() {}
 
-func (x *ReindexPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[32]
+func (x *AddPublicationCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,75 +2104,73 @@ func (x *ReindexPublicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexPublicationsResponse.ProtoReflect.Descriptor instead.
-func (*ReindexPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AddPublicationCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddPublicationCommentResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{33}
 }
 
-func (m *ReindexPublicationsResponse) GetResponse() isReindexPublicationsResponse_Response {
+func (m *AddPublicationCommentResponse) GetResponse() isAddPublicationCommentResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ReindexPublicationsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*ReindexPublicationsResponse_Message); ok {
-		return x.Message
+func (x *AddPublicationCommentResponse) GetComment() *Comment {
+	if x, ok := x.GetResponse().(*AddPublicationCommentResponse_Comment); ok {
+		return x.Comment
 	}
-	return ""
+	return nil
 }
 
-func (x *ReindexPublicationsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*ReindexPublicationsResponse_Error); ok {
+func (x *AddPublicationCommentResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*AddPublicationCommentResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isReindexPublicationsResponse_Response interface {
-	isReindexPublicationsResponse_Response()
+type isAddPublicationCommentResponse_Response interface {
+	isAddPublicationCommentResponse_Response()
 }
 
-type ReindexPublicationsResponse_Message struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+type AddPublicationCommentResponse_Comment struct {
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3,oneof"`
 }
 
-type ReindexPublicationsResponse_Error struct {
+type AddPublicationCommentResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ReindexPublicationsResponse_Message) isReindexPublicationsResponse_Response() {}
+func (*AddPublicationCommentResponse_Comment) isAddPublicationCommentResponse_Response() {}
 
-func (*ReindexPublicationsResponse_Error) isReindexPublicationsResponse_Response() {}
+func (*AddPublicationCommentResponse_Error) isAddPublicationCommentResponse_Response() {}
 
-type TransferPublicationsRequest struct {
+type PurgePublicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Src           string `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
-	Dest          string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
-	Publicationid string `protobuf:"bytes,3,opt,name=publicationid,proto3" json:"publicationid,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TransferPublicationsRequest) Reset() {
-	*x = TransferPublicationsRequest{}
+func (x *PurgePublicationRequest) Reset() {
+	*x = PurgePublicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[33]
+		mi := &file_biblio_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferPublicationsRequest) String() string {
+func (x *PurgePublicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferPublicationsRequest) ProtoMessage() {}
+func (*PurgePublicationRequest) ProtoMessage() {}
 
-func (x *TransferPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[33]
+func (x *PurgePublicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,61 +2181,47 @@ func (x *TransferPublicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferPublicationsRequest.ProtoReflect.Descriptor instead.
-func (*TransferPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *TransferPublicationsRequest) GetSrc() string {
-	if x != nil {
-		return x.Src
-	}
-	return ""
-}
-
-func (x *TransferPublicationsRequest) GetDest() string {
-	if x != nil {
-		return x.Dest
-	}
-	return ""
+// Deprecated: Use PurgePublicationRequest.ProtoReflect.Descriptor instead.
+func (*PurgePublicationRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *TransferPublicationsRequest) GetPublicationid() string {
+func (x *PurgePublicationRequest) GetId() string {
 	if x != nil {
-		return x.Publicationid
+		return x.Id
 	}
 	return ""
 }
 
-type TransferPublicationsResponse struct {
+type PurgePublicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*TransferPublicationsResponse_Message
-	//	*TransferPublicationsResponse_Error
-	Response isTransferPublicationsResponse_Response `protobuf_oneof:"response"`
+	//	*PurgePublicationResponse_Ok
+	//	*PurgePublicationResponse_Error
+	Response isPurgePublicationResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *TransferPublicationsResponse) Reset() {
-	*x = TransferPublicationsResponse{}
+func (x *PurgePublicationResponse) Reset() {
+	*x = PurgePublicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[34]
+		mi := &file_biblio_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferPublicationsResponse) String() string {
+func (x *PurgePublicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferPublicationsResponse) ProtoMessage() {}
+func (*PurgePublicationResponse) ProtoMessage() {}
 
-func (x *TransferPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[34]
+func (x *PurgePublicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,71 +2232,73 @@ func (x *TransferPublicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferPublicationsResponse.ProtoReflect.Descriptor instead.
-func (*TransferPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use PurgePublicationResponse.ProtoReflect.Descriptor instead.
+func (*PurgePublicationResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{35}
 }
 
-func (m *TransferPublicationsResponse) GetResponse() isTransferPublicationsResponse_Response {
+func (m *PurgePublicationResponse) GetResponse() isPurgePublicationResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *TransferPublicationsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*TransferPublicationsResponse_Message); ok {
-		return x.Message
+func (x *PurgePublicationResponse) GetOk() bool {
+	if x, ok := x.GetResponse().(*PurgePublicationResponse_Ok); ok {
+		return x.Ok
 	}
-	return ""
+	return false
 }
 
-func (x *TransferPublicationsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*TransferPublicationsResponse_Error); ok {
+func (x *PurgePublicationResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*PurgePublicationResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isTransferPublicationsResponse_Response interface {
-	isTransferPublicationsResponse_Response()
+type isPurgePublicationResponse_Response interface {
+	isPurgePublicationResponse_Response()
 }
 
-type TransferPublicationsResponse_Message struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+type PurgePublicationResponse_Ok struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
 }
 
-type TransferPublicationsResponse_Error struct {
+type PurgePublicationResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*TransferPublicationsResponse_Message) isTransferPublicationsResponse_Response() {}
+func (*PurgePublicationResponse_Ok) isPurgePublicationResponse_Response() {}
 
-func (*TransferPublicationsResponse_Error) isTransferPublicationsResponse_Response() {}
+func (*PurgePublicationResponse_Error) isPurgePublicationResponse_Response() {}
 
-type CleanupPublicationsRequest struct {
+type PurgeAllPublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Confirm bool `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"`
 }
 
-func (x *CleanupPublicationsRequest) Reset() {
-	*x = CleanupPublicationsRequest{}
+func (x *PurgeAllPublicationsRequest) Reset() {
+	*x = PurgeAllPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[35]
+		mi := &file_biblio_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanupPublicationsRequest) String() string {
+func (x *PurgeAllPublicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupPublicationsRequest) ProtoMessage() {}
+func (*PurgeAllPublicationsRequest) ProtoMessage() {}
 
-func (x *CleanupPublicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[35]
+func (x *PurgeAllPublicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,40 +2309,47 @@ func (x *CleanupPublicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupPublicationsRequest.ProtoReflect.Descriptor instead.
-func (*CleanupPublicationsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use PurgeAllPublicationsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeAllPublicationsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{36}
 }
 
-type CleanupPublicationsResponse struct {
+func (x *PurgeAllPublicationsRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type PurgeAllPublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*CleanupPublicationsResponse_Message
-	//	*CleanupPublicationsResponse_Error
-	Response isCleanupPublicationsResponse_Response `protobuf_oneof:"response"`
+	//	*PurgeAllPublicationsResponse_Ok
+	//	*PurgeAllPublicationsResponse_Error
+	Response isPurgeAllPublicationsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *CleanupPublicationsResponse) Reset() {
-	*x = CleanupPublicationsResponse{}
+func (x *PurgeAllPublicationsResponse) Reset() {
+	*x = PurgeAllPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[36]
+		mi := &file_biblio_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanupPublicationsResponse) String() string {
+func (x *PurgeAllPublicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupPublicationsResponse) ProtoMessage() {}
+func (*PurgeAllPublicationsResponse) ProtoMessage() {}
 
-func (x *CleanupPublicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[36]
+func (x *PurgeAllPublicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,73 +2360,74 @@ func (x *CleanupPublicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupPublicationsResponse.ProtoReflect.Descriptor instead.
-func (*CleanupPublicationsResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use PurgeAllPublicationsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeAllPublicationsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{37}
 }
 
-func (m *CleanupPublicationsResponse) GetResponse() isCleanupPublicationsResponse_Response {
+func (m *PurgeAllPublicationsResponse) GetResponse() isPurgeAllPublicationsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *CleanupPublicationsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*CleanupPublicationsResponse_Message); ok {
-		return x.Message
+func (x *PurgeAllPublicationsResponse) GetOk() bool {
+	if x, ok := x.GetResponse().(*PurgeAllPublicationsResponse_Ok); ok {
+		return x.Ok
 	}
-	return ""
+	return false
 }
 
-func (x *CleanupPublicationsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*CleanupPublicationsResponse_Error); ok {
+func (x *PurgeAllPublicationsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*PurgeAllPublicationsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isCleanupPublicationsResponse_Response interface {
-	isCleanupPublicationsResponse_Response()
+type isPurgeAllPublicationsResponse_Response interface {
+	isPurgeAllPublicationsResponse_Response()
 }
 
-type CleanupPublicationsResponse_Message struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+type PurgeAllPublicationsResponse_Ok struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
 }
 
-type CleanupPublicationsResponse_Error struct {
+type PurgeAllPublicationsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*CleanupPublicationsResponse_Message) isCleanupPublicationsResponse_Response() {}
+func (*PurgeAllPublicationsResponse_Ok) isPurgeAllPublicationsResponse_Response() {}
 
-func (*CleanupPublicationsResponse_Error) isCleanupPublicationsResponse_Response() {}
+func (*PurgeAllPublicationsResponse_Error) isPurgeAllPublicationsResponse_Response() {}
 
-type GetDatasetRequest struct {
+type MergePublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SurvivorId string `protobuf:"bytes,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedId   string `protobuf:"bytes,2,opt,name=merged_id,json=mergedId,proto3" json:"merged_id,omitempty"`
 }
 
-func (x *GetDatasetRequest) Reset() {
-	*x = GetDatasetRequest{}
+func (x *MergePublicationsRequest) Reset() {
+	*x = MergePublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[37]
+		mi := &file_biblio_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDatasetRequest) String() string {
+func (x *MergePublicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDatasetRequest) ProtoMessage() {}
+func (*MergePublicationsRequest) ProtoMessage() {}
 
-func (x *GetDatasetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[37]
+func (x *MergePublicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2339,47 +2438,54 @@ func (x *GetDatasetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDatasetRequest.ProtoReflect.Descriptor instead.
-func (*GetDatasetRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use MergePublicationsRequest.ProtoReflect.Descriptor instead.
+func (*MergePublicationsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetDatasetRequest) GetId() string {
+func (x *MergePublicationsRequest) GetSurvivorId() string {
 	if x != nil {
-		return x.Id
+		return x.SurvivorId
 	}
 	return ""
 }
 
-type GetDatasetResponse struct {
+func (x *MergePublicationsRequest) GetMergedId() string {
+	if x != nil {
+		return x.MergedId
+	}
+	return ""
+}
+
+type MergePublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*GetDatasetResponse_Dataset
-	//	*GetDatasetResponse_Error
-	Response isGetDatasetResponse_Response `protobuf_oneof:"response"`
+	//	*MergePublicationsResponse_Publication
+	//	*MergePublicationsResponse_Error
+	Response isMergePublicationsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetDatasetResponse) Reset() {
-	*x = GetDatasetResponse{}
+func (x *MergePublicationsResponse) Reset() {
+	*x = MergePublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[38]
+		mi := &file_biblio_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDatasetResponse) String() string {
+func (x *MergePublicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDatasetResponse) ProtoMessage() {}
+func (*MergePublicationsResponse) ProtoMessage() {}
 
-func (x *GetDatasetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[38]
+func (x *MergePublicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2390,114 +2496,72 @@ func (x *GetDatasetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDatasetResponse.ProtoReflect.Descriptor instead.
-func (*GetDatasetResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use MergePublicationsResponse.ProtoReflect.Descriptor instead.
+func (*MergePublicationsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{39}
 }
 
-func (m *GetDatasetResponse) GetResponse() isGetDatasetResponse_Response {
+func (m *MergePublicationsResponse) GetResponse() isMergePublicationsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *GetDatasetResponse) GetDataset() *Dataset {
-	if x, ok := x.GetResponse().(*GetDatasetResponse_Dataset); ok {
-		return x.Dataset
+func (x *MergePublicationsResponse) GetPublication() *Publication {
+	if x, ok := x.GetResponse().(*MergePublicationsResponse_Publication); ok {
+		return x.Publication
 	}
 	return nil
 }
 
-func (x *GetDatasetResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*GetDatasetResponse_Error); ok {
+func (x *MergePublicationsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*MergePublicationsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isGetDatasetResponse_Response interface {
-	isGetDatasetResponse_Response()
+type isMergePublicationsResponse_Response interface {
+	isMergePublicationsResponse_Response()
 }
 
-type GetDatasetResponse_Dataset struct {
-	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3,oneof"`
+type MergePublicationsResponse_Publication struct {
+	Publication *Publication `protobuf:"bytes,1,opt,name=publication,proto3,oneof"`
 }
 
-type GetDatasetResponse_Error struct {
+type MergePublicationsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*GetDatasetResponse_Dataset) isGetDatasetResponse_Response() {}
+func (*MergePublicationsResponse_Publication) isMergePublicationsResponse_Response() {}
 
-func (*GetDatasetResponse_Error) isGetDatasetResponse_Response() {}
+func (*MergePublicationsResponse_Error) isMergePublicationsResponse_Response() {}
 
-type GetAllDatasetsRequest struct {
+type ValidatePublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Publication *Publication `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
 }
 
-func (x *GetAllDatasetsRequest) Reset() {
-	*x = GetAllDatasetsRequest{}
+func (x *ValidatePublicationsRequest) Reset() {
+	*x = ValidatePublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[39]
+		mi := &file_biblio_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllDatasetsRequest) String() string {
+func (x *ValidatePublicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllDatasetsRequest) ProtoMessage() {}
-
-func (x *GetAllDatasetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetAllDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllDatasetsRequest) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{39}
-}
-
-type GetAllDatasetsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Response:
-	//
-	//	*GetAllDatasetsResponse_Dataset
-	//	*GetAllDatasetsResponse_Error
-	Response isGetAllDatasetsResponse_Response `protobuf_oneof:"response"`
-}
-
-func (x *GetAllDatasetsResponse) Reset() {
-	*x = GetAllDatasetsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetAllDatasetsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetAllDatasetsResponse) ProtoMessage() {}
+func (*ValidatePublicationsRequest) ProtoMessage() {}
 
-func (x *GetAllDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidatePublicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2509,60 +2573,30 @@ func (x *GetAllDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatePublicationsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePublicationsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{40}
 }
 
-func (m *GetAllDatasetsResponse) GetResponse() isGetAllDatasetsResponse_Response {
-	if m != nil {
-		return m.Response
-	}
-	return nil
-}
-
-func (x *GetAllDatasetsResponse) GetDataset() *Dataset {
-	if x, ok := x.GetResponse().(*GetAllDatasetsResponse_Dataset); ok {
-		return x.Dataset
-	}
-	return nil
-}
-
-func (x *GetAllDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*GetAllDatasetsResponse_Error); ok {
-		return x.Error
+func (x *ValidatePublicationsRequest) GetPublication() *Publication {
+	if x != nil {
+		return x.Publication
 	}
 	return nil
 }
 
-type isGetAllDatasetsResponse_Response interface {
-	isGetAllDatasetsResponse_Response()
-}
-
-type GetAllDatasetsResponse_Dataset struct {
-	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3,oneof"`
-}
-
-type GetAllDatasetsResponse_Error struct {
-	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
-}
-
-func (*GetAllDatasetsResponse_Dataset) isGetAllDatasetsResponse_Response() {}
-
-func (*GetAllDatasetsResponse_Error) isGetAllDatasetsResponse_Response() {}
-
-type SearchDatasetsRequest struct {
+type ValidateResults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Seq     int32  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SearchDatasetsRequest) Reset() {
-	*x = SearchDatasetsRequest{}
+func (x *ValidateResults) Reset() {
+	*x = ValidateResults{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2570,13 +2604,13 @@ func (x *SearchDatasetsRequest) Reset() {
 	}
 }
 
-func (x *SearchDatasetsRequest) String() string {
+func (x *ValidateResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDatasetsRequest) ProtoMessage() {}
+func (*ValidateResults) ProtoMessage() {}
 
-func (x *SearchDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateResults) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2588,45 +2622,46 @@ func (x *SearchDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*SearchDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateResults.ProtoReflect.Descriptor instead.
+func (*ValidateResults) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SearchDatasetsRequest) GetQuery() string {
+func (x *ValidateResults) GetSeq() int32 {
 	if x != nil {
-		return x.Query
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchDatasetsRequest) GetOffset() int32 {
+func (x *ValidateResults) GetId() string {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchDatasetsRequest) GetLimit() int32 {
+func (x *ValidateResults) GetMessage() string {
 	if x != nil {
-		return x.Limit
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type SearchDatasetsResponse struct {
+type ValidatePublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hits   []*Dataset `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
-	Offset int32      `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  int32      `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Total  int32      `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*ValidatePublicationsResponse_Results
+	//	*ValidatePublicationsResponse_Error
+	Response isValidatePublicationsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *SearchDatasetsResponse) Reset() {
-	*x = SearchDatasetsResponse{}
+func (x *ValidatePublicationsResponse) Reset() {
+	*x = ValidatePublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2634,13 +2669,13 @@ func (x *SearchDatasetsResponse) Reset() {
 	}
 }
 
-func (x *SearchDatasetsResponse) String() string {
+func (x *ValidatePublicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDatasetsResponse) ProtoMessage() {}
+func (*ValidatePublicationsResponse) ProtoMessage() {}
 
-func (x *SearchDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidatePublicationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2652,49 +2687,56 @@ func (x *SearchDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*SearchDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatePublicationsResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePublicationsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SearchDatasetsResponse) GetHits() []*Dataset {
-	if x != nil {
-		return x.Hits
+func (m *ValidatePublicationsResponse) GetResponse() isValidatePublicationsResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (x *SearchDatasetsResponse) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+func (x *ValidatePublicationsResponse) GetResults() *ValidateResults {
+	if x, ok := x.GetResponse().(*ValidatePublicationsResponse_Results); ok {
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchDatasetsResponse) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
+func (x *ValidatePublicationsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*ValidatePublicationsResponse_Error); ok {
+		return x.Error
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchDatasetsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+type isValidatePublicationsResponse_Response interface {
+	isValidatePublicationsResponse_Response()
 }
 
-type UpdateDatasetRequest struct {
+type ValidatePublicationsResponse_Results struct {
+	Results *ValidateResults `protobuf:"bytes,1,opt,name=results,proto3,oneof"`
+}
+
+type ValidatePublicationsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ValidatePublicationsResponse_Results) isValidatePublicationsResponse_Response() {}
+
+func (*ValidatePublicationsResponse_Error) isValidatePublicationsResponse_Response() {}
+
+type ReindexPublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
 }
 
-func (x *UpdateDatasetRequest) Reset() {
-	*x = UpdateDatasetRequest{}
+func (x *ReindexPublicationsRequest) Reset() {
+	*x = ReindexPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2702,13 +2744,13 @@ func (x *UpdateDatasetRequest) Reset() {
 	}
 }
 
-func (x *UpdateDatasetRequest) String() string {
+func (x *ReindexPublicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDatasetRequest) ProtoMessage() {}
+func (*ReindexPublicationsRequest) ProtoMessage() {}
 
-func (x *UpdateDatasetRequest) ProtoReflect() protoreflect.Message {
+func (x *ReindexPublicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2720,32 +2762,25 @@ func (x *UpdateDatasetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDatasetRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDatasetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReindexPublicationsRequest.ProtoReflect.Descriptor instead.
+func (*ReindexPublicationsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateDatasetRequest) GetDataset() *Dataset {
-	if x != nil {
-		return x.Dataset
-	}
-	return nil
-}
-
-type UpdateDatasetResponse struct {
+type ReindexPublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*UpdateDatasetResponse_Message
-	//	*UpdateDatasetResponse_Error
-	Response isUpdateDatasetResponse_Response `protobuf_oneof:"response"`
+	//	*ReindexPublicationsResponse_Message
+	//	*ReindexPublicationsResponse_Error
+	Response isReindexPublicationsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *UpdateDatasetResponse) Reset() {
-	*x = UpdateDatasetResponse{}
+func (x *ReindexPublicationsResponse) Reset() {
+	*x = ReindexPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2753,13 +2788,13 @@ func (x *UpdateDatasetResponse) Reset() {
 	}
 }
 
-func (x *UpdateDatasetResponse) String() string {
+func (x *ReindexPublicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDatasetResponse) ProtoMessage() {}
+func (*ReindexPublicationsResponse) ProtoMessage() {}
 
-func (x *UpdateDatasetResponse) ProtoReflect() protoreflect.Message {
+func (x *ReindexPublicationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2771,58 +2806,60 @@ func (x *UpdateDatasetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDatasetResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDatasetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReindexPublicationsResponse.ProtoReflect.Descriptor instead.
+func (*ReindexPublicationsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{44}
 }
 
-func (m *UpdateDatasetResponse) GetResponse() isUpdateDatasetResponse_Response {
+func (m *ReindexPublicationsResponse) GetResponse() isReindexPublicationsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *UpdateDatasetResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*UpdateDatasetResponse_Message); ok {
+func (x *ReindexPublicationsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*ReindexPublicationsResponse_Message); ok {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateDatasetResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*UpdateDatasetResponse_Error); ok {
+func (x *ReindexPublicationsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*ReindexPublicationsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isUpdateDatasetResponse_Response interface {
-	isUpdateDatasetResponse_Response()
+type isReindexPublicationsResponse_Response interface {
+	isReindexPublicationsResponse_Response()
 }
 
-type UpdateDatasetResponse_Message struct {
+type ReindexPublicationsResponse_Message struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type UpdateDatasetResponse_Error struct {
+type ReindexPublicationsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*UpdateDatasetResponse_Message) isUpdateDatasetResponse_Response() {}
+func (*ReindexPublicationsResponse_Message) isReindexPublicationsResponse_Response() {}
 
-func (*UpdateDatasetResponse_Error) isUpdateDatasetResponse_Response() {}
+func (*ReindexPublicationsResponse_Error) isReindexPublicationsResponse_Response() {}
 
-type AddDatasetsRequest struct {
+type TransferPublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Src           string `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest          string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Publicationid string `protobuf:"bytes,3,opt,name=publicationid,proto3" json:"publicationid,omitempty"`
 }
 
-func (x *AddDatasetsRequest) Reset() {
-	*x = AddDatasetsRequest{}
+func (x *TransferPublicationsRequest) Reset() {
+	*x = TransferPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,13 +2867,13 @@ func (x *AddDatasetsRequest) Reset() {
 	}
 }
 
-func (x *AddDatasetsRequest) String() string {
+func (x *TransferPublicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDatasetsRequest) ProtoMessage() {}
+func (*TransferPublicationsRequest) ProtoMessage() {}
 
-func (x *AddDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *TransferPublicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2848,32 +2885,46 @@ func (x *AddDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*AddDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferPublicationsRequest.ProtoReflect.Descriptor instead.
+func (*TransferPublicationsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AddDatasetsRequest) GetDataset() *Dataset {
+func (x *TransferPublicationsRequest) GetSrc() string {
 	if x != nil {
-		return x.Dataset
+		return x.Src
 	}
-	return nil
+	return ""
 }
 
-type AddDatasetsResponse struct {
+func (x *TransferPublicationsRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *TransferPublicationsRequest) GetPublicationid() string {
+	if x != nil {
+		return x.Publicationid
+	}
+	return ""
+}
+
+type TransferPublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*AddDatasetsResponse_Message
-	//	*AddDatasetsResponse_Error
-	Response isAddDatasetsResponse_Response `protobuf_oneof:"response"`
+	//	*TransferPublicationsResponse_Message
+	//	*TransferPublicationsResponse_Error
+	Response isTransferPublicationsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *AddDatasetsResponse) Reset() {
-	*x = AddDatasetsResponse{}
+func (x *TransferPublicationsResponse) Reset() {
+	*x = TransferPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2881,13 +2932,13 @@ func (x *AddDatasetsResponse) Reset() {
 	}
 }
 
-func (x *AddDatasetsResponse) String() string {
+func (x *TransferPublicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDatasetsResponse) ProtoMessage() {}
+func (*TransferPublicationsResponse) ProtoMessage() {}
 
-func (x *AddDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *TransferPublicationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2899,58 +2950,56 @@ func (x *AddDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*AddDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferPublicationsResponse.ProtoReflect.Descriptor instead.
+func (*TransferPublicationsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{46}
 }
 
-func (m *AddDatasetsResponse) GetResponse() isAddDatasetsResponse_Response {
+func (m *TransferPublicationsResponse) GetResponse() isTransferPublicationsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *AddDatasetsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*AddDatasetsResponse_Message); ok {
+func (x *TransferPublicationsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*TransferPublicationsResponse_Message); ok {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *AddDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*AddDatasetsResponse_Error); ok {
+func (x *TransferPublicationsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*TransferPublicationsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isAddDatasetsResponse_Response interface {
-	isAddDatasetsResponse_Response()
+type isTransferPublicationsResponse_Response interface {
+	isTransferPublicationsResponse_Response()
 }
 
-type AddDatasetsResponse_Message struct {
+type TransferPublicationsResponse_Message struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type AddDatasetsResponse_Error struct {
+type TransferPublicationsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*AddDatasetsResponse_Message) isAddDatasetsResponse_Response() {}
+func (*TransferPublicationsResponse_Message) isTransferPublicationsResponse_Response() {}
 
-func (*AddDatasetsResponse_Error) isAddDatasetsResponse_Response() {}
+func (*TransferPublicationsResponse_Error) isTransferPublicationsResponse_Response() {}
 
-type ImportDatasetsRequest struct {
+type CleanupPublicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
 }
 
-func (x *ImportDatasetsRequest) Reset() {
-	*x = ImportDatasetsRequest{}
+func (x *CleanupPublicationsRequest) Reset() {
+	*x = CleanupPublicationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2958,13 +3007,13 @@ func (x *ImportDatasetsRequest) Reset() {
 	}
 }
 
-func (x *ImportDatasetsRequest) String() string {
+func (x *CleanupPublicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDatasetsRequest) ProtoMessage() {}
+func (*CleanupPublicationsRequest) ProtoMessage() {}
 
-func (x *ImportDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *CleanupPublicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2976,32 +3025,25 @@ func (x *ImportDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*ImportDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CleanupPublicationsRequest.ProtoReflect.Descriptor instead.
+func (*CleanupPublicationsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ImportDatasetsRequest) GetDataset() *Dataset {
-	if x != nil {
-		return x.Dataset
-	}
-	return nil
-}
-
-type ImportDatasetsResponse struct {
+type CleanupPublicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*ImportDatasetsResponse_Message
-	//	*ImportDatasetsResponse_Error
-	Response isImportDatasetsResponse_Response `protobuf_oneof:"response"`
+	//	*CleanupPublicationsResponse_Message
+	//	*CleanupPublicationsResponse_Error
+	Response isCleanupPublicationsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *ImportDatasetsResponse) Reset() {
-	*x = ImportDatasetsResponse{}
+func (x *CleanupPublicationsResponse) Reset() {
+	*x = CleanupPublicationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3009,13 +3051,13 @@ func (x *ImportDatasetsResponse) Reset() {
 	}
 }
 
-func (x *ImportDatasetsResponse) String() string {
+func (x *CleanupPublicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDatasetsResponse) ProtoMessage() {}
+func (*CleanupPublicationsResponse) ProtoMessage() {}
 
-func (x *ImportDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *CleanupPublicationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3027,49 +3069,49 @@ func (x *ImportDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*ImportDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CleanupPublicationsResponse.ProtoReflect.Descriptor instead.
+func (*CleanupPublicationsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{48}
 }
 
-func (m *ImportDatasetsResponse) GetResponse() isImportDatasetsResponse_Response {
+func (m *CleanupPublicationsResponse) GetResponse() isCleanupPublicationsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ImportDatasetsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*ImportDatasetsResponse_Message); ok {
+func (x *CleanupPublicationsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*CleanupPublicationsResponse_Message); ok {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ImportDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*ImportDatasetsResponse_Error); ok {
+func (x *CleanupPublicationsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*CleanupPublicationsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isImportDatasetsResponse_Response interface {
-	isImportDatasetsResponse_Response()
+type isCleanupPublicationsResponse_Response interface {
+	isCleanupPublicationsResponse_Response()
 }
 
-type ImportDatasetsResponse_Message struct {
+type CleanupPublicationsResponse_Message struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type ImportDatasetsResponse_Error struct {
+type CleanupPublicationsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ImportDatasetsResponse_Message) isImportDatasetsResponse_Response() {}
+func (*CleanupPublicationsResponse_Message) isCleanupPublicationsResponse_Response() {}
 
-func (*ImportDatasetsResponse_Error) isImportDatasetsResponse_Response() {}
+func (*CleanupPublicationsResponse_Error) isCleanupPublicationsResponse_Response() {}
 
-type GetDatasetHistoryRequest struct {
+type GetDatasetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3077,8 +3119,8 @@ type GetDatasetHistoryRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetDatasetHistoryRequest) Reset() {
-	*x = GetDatasetHistoryRequest{}
+func (x *GetDatasetRequest) Reset() {
+	*x = GetDatasetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3086,13 +3128,13 @@ func (x *GetDatasetHistoryRequest) Reset() {
 	}
 }
 
-func (x *GetDatasetHistoryRequest) String() string {
+func (x *GetDatasetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDatasetHistoryRequest) ProtoMessage() {}
+func (*GetDatasetRequest) ProtoMessage() {}
 
-func (x *GetDatasetHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDatasetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3104,32 +3146,32 @@ func (x *GetDatasetHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDatasetHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetDatasetHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDatasetRequest.ProtoReflect.Descriptor instead.
+func (*GetDatasetRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetDatasetHistoryRequest) GetId() string {
+func (x *GetDatasetRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetDatasetHistoryResponse struct {
+type GetDatasetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*GetDatasetHistoryResponse_Dataset
-	//	*GetDatasetHistoryResponse_Error
-	Response isGetDatasetHistoryResponse_Response `protobuf_oneof:"response"`
+	//	*GetDatasetResponse_Dataset
+	//	*GetDatasetResponse_Error
+	Response isGetDatasetResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetDatasetHistoryResponse) Reset() {
-	*x = GetDatasetHistoryResponse{}
+func (x *GetDatasetResponse) Reset() {
+	*x = GetDatasetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3137,13 +3179,13 @@ func (x *GetDatasetHistoryResponse) Reset() {
 	}
 }
 
-func (x *GetDatasetHistoryResponse) String() string {
+func (x *GetDatasetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDatasetHistoryResponse) ProtoMessage() {}
+func (*GetDatasetResponse) ProtoMessage() {}
 
-func (x *GetDatasetHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDatasetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3155,58 +3197,58 @@ func (x *GetDatasetHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDatasetHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetDatasetHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDatasetResponse.ProtoReflect.Descriptor instead.
+func (*GetDatasetResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{50}
 }
 
-func (m *GetDatasetHistoryResponse) GetResponse() isGetDatasetHistoryResponse_Response {
+func (m *GetDatasetResponse) GetResponse() isGetDatasetResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *GetDatasetHistoryResponse) GetDataset() *Dataset {
-	if x, ok := x.GetResponse().(*GetDatasetHistoryResponse_Dataset); ok {
+func (x *GetDatasetResponse) GetDataset() *Dataset {
+	if x, ok := x.GetResponse().(*GetDatasetResponse_Dataset); ok {
 		return x.Dataset
 	}
 	return nil
 }
 
-func (x *GetDatasetHistoryResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*GetDatasetHistoryResponse_Error); ok {
+func (x *GetDatasetResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetDatasetResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isGetDatasetHistoryResponse_Response interface {
-	isGetDatasetHistoryResponse_Response()
+type isGetDatasetResponse_Response interface {
+	isGetDatasetResponse_Response()
 }
 
-type GetDatasetHistoryResponse_Dataset struct {
+type GetDatasetResponse_Dataset struct {
 	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3,oneof"`
 }
 
-type GetDatasetHistoryResponse_Error struct {
+type GetDatasetResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*GetDatasetHistoryResponse_Dataset) isGetDatasetHistoryResponse_Response() {}
+func (*GetDatasetResponse_Dataset) isGetDatasetResponse_Response() {}
 
-func (*GetDatasetHistoryResponse_Error) isGetDatasetHistoryResponse_Response() {}
+func (*GetDatasetResponse_Error) isGetDatasetResponse_Response() {}
 
-type PurgeDatasetRequest struct {
+type GetAllDatasetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	At string `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
 }
 
-func (x *PurgeDatasetRequest) Reset() {
-	*x = PurgeDatasetRequest{}
+func (x *GetAllDatasetsRequest) Reset() {
+	*x = GetAllDatasetsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3214,13 +3256,13 @@ func (x *PurgeDatasetRequest) Reset() {
 	}
 }
 
-func (x *PurgeDatasetRequest) String() string {
+func (x *GetAllDatasetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDatasetRequest) ProtoMessage() {}
+func (*GetAllDatasetsRequest) ProtoMessage() {}
 
-func (x *PurgeDatasetRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAllDatasetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3232,32 +3274,32 @@ func (x *PurgeDatasetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDatasetRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDatasetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllDatasetsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *PurgeDatasetRequest) GetId() string {
+func (x *GetAllDatasetsRequest) GetAt() string {
 	if x != nil {
-		return x.Id
+		return x.At
 	}
 	return ""
 }
 
-type PurgeDatasetResponse struct {
+type GetAllDatasetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*PurgeDatasetResponse_Ok
-	//	*PurgeDatasetResponse_Error
-	Response isPurgeDatasetResponse_Response `protobuf_oneof:"response"`
+	//	*GetAllDatasetsResponse_Dataset
+	//	*GetAllDatasetsResponse_Error
+	Response isGetAllDatasetsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *PurgeDatasetResponse) Reset() {
-	*x = PurgeDatasetResponse{}
+func (x *GetAllDatasetsResponse) Reset() {
+	*x = GetAllDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3265,13 +3307,13 @@ func (x *PurgeDatasetResponse) Reset() {
 	}
 }
 
-func (x *PurgeDatasetResponse) String() string {
+func (x *GetAllDatasetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDatasetResponse) ProtoMessage() {}
+func (*GetAllDatasetsResponse) ProtoMessage() {}
 
-func (x *PurgeDatasetResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAllDatasetsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3283,58 +3325,60 @@ func (x *PurgeDatasetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDatasetResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDatasetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllDatasetsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{52}
 }
 
-func (m *PurgeDatasetResponse) GetResponse() isPurgeDatasetResponse_Response {
+func (m *GetAllDatasetsResponse) GetResponse() isGetAllDatasetsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *PurgeDatasetResponse) GetOk() bool {
-	if x, ok := x.GetResponse().(*PurgeDatasetResponse_Ok); ok {
-		return x.Ok
+func (x *GetAllDatasetsResponse) GetDataset() *Dataset {
+	if x, ok := x.GetResponse().(*GetAllDatasetsResponse_Dataset); ok {
+		return x.Dataset
 	}
-	return false
+	return nil
 }
 
-func (x *PurgeDatasetResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*PurgeDatasetResponse_Error); ok {
+func (x *GetAllDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetAllDatasetsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isPurgeDatasetResponse_Response interface {
-	isPurgeDatasetResponse_Response()
+type isGetAllDatasetsResponse_Response interface {
+	isGetAllDatasetsResponse_Response()
 }
 
-type PurgeDatasetResponse_Ok struct {
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
+type GetAllDatasetsResponse_Dataset struct {
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3,oneof"`
 }
 
-type PurgeDatasetResponse_Error struct {
+type GetAllDatasetsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*PurgeDatasetResponse_Ok) isPurgeDatasetResponse_Response() {}
+func (*GetAllDatasetsResponse_Dataset) isGetAllDatasetsResponse_Response() {}
 
-func (*PurgeDatasetResponse_Error) isPurgeDatasetResponse_Response() {}
+func (*GetAllDatasetsResponse_Error) isGetAllDatasetsResponse_Response() {}
 
-type PurgeAllDatasetsRequest struct {
+type SearchDatasetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Confirm bool `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *PurgeAllDatasetsRequest) Reset() {
-	*x = PurgeAllDatasetsRequest{}
+func (x *SearchDatasetsRequest) Reset() {
+	*x = SearchDatasetsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3342,13 +3386,13 @@ func (x *PurgeAllDatasetsRequest) Reset() {
 	}
 }
 
-func (x *PurgeAllDatasetsRequest) String() string {
+func (x *SearchDatasetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeAllDatasetsRequest) ProtoMessage() {}
+func (*SearchDatasetsRequest) ProtoMessage() {}
 
-func (x *PurgeAllDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchDatasetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3360,32 +3404,45 @@ func (x *PurgeAllDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeAllDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*PurgeAllDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*SearchDatasetsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *PurgeAllDatasetsRequest) GetConfirm() bool {
+func (x *SearchDatasetsRequest) GetQuery() string {
 	if x != nil {
-		return x.Confirm
+		return x.Query
 	}
-	return false
+	return ""
 }
 
-type PurgeAllDatasetsResponse struct {
+func (x *SearchDatasetsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchDatasetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchDatasetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*PurgeAllDatasetsResponse_Ok
-	//	*PurgeAllDatasetsResponse_Error
-	Response isPurgeAllDatasetsResponse_Response `protobuf_oneof:"response"`
+	Hits   []*Dataset `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	Offset int32      `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32      `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total  int32      `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *PurgeAllDatasetsResponse) Reset() {
-	*x = PurgeAllDatasetsResponse{}
+func (x *SearchDatasetsResponse) Reset() {
+	*x = SearchDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3393,13 +3450,13 @@ func (x *PurgeAllDatasetsResponse) Reset() {
 	}
 }
 
-func (x *PurgeAllDatasetsResponse) String() string {
+func (x *SearchDatasetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeAllDatasetsResponse) ProtoMessage() {}
+func (*SearchDatasetsResponse) ProtoMessage() {}
 
-func (x *PurgeAllDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchDatasetsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3411,49 +3468,40 @@ func (x *PurgeAllDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeAllDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*PurgeAllDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*SearchDatasetsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{54}
 }
 
-func (m *PurgeAllDatasetsResponse) GetResponse() isPurgeAllDatasetsResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *SearchDatasetsResponse) GetHits() []*Dataset {
+	if x != nil {
+		return x.Hits
 	}
 	return nil
 }
 
-func (x *PurgeAllDatasetsResponse) GetOk() bool {
-	if x, ok := x.GetResponse().(*PurgeAllDatasetsResponse_Ok); ok {
-		return x.Ok
+func (x *SearchDatasetsResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
 	}
-	return false
+	return 0
 }
 
-func (x *PurgeAllDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*PurgeAllDatasetsResponse_Error); ok {
-		return x.Error
+func (x *SearchDatasetsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	return nil
-}
-
-type isPurgeAllDatasetsResponse_Response interface {
-	isPurgeAllDatasetsResponse_Response()
-}
-
-type PurgeAllDatasetsResponse_Ok struct {
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
+	return 0
 }
 
-type PurgeAllDatasetsResponse_Error struct {
-	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (x *SearchDatasetsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (*PurgeAllDatasetsResponse_Ok) isPurgeAllDatasetsResponse_Response() {}
-
-func (*PurgeAllDatasetsResponse_Error) isPurgeAllDatasetsResponse_Response() {}
-
-type ValidateDatasetsRequest struct {
+type UpdateDatasetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3461,8 +3509,8 @@ type ValidateDatasetsRequest struct {
 	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
 }
 
-func (x *ValidateDatasetsRequest) Reset() {
-	*x = ValidateDatasetsRequest{}
+func (x *UpdateDatasetRequest) Reset() {
+	*x = UpdateDatasetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3470,13 +3518,13 @@ func (x *ValidateDatasetsRequest) Reset() {
 	}
 }
 
-func (x *ValidateDatasetsRequest) String() string {
+func (x *UpdateDatasetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateDatasetsRequest) ProtoMessage() {}
+func (*UpdateDatasetRequest) ProtoMessage() {}
 
-func (x *ValidateDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDatasetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3488,32 +3536,32 @@ func (x *ValidateDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*ValidateDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDatasetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDatasetRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ValidateDatasetsRequest) GetDataset() *Dataset {
+func (x *UpdateDatasetRequest) GetDataset() *Dataset {
 	if x != nil {
 		return x.Dataset
 	}
 	return nil
 }
 
-type ValidateDatasetsResponse struct {
+type UpdateDatasetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*ValidateDatasetsResponse_Results
-	//	*ValidateDatasetsResponse_Error
-	Response isValidateDatasetsResponse_Response `protobuf_oneof:"response"`
+	//	*UpdateDatasetResponse_Message
+	//	*UpdateDatasetResponse_Error
+	Response isUpdateDatasetResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *ValidateDatasetsResponse) Reset() {
-	*x = ValidateDatasetsResponse{}
+func (x *UpdateDatasetResponse) Reset() {
+	*x = UpdateDatasetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3521,13 +3569,13 @@ func (x *ValidateDatasetsResponse) Reset() {
 	}
 }
 
-func (x *ValidateDatasetsResponse) String() string {
+func (x *UpdateDatasetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateDatasetsResponse) ProtoMessage() {}
+func (*UpdateDatasetResponse) ProtoMessage() {}
 
-func (x *ValidateDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateDatasetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3539,56 +3587,58 @@ func (x *ValidateDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*ValidateDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDatasetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDatasetResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{56}
 }
 
-func (m *ValidateDatasetsResponse) GetResponse() isValidateDatasetsResponse_Response {
+func (m *UpdateDatasetResponse) GetResponse() isUpdateDatasetResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ValidateDatasetsResponse) GetResults() *ValidateResults {
-	if x, ok := x.GetResponse().(*ValidateDatasetsResponse_Results); ok {
-		return x.Results
+func (x *UpdateDatasetResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*UpdateDatasetResponse_Message); ok {
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *ValidateDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*ValidateDatasetsResponse_Error); ok {
+func (x *UpdateDatasetResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*UpdateDatasetResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isValidateDatasetsResponse_Response interface {
-	isValidateDatasetsResponse_Response()
+type isUpdateDatasetResponse_Response interface {
+	isUpdateDatasetResponse_Response()
 }
 
-type ValidateDatasetsResponse_Results struct {
-	Results *ValidateResults `protobuf:"bytes,1,opt,name=results,proto3,oneof"`
+type UpdateDatasetResponse_Message struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type ValidateDatasetsResponse_Error struct {
+type UpdateDatasetResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ValidateDatasetsResponse_Results) isValidateDatasetsResponse_Response() {}
+func (*UpdateDatasetResponse_Message) isUpdateDatasetResponse_Response() {}
 
-func (*ValidateDatasetsResponse_Error) isValidateDatasetsResponse_Response() {}
+func (*UpdateDatasetResponse_Error) isUpdateDatasetResponse_Response() {}
 
-type ReindexDatasetsRequest struct {
+type AddDatasetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
 }
 
-func (x *ReindexDatasetsRequest) Reset() {
-	*x = ReindexDatasetsRequest{}
+func (x *AddDatasetsRequest) Reset() {
+	*x = AddDatasetsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3596,13 +3646,13 @@ func (x *ReindexDatasetsRequest) Reset() {
 	}
 }
 
-func (x *ReindexDatasetsRequest) String() string {
+func (x *AddDatasetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexDatasetsRequest) ProtoMessage() {}
+func (*AddDatasetsRequest) ProtoMessage() {}
 
-func (x *ReindexDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddDatasetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3614,25 +3664,32 @@ func (x *ReindexDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*ReindexDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*AddDatasetsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{57}
 }
 
-type ReindexDatasetsResponse struct {
+func (x *AddDatasetsRequest) GetDataset() *Dataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+type AddDatasetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*ReindexDatasetsResponse_Message
-	//	*ReindexDatasetsResponse_Error
-	Response isReindexDatasetsResponse_Response `protobuf_oneof:"response"`
+	//	*AddDatasetsResponse_Message
+	//	*AddDatasetsResponse_Error
+	Response isAddDatasetsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *ReindexDatasetsResponse) Reset() {
-	*x = ReindexDatasetsResponse{}
+func (x *AddDatasetsResponse) Reset() {
+	*x = AddDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3640,13 +3697,13 @@ func (x *ReindexDatasetsResponse) Reset() {
 	}
 }
 
-func (x *ReindexDatasetsResponse) String() string {
+func (x *AddDatasetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexDatasetsResponse) ProtoMessage() {}
+func (*AddDatasetsResponse) ProtoMessage() {}
 
-func (x *ReindexDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *AddDatasetsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3658,56 +3715,58 @@ func (x *ReindexDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*ReindexDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*AddDatasetsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{58}
 }
 
-func (m *ReindexDatasetsResponse) GetResponse() isReindexDatasetsResponse_Response {
+func (m *AddDatasetsResponse) GetResponse() isAddDatasetsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ReindexDatasetsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*ReindexDatasetsResponse_Message); ok {
+func (x *AddDatasetsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*AddDatasetsResponse_Message); ok {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ReindexDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*ReindexDatasetsResponse_Error); ok {
+func (x *AddDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*AddDatasetsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isReindexDatasetsResponse_Response interface {
-	isReindexDatasetsResponse_Response()
+type isAddDatasetsResponse_Response interface {
+	isAddDatasetsResponse_Response()
 }
 
-type ReindexDatasetsResponse_Message struct {
+type AddDatasetsResponse_Message struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type ReindexDatasetsResponse_Error struct {
+type AddDatasetsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ReindexDatasetsResponse_Message) isReindexDatasetsResponse_Response() {}
+func (*AddDatasetsResponse_Message) isAddDatasetsResponse_Response() {}
 
-func (*ReindexDatasetsResponse_Error) isReindexDatasetsResponse_Response() {}
+func (*AddDatasetsResponse_Error) isAddDatasetsResponse_Response() {}
 
-type CleanupDatasetsRequest struct {
+type ImportDatasetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
 }
 
-func (x *CleanupDatasetsRequest) Reset() {
-	*x = CleanupDatasetsRequest{}
+func (x *ImportDatasetsRequest) Reset() {
+	*x = ImportDatasetsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3715,13 +3774,13 @@ func (x *CleanupDatasetsRequest) Reset() {
 	}
 }
 
-func (x *CleanupDatasetsRequest) String() string {
+func (x *ImportDatasetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupDatasetsRequest) ProtoMessage() {}
+func (*ImportDatasetsRequest) ProtoMessage() {}
 
-func (x *CleanupDatasetsRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportDatasetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3733,25 +3792,32 @@ func (x *CleanupDatasetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupDatasetsRequest.ProtoReflect.Descriptor instead.
-func (*CleanupDatasetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*ImportDatasetsRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{59}
 }
 
-type CleanupDatasetsResponse struct {
+func (x *ImportDatasetsRequest) GetDataset() *Dataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+type ImportDatasetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*CleanupDatasetsResponse_Message
-	//	*CleanupDatasetsResponse_Error
-	Response isCleanupDatasetsResponse_Response `protobuf_oneof:"response"`
+	//	*ImportDatasetsResponse_Message
+	//	*ImportDatasetsResponse_Error
+	Response isImportDatasetsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *CleanupDatasetsResponse) Reset() {
-	*x = CleanupDatasetsResponse{}
+func (x *ImportDatasetsResponse) Reset() {
+	*x = ImportDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3759,13 +3825,13 @@ func (x *CleanupDatasetsResponse) Reset() {
 	}
 }
 
-func (x *CleanupDatasetsResponse) String() string {
+func (x *ImportDatasetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupDatasetsResponse) ProtoMessage() {}
+func (*ImportDatasetsResponse) ProtoMessage() {}
 
-func (x *CleanupDatasetsResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportDatasetsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3777,67 +3843,58 @@ func (x *CleanupDatasetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupDatasetsResponse.ProtoReflect.Descriptor instead.
-func (*CleanupDatasetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*ImportDatasetsResponse) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{60}
 }
 
-func (m *CleanupDatasetsResponse) GetResponse() isCleanupDatasetsResponse_Response {
+func (m *ImportDatasetsResponse) GetResponse() isImportDatasetsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *CleanupDatasetsResponse) GetMessage() string {
-	if x, ok := x.GetResponse().(*CleanupDatasetsResponse_Message); ok {
+func (x *ImportDatasetsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*ImportDatasetsResponse_Message); ok {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CleanupDatasetsResponse) GetError() *status.Status {
-	if x, ok := x.GetResponse().(*CleanupDatasetsResponse_Error); ok {
+func (x *ImportDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*ImportDatasetsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isCleanupDatasetsResponse_Response interface {
-	isCleanupDatasetsResponse_Response()
+type isImportDatasetsResponse_Response interface {
+	isImportDatasetsResponse_Response()
 }
 
-type CleanupDatasetsResponse_Message struct {
+type ImportDatasetsResponse_Message struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type CleanupDatasetsResponse_Error struct {
+type ImportDatasetsResponse_Error struct {
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*CleanupDatasetsResponse_Message) isCleanupDatasetsResponse_Response() {}
+func (*ImportDatasetsResponse_Message) isImportDatasetsResponse_Response() {}
 
-func (*CleanupDatasetsResponse_Error) isCleanupDatasetsResponse_Response() {}
+func (*ImportDatasetsResponse_Error) isImportDatasetsResponse_Response() {}
 
-type RelateRequest struct {
+type GetDatasetHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to One:
-	//
-	//	*RelateRequest_PublicationOne
-	//	*RelateRequest_DatasetOne
-	One isRelateRequest_One `protobuf_oneof:"one"`
-	// Types that are assignable to Two:
-	//
-	//	*RelateRequest_PublicationTwo
-	//	*RelateRequest_DatasetTwo
-	Two isRelateRequest_Two `protobuf_oneof:"two"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RelateRequest) Reset() {
-	*x = RelateRequest{}
+func (x *GetDatasetHistoryRequest) Reset() {
+	*x = GetDatasetHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_biblio_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3845,13 +3902,13 @@ func (x *RelateRequest) Reset() {
 	}
 }
 
-func (x *RelateRequest) String() string {
+func (x *GetDatasetHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelateRequest) ProtoMessage() {}
+func (*GetDatasetHistoryRequest) ProtoMessage() {}
 
-func (x *RelateRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDatasetHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_biblio_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3863,114 +3920,175 @@ func (x *RelateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelateRequest.ProtoReflect.Descriptor instead.
-func (*RelateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDatasetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDatasetHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_biblio_proto_rawDescGZIP(), []int{61}
 }
 
-func (m *RelateRequest) GetOne() isRelateRequest_One {
-	if m != nil {
-		return m.One
+func (x *GetDatasetHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *RelateRequest) GetPublicationOne() string {
-	if x, ok := x.GetOne().(*RelateRequest_PublicationOne); ok {
-		return x.PublicationOne
+type GetDatasetHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetDatasetHistoryResponse_Dataset
+	//	*GetDatasetHistoryResponse_Error
+	Response isGetDatasetHistoryResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetDatasetHistoryResponse) Reset() {
+	*x = GetDatasetHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RelateRequest) GetDatasetOne() string {
-	if x, ok := x.GetOne().(*RelateRequest_DatasetOne); ok {
-		return x.DatasetOne
+func (x *GetDatasetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatasetHistoryResponse) ProtoMessage() {}
+
+func (x *GetDatasetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *RelateRequest) GetTwo() isRelateRequest_Two {
+// Deprecated: Use GetDatasetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDatasetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{62}
+}
+
+func (m *GetDatasetHistoryResponse) GetResponse() isGetDatasetHistoryResponse_Response {
 	if m != nil {
-		return m.Two
+		return m.Response
 	}
 	return nil
 }
 
-func (x *RelateRequest) GetPublicationTwo() string {
-	if x, ok := x.GetTwo().(*RelateRequest_PublicationTwo); ok {
-		return x.PublicationTwo
+func (x *GetDatasetHistoryResponse) GetDataset() *Dataset {
+	if x, ok := x.GetResponse().(*GetDatasetHistoryResponse_Dataset); ok {
+		return x.Dataset
 	}
-	return ""
+	return nil
 }
 
-func (x *RelateRequest) GetDatasetTwo() string {
-	if x, ok := x.GetTwo().(*RelateRequest_DatasetTwo); ok {
-		return x.DatasetTwo
+func (x *GetDatasetHistoryResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetDatasetHistoryResponse_Error); ok {
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type isRelateRequest_One interface {
-	isRelateRequest_One()
+type isGetDatasetHistoryResponse_Response interface {
+	isGetDatasetHistoryResponse_Response()
 }
 
-type RelateRequest_PublicationOne struct {
-	PublicationOne string `protobuf:"bytes,1,opt,name=publication_one,json=publicationOne,proto3,oneof"`
+type GetDatasetHistoryResponse_Dataset struct {
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3,oneof"`
 }
 
-type RelateRequest_DatasetOne struct {
-	DatasetOne string `protobuf:"bytes,2,opt,name=dataset_one,json=datasetOne,proto3,oneof"`
+type GetDatasetHistoryResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*RelateRequest_PublicationOne) isRelateRequest_One() {}
+func (*GetDatasetHistoryResponse_Dataset) isGetDatasetHistoryResponse_Response() {}
 
-func (*RelateRequest_DatasetOne) isRelateRequest_One() {}
+func (*GetDatasetHistoryResponse_Error) isGetDatasetHistoryResponse_Response() {}
 
-type isRelateRequest_Two interface {
-	isRelateRequest_Two()
+type GetDatasetChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-type RelateRequest_PublicationTwo struct {
-	PublicationTwo string `protobuf:"bytes,3,opt,name=publication_two,json=publicationTwo,proto3,oneof"`
+func (x *GetDatasetChangesRequest) Reset() {
+	*x = GetDatasetChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type RelateRequest_DatasetTwo struct {
-	DatasetTwo string `protobuf:"bytes,4,opt,name=dataset_two,json=datasetTwo,proto3,oneof"`
+func (x *GetDatasetChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelateRequest_PublicationTwo) isRelateRequest_Two() {}
+func (*GetDatasetChangesRequest) ProtoMessage() {}
 
-func (*RelateRequest_DatasetTwo) isRelateRequest_Two() {}
+func (x *GetDatasetChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type RelateResponse struct {
+// Deprecated: Use GetDatasetChangesRequest.ProtoReflect.Descriptor instead.
+func (*GetDatasetChangesRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetDatasetChangesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDatasetChangesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*RelateResponse_Message
-	//	*RelateResponse_Error
-	Response isRelateResponse_Response `protobuf_oneof:"response"`
+	//	*GetDatasetChangesResponse_Entry
+	//	*GetDatasetChangesResponse_Error
+	Response isGetDatasetChangesResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *RelateResponse) Reset() {
-	*x = RelateResponse{}
+func (x *GetDatasetChangesResponse) Reset() {
+	*x = GetDatasetChangesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_biblio_proto_msgTypes[62]
+		mi := &file_biblio_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelateResponse) String() string {
+func (x *GetDatasetChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelateResponse) ProtoMessage() {}
+func (*GetDatasetChangesResponse) ProtoMessage() {}
 
-func (x *RelateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_biblio_proto_msgTypes[62]
+func (x *GetDatasetChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3981,9 +4099,1267 @@ func (x *RelateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelateResponse.ProtoReflect.Descriptor instead.
-func (*RelateResponse) Descriptor() ([]byte, []int) {
-	return file_biblio_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetDatasetChangesResponse.ProtoReflect.Descriptor instead.
+func (*GetDatasetChangesResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{64}
+}
+
+func (m *GetDatasetChangesResponse) GetResponse() isGetDatasetChangesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetDatasetChangesResponse) GetEntry() *HistoryEntry {
+	if x, ok := x.GetResponse().(*GetDatasetChangesResponse_Entry); ok {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *GetDatasetChangesResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetDatasetChangesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetDatasetChangesResponse_Response interface {
+	isGetDatasetChangesResponse_Response()
+}
+
+type GetDatasetChangesResponse_Entry struct {
+	Entry *HistoryEntry `protobuf:"bytes,1,opt,name=entry,proto3,oneof"`
+}
+
+type GetDatasetChangesResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetDatasetChangesResponse_Entry) isGetDatasetChangesResponse_Response() {}
+
+func (*GetDatasetChangesResponse_Error) isGetDatasetChangesResponse_Response() {}
+
+type RestoreDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RestoreDatasetRequest) Reset() {
+	*x = RestoreDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDatasetRequest) ProtoMessage() {}
+
+func (x *RestoreDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDatasetRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RestoreDatasetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreDatasetRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *RestoreDatasetRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestoreDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RestoreDatasetResponse_Dataset
+	//	*RestoreDatasetResponse_Error
+	Response isRestoreDatasetResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RestoreDatasetResponse) Reset() {
+	*x = RestoreDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDatasetResponse) ProtoMessage() {}
+
+func (x *RestoreDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDatasetResponse.ProtoReflect.Descriptor instead.
+func (*RestoreDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{66}
+}
+
+func (m *RestoreDatasetResponse) GetResponse() isRestoreDatasetResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RestoreDatasetResponse) GetDataset() *Dataset {
+	if x, ok := x.GetResponse().(*RestoreDatasetResponse_Dataset); ok {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *RestoreDatasetResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*RestoreDatasetResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRestoreDatasetResponse_Response interface {
+	isRestoreDatasetResponse_Response()
+}
+
+type RestoreDatasetResponse_Dataset struct {
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3,oneof"`
+}
+
+type RestoreDatasetResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RestoreDatasetResponse_Dataset) isRestoreDatasetResponse_Response() {}
+
+func (*RestoreDatasetResponse_Error) isRestoreDatasetResponse_Response() {}
+
+type GetDatasetCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDatasetCommentsRequest) Reset() {
+	*x = GetDatasetCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDatasetCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatasetCommentsRequest) ProtoMessage() {}
+
+func (x *GetDatasetCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatasetCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetDatasetCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDatasetCommentsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDatasetCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetDatasetCommentsResponse_Comment
+	//	*GetDatasetCommentsResponse_Error
+	Response isGetDatasetCommentsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetDatasetCommentsResponse) Reset() {
+	*x = GetDatasetCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDatasetCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatasetCommentsResponse) ProtoMessage() {}
+
+func (x *GetDatasetCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatasetCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetDatasetCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{68}
+}
+
+func (m *GetDatasetCommentsResponse) GetResponse() isGetDatasetCommentsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetDatasetCommentsResponse) GetComment() *Comment {
+	if x, ok := x.GetResponse().(*GetDatasetCommentsResponse_Comment); ok {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *GetDatasetCommentsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*GetDatasetCommentsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetDatasetCommentsResponse_Response interface {
+	isGetDatasetCommentsResponse_Response()
+}
+
+type GetDatasetCommentsResponse_Comment struct {
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3,oneof"`
+}
+
+type GetDatasetCommentsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetDatasetCommentsResponse_Comment) isGetDatasetCommentsResponse_Response() {}
+
+func (*GetDatasetCommentsResponse_Error) isGetDatasetCommentsResponse_Response() {}
+
+type AddDatasetCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId   string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	UserId     string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Body       string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Visibility string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+}
+
+func (x *AddDatasetCommentRequest) Reset() {
+	*x = AddDatasetCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDatasetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDatasetCommentRequest) ProtoMessage() {}
+
+func (x *AddDatasetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDatasetCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddDatasetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AddDatasetCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddDatasetCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AddDatasetCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddDatasetCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *AddDatasetCommentRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type AddDatasetCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AddDatasetCommentResponse_Comment
+	//	*AddDatasetCommentResponse_Error
+	Response isAddDatasetCommentResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AddDatasetCommentResponse) Reset() {
+	*x = AddDatasetCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDatasetCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDatasetCommentResponse) ProtoMessage() {}
+
+func (x *AddDatasetCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDatasetCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddDatasetCommentResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{70}
+}
+
+func (m *AddDatasetCommentResponse) GetResponse() isAddDatasetCommentResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AddDatasetCommentResponse) GetComment() *Comment {
+	if x, ok := x.GetResponse().(*AddDatasetCommentResponse_Comment); ok {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *AddDatasetCommentResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*AddDatasetCommentResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isAddDatasetCommentResponse_Response interface {
+	isAddDatasetCommentResponse_Response()
+}
+
+type AddDatasetCommentResponse_Comment struct {
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3,oneof"`
+}
+
+type AddDatasetCommentResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*AddDatasetCommentResponse_Comment) isAddDatasetCommentResponse_Response() {}
+
+func (*AddDatasetCommentResponse_Error) isAddDatasetCommentResponse_Response() {}
+
+type PurgeDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PurgeDatasetRequest) Reset() {
+	*x = PurgeDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDatasetRequest) ProtoMessage() {}
+
+func (x *PurgeDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDatasetRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PurgeDatasetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PurgeDatasetResponse_Ok
+	//	*PurgeDatasetResponse_Error
+	Response isPurgeDatasetResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PurgeDatasetResponse) Reset() {
+	*x = PurgeDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDatasetResponse) ProtoMessage() {}
+
+func (x *PurgeDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDatasetResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{72}
+}
+
+func (m *PurgeDatasetResponse) GetResponse() isPurgeDatasetResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PurgeDatasetResponse) GetOk() bool {
+	if x, ok := x.GetResponse().(*PurgeDatasetResponse_Ok); ok {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *PurgeDatasetResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*PurgeDatasetResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isPurgeDatasetResponse_Response interface {
+	isPurgeDatasetResponse_Response()
+}
+
+type PurgeDatasetResponse_Ok struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
+}
+
+type PurgeDatasetResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PurgeDatasetResponse_Ok) isPurgeDatasetResponse_Response() {}
+
+func (*PurgeDatasetResponse_Error) isPurgeDatasetResponse_Response() {}
+
+type PurgeAllDatasetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confirm bool `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"`
+}
+
+func (x *PurgeAllDatasetsRequest) Reset() {
+	*x = PurgeAllDatasetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeAllDatasetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAllDatasetsRequest) ProtoMessage() {}
+
+func (x *PurgeAllDatasetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAllDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeAllDatasetsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PurgeAllDatasetsRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type PurgeAllDatasetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PurgeAllDatasetsResponse_Ok
+	//	*PurgeAllDatasetsResponse_Error
+	Response isPurgeAllDatasetsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PurgeAllDatasetsResponse) Reset() {
+	*x = PurgeAllDatasetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeAllDatasetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAllDatasetsResponse) ProtoMessage() {}
+
+func (x *PurgeAllDatasetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAllDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeAllDatasetsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{74}
+}
+
+func (m *PurgeAllDatasetsResponse) GetResponse() isPurgeAllDatasetsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PurgeAllDatasetsResponse) GetOk() bool {
+	if x, ok := x.GetResponse().(*PurgeAllDatasetsResponse_Ok); ok {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *PurgeAllDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*PurgeAllDatasetsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isPurgeAllDatasetsResponse_Response interface {
+	isPurgeAllDatasetsResponse_Response()
+}
+
+type PurgeAllDatasetsResponse_Ok struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3,oneof"`
+}
+
+type PurgeAllDatasetsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PurgeAllDatasetsResponse_Ok) isPurgeAllDatasetsResponse_Response() {}
+
+func (*PurgeAllDatasetsResponse_Error) isPurgeAllDatasetsResponse_Response() {}
+
+type ValidateDatasetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+}
+
+func (x *ValidateDatasetsRequest) Reset() {
+	*x = ValidateDatasetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateDatasetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDatasetsRequest) ProtoMessage() {}
+
+func (x *ValidateDatasetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateDatasetsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ValidateDatasetsRequest) GetDataset() *Dataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+type ValidateDatasetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ValidateDatasetsResponse_Results
+	//	*ValidateDatasetsResponse_Error
+	Response isValidateDatasetsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ValidateDatasetsResponse) Reset() {
+	*x = ValidateDatasetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateDatasetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDatasetsResponse) ProtoMessage() {}
+
+func (x *ValidateDatasetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateDatasetsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{76}
+}
+
+func (m *ValidateDatasetsResponse) GetResponse() isValidateDatasetsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ValidateDatasetsResponse) GetResults() *ValidateResults {
+	if x, ok := x.GetResponse().(*ValidateDatasetsResponse_Results); ok {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ValidateDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*ValidateDatasetsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isValidateDatasetsResponse_Response interface {
+	isValidateDatasetsResponse_Response()
+}
+
+type ValidateDatasetsResponse_Results struct {
+	Results *ValidateResults `protobuf:"bytes,1,opt,name=results,proto3,oneof"`
+}
+
+type ValidateDatasetsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ValidateDatasetsResponse_Results) isValidateDatasetsResponse_Response() {}
+
+func (*ValidateDatasetsResponse_Error) isValidateDatasetsResponse_Response() {}
+
+type ReindexDatasetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReindexDatasetsRequest) Reset() {
+	*x = ReindexDatasetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexDatasetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexDatasetsRequest) ProtoMessage() {}
+
+func (x *ReindexDatasetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*ReindexDatasetsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{77}
+}
+
+type ReindexDatasetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ReindexDatasetsResponse_Message
+	//	*ReindexDatasetsResponse_Error
+	Response isReindexDatasetsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ReindexDatasetsResponse) Reset() {
+	*x = ReindexDatasetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexDatasetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexDatasetsResponse) ProtoMessage() {}
+
+func (x *ReindexDatasetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*ReindexDatasetsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{78}
+}
+
+func (m *ReindexDatasetsResponse) GetResponse() isReindexDatasetsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ReindexDatasetsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*ReindexDatasetsResponse_Message); ok {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReindexDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*ReindexDatasetsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isReindexDatasetsResponse_Response interface {
+	isReindexDatasetsResponse_Response()
+}
+
+type ReindexDatasetsResponse_Message struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type ReindexDatasetsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ReindexDatasetsResponse_Message) isReindexDatasetsResponse_Response() {}
+
+func (*ReindexDatasetsResponse_Error) isReindexDatasetsResponse_Response() {}
+
+type CleanupDatasetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CleanupDatasetsRequest) Reset() {
+	*x = CleanupDatasetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupDatasetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupDatasetsRequest) ProtoMessage() {}
+
+func (x *CleanupDatasetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*CleanupDatasetsRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{79}
+}
+
+type CleanupDatasetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CleanupDatasetsResponse_Message
+	//	*CleanupDatasetsResponse_Error
+	Response isCleanupDatasetsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *CleanupDatasetsResponse) Reset() {
+	*x = CleanupDatasetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupDatasetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupDatasetsResponse) ProtoMessage() {}
+
+func (x *CleanupDatasetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*CleanupDatasetsResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{80}
+}
+
+func (m *CleanupDatasetsResponse) GetResponse() isCleanupDatasetsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CleanupDatasetsResponse) GetMessage() string {
+	if x, ok := x.GetResponse().(*CleanupDatasetsResponse_Message); ok {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CleanupDatasetsResponse) GetError() *status.Status {
+	if x, ok := x.GetResponse().(*CleanupDatasetsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCleanupDatasetsResponse_Response interface {
+	isCleanupDatasetsResponse_Response()
+}
+
+type CleanupDatasetsResponse_Message struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type CleanupDatasetsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CleanupDatasetsResponse_Message) isCleanupDatasetsResponse_Response() {}
+
+func (*CleanupDatasetsResponse_Error) isCleanupDatasetsResponse_Response() {}
+
+type RelateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to One:
+	//
+	//	*RelateRequest_PublicationOne
+	//	*RelateRequest_DatasetOne
+	One isRelateRequest_One `protobuf_oneof:"one"`
+	// Types that are assignable to Two:
+	//
+	//	*RelateRequest_PublicationTwo
+	//	*RelateRequest_DatasetTwo
+	Two isRelateRequest_Two `protobuf_oneof:"two"`
+}
+
+func (x *RelateRequest) Reset() {
+	*x = RelateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelateRequest) ProtoMessage() {}
+
+func (x *RelateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelateRequest.ProtoReflect.Descriptor instead.
+func (*RelateRequest) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{81}
+}
+
+func (m *RelateRequest) GetOne() isRelateRequest_One {
+	if m != nil {
+		return m.One
+	}
+	return nil
+}
+
+func (x *RelateRequest) GetPublicationOne() string {
+	if x, ok := x.GetOne().(*RelateRequest_PublicationOne); ok {
+		return x.PublicationOne
+	}
+	return ""
+}
+
+func (x *RelateRequest) GetDatasetOne() string {
+	if x, ok := x.GetOne().(*RelateRequest_DatasetOne); ok {
+		return x.DatasetOne
+	}
+	return ""
+}
+
+func (m *RelateRequest) GetTwo() isRelateRequest_Two {
+	if m != nil {
+		return m.Two
+	}
+	return nil
+}
+
+func (x *RelateRequest) GetPublicationTwo() string {
+	if x, ok := x.GetTwo().(*RelateRequest_PublicationTwo); ok {
+		return x.PublicationTwo
+	}
+	return ""
+}
+
+func (x *RelateRequest) GetDatasetTwo() string {
+	if x, ok := x.GetTwo().(*RelateRequest_DatasetTwo); ok {
+		return x.DatasetTwo
+	}
+	return ""
+}
+
+type isRelateRequest_One interface {
+	isRelateRequest_One()
+}
+
+type RelateRequest_PublicationOne struct {
+	PublicationOne string `protobuf:"bytes,1,opt,name=publication_one,json=publicationOne,proto3,oneof"`
+}
+
+type RelateRequest_DatasetOne struct {
+	DatasetOne string `protobuf:"bytes,2,opt,name=dataset_one,json=datasetOne,proto3,oneof"`
+}
+
+func (*RelateRequest_PublicationOne) isRelateRequest_One() {}
+
+func (*RelateRequest_DatasetOne) isRelateRequest_One() {}
+
+type isRelateRequest_Two interface {
+	isRelateRequest_Two()
+}
+
+type RelateRequest_PublicationTwo struct {
+	PublicationTwo string `protobuf:"bytes,3,opt,name=publication_two,json=publicationTwo,proto3,oneof"`
+}
+
+type RelateRequest_DatasetTwo struct {
+	DatasetTwo string `protobuf:"bytes,4,opt,name=dataset_two,json=datasetTwo,proto3,oneof"`
+}
+
+func (*RelateRequest_PublicationTwo) isRelateRequest_Two() {}
+
+func (*RelateRequest_DatasetTwo) isRelateRequest_Two() {}
+
+type RelateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RelateResponse_Message
+	//	*RelateResponse_Error
+	Response isRelateResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RelateResponse) Reset() {
+	*x = RelateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_biblio_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelateResponse) ProtoMessage() {}
+
+func (x *RelateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_biblio_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelateResponse.ProtoReflect.Descriptor instead.
+func (*RelateResponse) Descriptor() ([]byte, []int) {
+	return file_biblio_proto_rawDescGZIP(), []int{82}
 }
 
 func (m *RelateResponse) GetResponse() isRelateResponse_Response {
@@ -4034,484 +5410,677 @@ var file_biblio_proto_rawDesc = []byte{
 	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x23, 0x0a, 0x07, 0x44,
 	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x22, 0x43, 0x0a, 0x0d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f,
-	0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x22, 0x28, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x23, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x43, 0x0a, 0x0d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x2b, 0x0a,
+	0x11, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x2c, 0x0a, 0x12, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x22, 0x63, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x2a, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8c,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a,
+	0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c,
+	0x01, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x54, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
+	0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x55, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x1a, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
 	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x2b,
-	0x0a, 0x11, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x2c, 0x0a, 0x12, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0x63, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x2a,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x8c, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f,
-	0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x8c, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
-	0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
-	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x54,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x88, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x19,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75,
 	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
+	0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a,
 	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x38, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x17, 0x41, 0x64, 0x64,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62,
-	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x70, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x65, 0x22, 0x98, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x87, 0x01, 0x0a,
+	0x1d, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x64, 0x22, 0x64, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12,
 	0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x64, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b,
-	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x1b, 0x50, 0x75, 0x72, 0x67,
-	0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x22, 0x68, 0x0a, 0x1c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x02, 0x6f, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x1b, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x71, 0x0a, 0x1b, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64,
-	0x22, 0x72, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x1b, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x22, 0x68, 0x0a, 0x1c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c,
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x71, 0x0a, 0x1b, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x12, 0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02,
+	0x6f, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x18, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x72,
+	0x76, 0x69, 0x76, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x64, 0x49, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x19, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x4d, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8e,
+	0x01, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x71, 0x0a,
+	0x1b, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x69, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22, 0x72, 0x0a, 0x1c, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x71, 0x0a,
+	0x1b, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x22, 0x80, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x5b, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x84, 0x01, 0x0a,
+	0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x6b, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x69, 0x0a, 0x13, 0x41, 0x64,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
 	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2e, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x6c, 0x0a, 0x16,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x60, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07,
 	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
 	0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
 	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04,
-	0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62,
-	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x04,
-	0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x6b,
-	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x41,
-	0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22,
-	0x69, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x22, 0x6c, 0x0a, 0x16, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x84, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0x83, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x14,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x22, 0x64, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x6f,
+	0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
 	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62,
-	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x00,
-	0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x18, 0x0a, 0x16, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x65, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x6d, 0x0a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x25, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x14, 0x50, 0x75, 0x72, 0x67,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02,
-	0x6f, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x50, 0x75,
-	0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22,
-	0x64, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x02, 0x6f,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2a, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x8a,
-	0x01, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
-	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x52,
-	0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44,
-	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6d,
-	0x0a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb9, 0x01,
-	0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12, 0x29, 0x0a,
-	0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x77, 0x6f,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x77, 0x6f, 0x12, 0x21, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x42, 0x05, 0x0a, 0x03, 0x6f,
-	0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x74, 0x77, 0x6f, 0x22, 0x64, 0x0a, 0x0e, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0xf9, 0x15, 0x0a, 0x06, 0x42, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x12, 0x42, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42,
-	0x0a, 0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c,
-	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x65, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x65, 0x12, 0x21,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4f, 0x6e,
+	0x65, 0x12, 0x29, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x77, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x77, 0x6f, 0x12, 0x21, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x42,
+	0x05, 0x0a, 0x03, 0x6f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x74, 0x77, 0x6f, 0x22, 0x64, 0x0a,
+	0x0e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x85, 0x1d, 0x0a, 0x06, 0x42, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x12, 0x42,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c,
+	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x28, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x1c, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x62,
-	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24,
+	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x61, 0x0a,
+	0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x69, 0x62, 0x6c,
+	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x65,
+	0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x61, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x14, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41,
+	0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62,
 	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x12, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f,
-	0x41, 0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x64, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x65, 0x0a, 0x12, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30,
-	0x01, 0x12, 0x4d, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x62, 0x69, 0x62, 0x6c,
-	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5b,
-	0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x14, 0x50,
-	0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x1a, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
+	0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x13, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x14, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69,
-	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
 	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x62,
-	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30,
-	0x01, 0x12, 0x66, 0x0a, 0x13, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x14, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x62, 0x6c,
-	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x69,
-	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x62, 0x69, 0x62,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x49,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x69, 0x62,
 	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c,
-	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x69,
 	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
 	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c,
-	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x41,
-	0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x69, 0x62,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0b, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x59, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x62,
+	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x62, 0x69,
+	0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62,
+	0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x62,
 	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x69, 0x62, 0x6c,
-	0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x59, 0x0a,
-	0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12,
-	0x20, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x4d, 0x75, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x62, 0x69, 0x62,
-	0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
-	0x01, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x61,
 	0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
 	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x69, 0x62, 0x6c, 0x69, 0x6f, 0x2e, 0x76,
@@ -4562,185 +6131,241 @@ func file_biblio_proto_rawDescGZIP() []byte {
 	return file_biblio_proto_rawDescData
 }
 
-var file_biblio_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_biblio_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_biblio_proto_goTypes = []interface{}{
-	(*Publication)(nil),                   // 0: biblio.v1.Publication
-	(*Dataset)(nil),                       // 1: biblio.v1.Dataset
-	(*MutateRequest)(nil),                 // 2: biblio.v1.MutateRequest
-	(*MutateResponse)(nil),                // 3: biblio.v1.MutateResponse
-	(*GetFileRequest)(nil),                // 4: biblio.v1.GetFileRequest
-	(*GetFileResponse)(nil),               // 5: biblio.v1.GetFileResponse
-	(*ExistsFileRequest)(nil),             // 6: biblio.v1.ExistsFileRequest
-	(*ExistsFileResponse)(nil),            // 7: biblio.v1.ExistsFileResponse
-	(*AddFileRequest)(nil),                // 8: biblio.v1.AddFileRequest
-	(*AddFileResponse)(nil),               // 9: biblio.v1.AddFileResponse
-	(*GetPublicationRequest)(nil),         // 10: biblio.v1.GetPublicationRequest
-	(*GetPublicationResponse)(nil),        // 11: biblio.v1.GetPublicationResponse
-	(*GetAllPublicationsRequest)(nil),     // 12: biblio.v1.GetAllPublicationsRequest
-	(*GetAllPublicationsResponse)(nil),    // 13: biblio.v1.GetAllPublicationsResponse
-	(*SearchPublicationsRequest)(nil),     // 14: biblio.v1.SearchPublicationsRequest
-	(*SearchPublicationsResponse)(nil),    // 15: biblio.v1.SearchPublicationsResponse
-	(*UpdatePublicationRequest)(nil),      // 16: biblio.v1.UpdatePublicationRequest
-	(*UpdatePublicationResponse)(nil),     // 17: biblio.v1.UpdatePublicationResponse
-	(*AddPublicationsRequest)(nil),        // 18: biblio.v1.AddPublicationsRequest
-	(*AddPublicationsResponse)(nil),       // 19: biblio.v1.AddPublicationsResponse
-	(*ImportPublicationsRequest)(nil),     // 20: biblio.v1.ImportPublicationsRequest
-	(*ImportPublicationsResponse)(nil),    // 21: biblio.v1.ImportPublicationsResponse
-	(*GetPublicationHistoryRequest)(nil),  // 22: biblio.v1.GetPublicationHistoryRequest
-	(*GetPublicationHistoryResponse)(nil), // 23: biblio.v1.GetPublicationHistoryResponse
-	(*PurgePublicationRequest)(nil),       // 24: biblio.v1.PurgePublicationRequest
-	(*PurgePublicationResponse)(nil),      // 25: biblio.v1.PurgePublicationResponse
-	(*PurgeAllPublicationsRequest)(nil),   // 26: biblio.v1.PurgeAllPublicationsRequest
-	(*PurgeAllPublicationsResponse)(nil),  // 27: biblio.v1.PurgeAllPublicationsResponse
-	(*ValidatePublicationsRequest)(nil),   // 28: biblio.v1.ValidatePublicationsRequest
-	(*ValidateResults)(nil),               // 29: biblio.v1.ValidateResults
-	(*ValidatePublicationsResponse)(nil),  // 30: biblio.v1.ValidatePublicationsResponse
-	(*ReindexPublicationsRequest)(nil),    // 31: biblio.v1.ReindexPublicationsRequest
-	(*ReindexPublicationsResponse)(nil),   // 32: biblio.v1.ReindexPublicationsResponse
-	(*TransferPublicationsRequest)(nil),   // 33: biblio.v1.TransferPublicationsRequest
-	(*TransferPublicationsResponse)(nil),  // 34: biblio.v1.TransferPublicationsResponse
-	(*CleanupPublicationsRequest)(nil),    // 35: biblio.v1.CleanupPublicationsRequest
-	(*CleanupPublicationsResponse)(nil),   // 36: biblio.v1.CleanupPublicationsResponse
-	(*GetDatasetRequest)(nil),             // 37: biblio.v1.GetDatasetRequest
-	(*GetDatasetResponse)(nil),            // 38: biblio.v1.GetDatasetResponse
-	(*GetAllDatasetsRequest)(nil),         // 39: biblio.v1.GetAllDatasetsRequest
-	(*GetAllDatasetsResponse)(nil),        // 40: biblio.v1.GetAllDatasetsResponse
-	(*SearchDatasetsRequest)(nil),         // 41: biblio.v1.SearchDatasetsRequest
-	(*SearchDatasetsResponse)(nil),        // 42: biblio.v1.SearchDatasetsResponse
-	(*UpdateDatasetRequest)(nil),          // 43: biblio.v1.UpdateDatasetRequest
-	(*UpdateDatasetResponse)(nil),         // 44: biblio.v1.UpdateDatasetResponse
-	(*AddDatasetsRequest)(nil),            // 45: biblio.v1.AddDatasetsRequest
-	(*AddDatasetsResponse)(nil),           // 46: biblio.v1.AddDatasetsResponse
-	(*ImportDatasetsRequest)(nil),         // 47: biblio.v1.ImportDatasetsRequest
-	(*ImportDatasetsResponse)(nil),        // 48: biblio.v1.importDatasetsResponse
-	(*GetDatasetHistoryRequest)(nil),      // 49: biblio.v1.GetDatasetHistoryRequest
-	(*GetDatasetHistoryResponse)(nil),     // 50: biblio.v1.GetDatasetHistoryResponse
-	(*PurgeDatasetRequest)(nil),           // 51: biblio.v1.PurgeDatasetRequest
-	(*PurgeDatasetResponse)(nil),          // 52: biblio.v1.PurgeDatasetResponse
-	(*PurgeAllDatasetsRequest)(nil),       // 53: biblio.v1.PurgeAllDatasetsRequest
-	(*PurgeAllDatasetsResponse)(nil),      // 54: biblio.v1.PurgeAllDatasetsResponse
-	(*ValidateDatasetsRequest)(nil),       // 55: biblio.v1.ValidateDatasetsRequest
-	(*ValidateDatasetsResponse)(nil),      // 56: biblio.v1.ValidateDatasetsResponse
-	(*ReindexDatasetsRequest)(nil),        // 57: biblio.v1.ReindexDatasetsRequest
-	(*ReindexDatasetsResponse)(nil),       // 58: biblio.v1.ReindexDatasetsResponse
-	(*CleanupDatasetsRequest)(nil),        // 59: biblio.v1.CleanupDatasetsRequest
-	(*CleanupDatasetsResponse)(nil),       // 60: biblio.v1.CleanupDatasetsResponse
-	(*RelateRequest)(nil),                 // 61: biblio.v1.RelateRequest
-	(*RelateResponse)(nil),                // 62: biblio.v1.RelateResponse
-	(*status.Status)(nil),                 // 63: google.rpc.Status
+	(*Publication)(nil),                    // 0: biblio.v1.Publication
+	(*Dataset)(nil),                        // 1: biblio.v1.Dataset
+	(*HistoryEntry)(nil),                   // 2: biblio.v1.HistoryEntry
+	(*Comment)(nil),                        // 3: biblio.v1.Comment
+	(*MutateRequest)(nil),                  // 4: biblio.v1.MutateRequest
+	(*MutateResponse)(nil),                 // 5: biblio.v1.MutateResponse
+	(*GetFileRequest)(nil),                 // 6: biblio.v1.GetFileRequest
+	(*GetFileResponse)(nil),                // 7: biblio.v1.GetFileResponse
+	(*ExistsFileRequest)(nil),              // 8: biblio.v1.ExistsFileRequest
+	(*ExistsFileResponse)(nil),             // 9: biblio.v1.ExistsFileResponse
+	(*AddFileRequest)(nil),                 // 10: biblio.v1.AddFileRequest
+	(*AddFileResponse)(nil),                // 11: biblio.v1.AddFileResponse
+	(*GetPublicationRequest)(nil),          // 12: biblio.v1.GetPublicationRequest
+	(*GetPublicationResponse)(nil),         // 13: biblio.v1.GetPublicationResponse
+	(*GetAllPublicationsRequest)(nil),      // 14: biblio.v1.GetAllPublicationsRequest
+	(*GetAllPublicationsResponse)(nil),     // 15: biblio.v1.GetAllPublicationsResponse
+	(*SearchPublicationsRequest)(nil),      // 16: biblio.v1.SearchPublicationsRequest
+	(*SearchPublicationsResponse)(nil),     // 17: biblio.v1.SearchPublicationsResponse
+	(*UpdatePublicationRequest)(nil),       // 18: biblio.v1.UpdatePublicationRequest
+	(*UpdatePublicationResponse)(nil),      // 19: biblio.v1.UpdatePublicationResponse
+	(*AddPublicationsRequest)(nil),         // 20: biblio.v1.AddPublicationsRequest
+	(*AddPublicationsResponse)(nil),        // 21: biblio.v1.AddPublicationsResponse
+	(*ImportPublicationsRequest)(nil),      // 22: biblio.v1.ImportPublicationsRequest
+	(*ImportPublicationsResponse)(nil),     // 23: biblio.v1.ImportPublicationsResponse
+	(*GetPublicationHistoryRequest)(nil),   // 24: biblio.v1.GetPublicationHistoryRequest
+	(*GetPublicationHistoryResponse)(nil),  // 25: biblio.v1.GetPublicationHistoryResponse
+	(*GetPublicationChangesRequest)(nil),   // 26: biblio.v1.GetPublicationChangesRequest
+	(*GetPublicationChangesResponse)(nil),  // 27: biblio.v1.GetPublicationChangesResponse
+	(*RestorePublicationRequest)(nil),      // 28: biblio.v1.RestorePublicationRequest
+	(*RestorePublicationResponse)(nil),     // 29: biblio.v1.RestorePublicationResponse
+	(*GetPublicationCommentsRequest)(nil),  // 30: biblio.v1.GetPublicationCommentsRequest
+	(*GetPublicationCommentsResponse)(nil), // 31: biblio.v1.GetPublicationCommentsResponse
+	(*AddPublicationCommentRequest)(nil),   // 32: biblio.v1.AddPublicationCommentRequest
+	(*AddPublicationCommentResponse)(nil),  // 33: biblio.v1.AddPublicationCommentResponse
+	(*PurgePublicationRequest)(nil),        // 34: biblio.v1.PurgePublicationRequest
+	(*PurgePublicationResponse)(nil),       // 35: biblio.v1.PurgePublicationResponse
+	(*PurgeAllPublicationsRequest)(nil),    // 36: biblio.v1.PurgeAllPublicationsRequest
+	(*PurgeAllPublicationsResponse)(nil),   // 37: biblio.v1.PurgeAllPublicationsResponse
+	(*MergePublicationsRequest)(nil),       // 38: biblio.v1.MergePublicationsRequest
+	(*MergePublicationsResponse)(nil),      // 39: biblio.v1.MergePublicationsResponse
+	(*ValidatePublicationsRequest)(nil),    // 40: biblio.v1.ValidatePublicationsRequest
+	(*ValidateResults)(nil),                // 41: biblio.v1.ValidateResults
+	(*ValidatePublicationsResponse)(nil),   // 42: biblio.v1.ValidatePublicationsResponse
+	(*ReindexPublicationsRequest)(nil),     // 43: biblio.v1.ReindexPublicationsRequest
+	(*ReindexPublicationsResponse)(nil),    // 44: biblio.v1.ReindexPublicationsResponse
+	(*TransferPublicationsRequest)(nil),    // 45: biblio.v1.TransferPublicationsRequest
+	(*TransferPublicationsResponse)(nil),   // 46: biblio.v1.TransferPublicationsResponse
+	(*CleanupPublicationsRequest)(nil),     // 47: biblio.v1.CleanupPublicationsRequest
+	(*CleanupPublicationsResponse)(nil),    // 48: biblio.v1.CleanupPublicationsResponse
+	(*GetDatasetRequest)(nil),              // 49: biblio.v1.GetDatasetRequest
+	(*GetDatasetResponse)(nil),             // 50: biblio.v1.GetDatasetResponse
+	(*GetAllDatasetsRequest)(nil),          // 51: biblio.v1.GetAllDatasetsRequest
+	(*GetAllDatasetsResponse)(nil),         // 52: biblio.v1.GetAllDatasetsResponse
+	(*SearchDatasetsRequest)(nil),          // 53: biblio.v1.SearchDatasetsRequest
+	(*SearchDatasetsResponse)(nil),         // 54: biblio.v1.SearchDatasetsResponse
+	(*UpdateDatasetRequest)(nil),           // 55: biblio.v1.UpdateDatasetRequest
+	(*UpdateDatasetResponse)(nil),          // 56: biblio.v1.UpdateDatasetResponse
+	(*AddDatasetsRequest)(nil),             // 57: biblio.v1.AddDatasetsRequest
+	(*AddDatasetsResponse)(nil),            // 58: biblio.v1.AddDatasetsResponse
+	(*ImportDatasetsRequest)(nil),          // 59: biblio.v1.ImportDatasetsRequest
+	(*ImportDatasetsResponse)(nil),         // 60: biblio.v1.importDatasetsResponse
+	(*GetDatasetHistoryRequest)(nil),       // 61: biblio.v1.GetDatasetHistoryRequest
+	(*GetDatasetHistoryResponse)(nil),      // 62: biblio.v1.GetDatasetHistoryResponse
+	(*GetDatasetChangesRequest)(nil),       // 63: biblio.v1.GetDatasetChangesRequest
+	(*GetDatasetChangesResponse)(nil),      // 64: biblio.v1.GetDatasetChangesResponse
+	(*RestoreDatasetRequest)(nil),          // 65: biblio.v1.RestoreDatasetRequest
+	(*RestoreDatasetResponse)(nil),         // 66: biblio.v1.RestoreDatasetResponse
+	(*GetDatasetCommentsRequest)(nil),      // 67: biblio.v1.GetDatasetCommentsRequest
+	(*GetDatasetCommentsResponse)(nil),     // 68: biblio.v1.GetDatasetCommentsResponse
+	(*AddDatasetCommentRequest)(nil),       // 69: biblio.v1.AddDatasetCommentRequest
+	(*AddDatasetCommentResponse)(nil),      // 70: biblio.v1.AddDatasetCommentResponse
+	(*PurgeDatasetRequest)(nil),            // 71: biblio.v1.PurgeDatasetRequest
+	(*PurgeDatasetResponse)(nil),           // 72: biblio.v1.PurgeDatasetResponse
+	(*PurgeAllDatasetsRequest)(nil),        // 73: biblio.v1.PurgeAllDatasetsRequest
+	(*PurgeAllDatasetsResponse)(nil),       // 74: biblio.v1.PurgeAllDatasetsResponse
+	(*ValidateDatasetsRequest)(nil),        // 75: biblio.v1.ValidateDatasetsRequest
+	(*ValidateDatasetsResponse)(nil),       // 76: biblio.v1.ValidateDatasetsResponse
+	(*ReindexDatasetsRequest)(nil),         // 77: biblio.v1.ReindexDatasetsRequest
+	(*ReindexDatasetsResponse)(nil),        // 78: biblio.v1.ReindexDatasetsResponse
+	(*CleanupDatasetsRequest)(nil),         // 79: biblio.v1.CleanupDatasetsRequest
+	(*CleanupDatasetsResponse)(nil),        // 80: biblio.v1.CleanupDatasetsResponse
+	(*RelateRequest)(nil),                  // 81: biblio.v1.RelateRequest
+	(*RelateResponse)(nil),                 // 82: biblio.v1.RelateResponse
+	(*status.Status)(nil),                  // 83: google.rpc.Status
 }
 var file_biblio_proto_depIdxs = []int32{
-	63, // 0: biblio.v1.MutateResponse.error:type_name -> google.rpc.Status
-	63, // 1: biblio.v1.AddFileResponse.error:type_name -> google.rpc.Status
-	0,  // 2: biblio.v1.GetPublicationResponse.publication:type_name -> biblio.v1.Publication
-	63, // 3: biblio.v1.GetPublicationResponse.error:type_name -> google.rpc.Status
-	0,  // 4: biblio.v1.GetAllPublicationsResponse.publication:type_name -> biblio.v1.Publication
-	63, // 5: biblio.v1.GetAllPublicationsResponse.error:type_name -> google.rpc.Status
-	0,  // 6: biblio.v1.SearchPublicationsResponse.hits:type_name -> biblio.v1.Publication
-	0,  // 7: biblio.v1.UpdatePublicationRequest.publication:type_name -> biblio.v1.Publication
-	63, // 8: biblio.v1.UpdatePublicationResponse.error:type_name -> google.rpc.Status
-	0,  // 9: biblio.v1.AddPublicationsRequest.publication:type_name -> biblio.v1.Publication
-	63, // 10: biblio.v1.AddPublicationsResponse.error:type_name -> google.rpc.Status
-	0,  // 11: biblio.v1.ImportPublicationsRequest.publication:type_name -> biblio.v1.Publication
-	63, // 12: biblio.v1.ImportPublicationsResponse.error:type_name -> google.rpc.Status
-	0,  // 13: biblio.v1.GetPublicationHistoryResponse.publication:type_name -> biblio.v1.Publication
-	63, // 14: biblio.v1.GetPublicationHistoryResponse.error:type_name -> google.rpc.Status
-	63, // 15: biblio.v1.PurgePublicationResponse.error:type_name -> google.rpc.Status
-	63, // 16: biblio.v1.PurgeAllPublicationsResponse.error:type_name -> google.rpc.Status
-	0,  // 17: biblio.v1.ValidatePublicationsRequest.publication:type_name -> biblio.v1.Publication
-	29, // 18: biblio.v1.ValidatePublicationsResponse.results:type_name -> biblio.v1.ValidateResults
-	63, // 19: biblio.v1.ValidatePublicationsResponse.error:type_name -> google.rpc.Status
-	63, // 20: biblio.v1.ReindexPublicationsResponse.error:type_name -> google.rpc.Status
-	63, // 21: biblio.v1.TransferPublicationsResponse.error:type_name -> google.rpc.Status
-	63, // 22: biblio.v1.CleanupPublicationsResponse.error:type_name -> google.rpc.Status
-	1,  // 23: biblio.v1.GetDatasetResponse.dataset:type_name -> biblio.v1.Dataset
-	63, // 24: biblio.v1.GetDatasetResponse.error:type_name -> google.rpc.Status
-	1,  // 25: biblio.v1.GetAllDatasetsResponse.dataset:type_name -> biblio.v1.Dataset
-	63, // 26: biblio.v1.GetAllDatasetsResponse.error:type_name -> google.rpc.Status
-	1,  // 27: biblio.v1.SearchDatasetsResponse.hits:type_name -> biblio.v1.Dataset
-	1,  // 28: biblio.v1.UpdateDatasetRequest.dataset:type_name -> biblio.v1.Dataset
-	63, // 29: biblio.v1.UpdateDatasetResponse.error:type_name -> google.rpc.Status
-	1,  // 30: biblio.v1.AddDatasetsRequest.dataset:type_name -> biblio.v1.Dataset
-	63, // 31: biblio.v1.AddDatasetsResponse.error:type_name -> google.rpc.Status
-	1,  // 32: biblio.v1.ImportDatasetsRequest.dataset:type_name -> biblio.v1.Dataset
-	63, // 33: biblio.v1.importDatasetsResponse.error:type_name -> google.rpc.Status
-	1,  // 34: biblio.v1.GetDatasetHistoryResponse.dataset:type_name -> biblio.v1.Dataset
-	63, // 35: biblio.v1.GetDatasetHistoryResponse.error:type_name -> google.rpc.Status
-	63, // 36: biblio.v1.PurgeDatasetResponse.error:type_name -> google.rpc.Status
-	63, // 37: biblio.v1.PurgeAllDatasetsResponse.error:type_name -> google.rpc.Status
-	1,  // 38: biblio.v1.ValidateDatasetsRequest.dataset:type_name -> biblio.v1.Dataset
-	29, // 39: biblio.v1.ValidateDatasetsResponse.results:type_name -> biblio.v1.ValidateResults
-	63, // 40: biblio.v1.ValidateDatasetsResponse.error:type_name -> google.rpc.Status
-	63, // 41: biblio.v1.ReindexDatasetsResponse.error:type_name -> google.rpc.Status
-	63, // 42: biblio.v1.CleanupDatasetsResponse.error:type_name -> google.rpc.Status
-	63, // 43: biblio.v1.RelateResponse.error:type_name -> google.rpc.Status
-	4,  // 44: biblio.v1.Biblio.GetFile:input_type -> biblio.v1.GetFileRequest
-	8,  // 45: biblio.v1.Biblio.AddFile:input_type -> biblio.v1.AddFileRequest
-	6,  // 46: biblio.v1.Biblio.ExistsFile:input_type -> biblio.v1.ExistsFileRequest
-	10, // 47: biblio.v1.Biblio.GetPublication:input_type -> biblio.v1.GetPublicationRequest
-	12, // 48: biblio.v1.Biblio.GetAllPublications:input_type -> biblio.v1.GetAllPublicationsRequest
-	14, // 49: biblio.v1.Biblio.SearchPublications:input_type -> biblio.v1.SearchPublicationsRequest
-	16, // 50: biblio.v1.Biblio.UpdatePublication:input_type -> biblio.v1.UpdatePublicationRequest
-	18, // 51: biblio.v1.Biblio.AddPublications:input_type -> biblio.v1.AddPublicationsRequest
-	20, // 52: biblio.v1.Biblio.ImportPublications:input_type -> biblio.v1.ImportPublicationsRequest
-	2,  // 53: biblio.v1.Biblio.MutatePublications:input_type -> biblio.v1.MutateRequest
-	22, // 54: biblio.v1.Biblio.GetPublicationHistory:input_type -> biblio.v1.GetPublicationHistoryRequest
-	24, // 55: biblio.v1.Biblio.PurgePublication:input_type -> biblio.v1.PurgePublicationRequest
-	26, // 56: biblio.v1.Biblio.PurgeAllPublications:input_type -> biblio.v1.PurgeAllPublicationsRequest
-	28, // 57: biblio.v1.Biblio.ValidatePublications:input_type -> biblio.v1.ValidatePublicationsRequest
-	31, // 58: biblio.v1.Biblio.ReindexPublications:input_type -> biblio.v1.ReindexPublicationsRequest
-	33, // 59: biblio.v1.Biblio.TransferPublications:input_type -> biblio.v1.TransferPublicationsRequest
-	35, // 60: biblio.v1.Biblio.CleanupPublications:input_type -> biblio.v1.CleanupPublicationsRequest
-	37, // 61: biblio.v1.Biblio.GetDataset:input_type -> biblio.v1.GetDatasetRequest
-	39, // 62: biblio.v1.Biblio.GetAllDatasets:input_type -> biblio.v1.GetAllDatasetsRequest
-	41, // 63: biblio.v1.Biblio.SearchDatasets:input_type -> biblio.v1.SearchDatasetsRequest
-	43, // 64: biblio.v1.Biblio.UpdateDataset:input_type -> biblio.v1.UpdateDatasetRequest
-	45, // 65: biblio.v1.Biblio.AddDatasets:input_type -> biblio.v1.AddDatasetsRequest
-	47, // 66: biblio.v1.Biblio.ImportDatasets:input_type -> biblio.v1.ImportDatasetsRequest
-	2,  // 67: biblio.v1.Biblio.MutateDatasets:input_type -> biblio.v1.MutateRequest
-	49, // 68: biblio.v1.Biblio.GetDatasetHistory:input_type -> biblio.v1.GetDatasetHistoryRequest
-	51, // 69: biblio.v1.Biblio.PurgeDataset:input_type -> biblio.v1.PurgeDatasetRequest
-	53, // 70: biblio.v1.Biblio.PurgeAllDatasets:input_type -> biblio.v1.PurgeAllDatasetsRequest
-	55, // 71: biblio.v1.Biblio.ValidateDatasets:input_type -> biblio.v1.ValidateDatasetsRequest
-	57, // 72: biblio.v1.Biblio.ReindexDatasets:input_type -> biblio.v1.ReindexDatasetsRequest
-	59, // 73: biblio.v1.Biblio.CleanupDatasets:input_type -> biblio.v1.CleanupDatasetsRequest
-	61, // 74: biblio.v1.Biblio.Relate:input_type -> biblio.v1.RelateRequest
-	5,  // 75: biblio.v1.Biblio.GetFile:output_type -> biblio.v1.GetFileResponse
-	9,  // 76: biblio.v1.Biblio.AddFile:output_type -> biblio.v1.AddFileResponse
-	7,  // 77: biblio.v1.Biblio.ExistsFile:output_type -> biblio.v1.ExistsFileResponse
-	11, // 78: biblio.v1.Biblio.GetPublication:output_type -> biblio.v1.GetPublicationResponse
-	13, // 79: biblio.v1.Biblio.GetAllPublications:output_type -> biblio.v1.GetAllPublicationsResponse
-	15, // 80: biblio.v1.Biblio.SearchPublications:output_type -> biblio.v1.SearchPublicationsResponse
-	17, // 81: biblio.v1.Biblio.UpdatePublication:output_type -> biblio.v1.UpdatePublicationResponse
-	19, // 82: biblio.v1.Biblio.AddPublications:output_type -> biblio.v1.AddPublicationsResponse
-	21, // 83: biblio.v1.Biblio.ImportPublications:output_type -> biblio.v1.ImportPublicationsResponse
-	3,  // 84: biblio.v1.Biblio.MutatePublications:output_type -> biblio.v1.MutateResponse
-	23, // 85: biblio.v1.Biblio.GetPublicationHistory:output_type -> biblio.v1.GetPublicationHistoryResponse
-	25, // 86: biblio.v1.Biblio.PurgePublication:output_type -> biblio.v1.PurgePublicationResponse
-	27, // 87: biblio.v1.Biblio.PurgeAllPublications:output_type -> biblio.v1.PurgeAllPublicationsResponse
-	30, // 88: biblio.v1.Biblio.ValidatePublications:output_type -> biblio.v1.ValidatePublicationsResponse
-	32, // 89: biblio.v1.Biblio.ReindexPublications:output_type -> biblio.v1.ReindexPublicationsResponse
-	34, // 90: biblio.v1.Biblio.TransferPublications:output_type -> biblio.v1.TransferPublicationsResponse
-	36, // 91: biblio.v1.Biblio.CleanupPublications:output_type -> biblio.v1.CleanupPublicationsResponse
-	38, // 92: biblio.v1.Biblio.GetDataset:output_type -> biblio.v1.GetDatasetResponse
-	40, // 93: biblio.v1.Biblio.GetAllDatasets:output_type -> biblio.v1.GetAllDatasetsResponse
-	42, // 94: biblio.v1.Biblio.SearchDatasets:output_type -> biblio.v1.SearchDatasetsResponse
-	44, // 95: biblio.v1.Biblio.UpdateDataset:output_type -> biblio.v1.UpdateDatasetResponse
-	46, // 96: biblio.v1.Biblio.AddDatasets:output_type -> biblio.v1.AddDatasetsResponse
-	48, // 97: biblio.v1.Biblio.ImportDatasets:output_type -> biblio.v1.importDatasetsResponse
-	3,  // 98: biblio.v1.Biblio.MutateDatasets:output_type -> biblio.v1.MutateResponse
-	50, // 99: biblio.v1.Biblio.GetDatasetHistory:output_type -> biblio.v1.GetDatasetHistoryResponse
-	52, // 100: biblio.v1.Biblio.PurgeDataset:output_type -> biblio.v1.PurgeDatasetResponse
-	54, // 101: biblio.v1.Biblio.PurgeAllDatasets:output_type -> biblio.v1.PurgeAllDatasetsResponse
-	56, // 102: biblio.v1.Biblio.ValidateDatasets:output_type -> biblio.v1.ValidateDatasetsResponse
-	58, // 103: biblio.v1.Biblio.ReindexDatasets:output_type -> biblio.v1.ReindexDatasetsResponse
-	60, // 104: biblio.v1.Biblio.CleanupDatasets:output_type -> biblio.v1.CleanupDatasetsResponse
-	62, // 105: biblio.v1.Biblio.Relate:output_type -> biblio.v1.RelateResponse
-	75, // [75:106] is the sub-list for method output_type
-	44, // [44:75] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	83,  // 0: biblio.v1.MutateResponse.error:type_name -> google.rpc.Status
+	83,  // 1: biblio.v1.AddFileResponse.error:type_name -> google.rpc.Status
+	0,   // 2: biblio.v1.GetPublicationResponse.publication:type_name -> biblio.v1.Publication
+	83,  // 3: biblio.v1.GetPublicationResponse.error:type_name -> google.rpc.Status
+	0,   // 4: biblio.v1.GetAllPublicationsResponse.publication:type_name -> biblio.v1.Publication
+	83,  // 5: biblio.v1.GetAllPublicationsResponse.error:type_name -> google.rpc.Status
+	0,   // 6: biblio.v1.SearchPublicationsResponse.hits:type_name -> biblio.v1.Publication
+	0,   // 7: biblio.v1.UpdatePublicationRequest.publication:type_name -> biblio.v1.Publication
+	83,  // 8: biblio.v1.UpdatePublicationResponse.error:type_name -> google.rpc.Status
+	0,   // 9: biblio.v1.AddPublicationsRequest.publication:type_name -> biblio.v1.Publication
+	83,  // 10: biblio.v1.AddPublicationsResponse.error:type_name -> google.rpc.Status
+	0,   // 11: biblio.v1.ImportPublicationsRequest.publication:type_name -> biblio.v1.Publication
+	83,  // 12: biblio.v1.ImportPublicationsResponse.error:type_name -> google.rpc.Status
+	0,   // 13: biblio.v1.GetPublicationHistoryResponse.publication:type_name -> biblio.v1.Publication
+	83,  // 14: biblio.v1.GetPublicationHistoryResponse.error:type_name -> google.rpc.Status
+	2,   // 15: biblio.v1.GetPublicationChangesResponse.entry:type_name -> biblio.v1.HistoryEntry
+	83,  // 16: biblio.v1.GetPublicationChangesResponse.error:type_name -> google.rpc.Status
+	0,   // 17: biblio.v1.RestorePublicationResponse.publication:type_name -> biblio.v1.Publication
+	83,  // 18: biblio.v1.RestorePublicationResponse.error:type_name -> google.rpc.Status
+	3,   // 19: biblio.v1.GetPublicationCommentsResponse.comment:type_name -> biblio.v1.Comment
+	83,  // 20: biblio.v1.GetPublicationCommentsResponse.error:type_name -> google.rpc.Status
+	3,   // 21: biblio.v1.AddPublicationCommentResponse.comment:type_name -> biblio.v1.Comment
+	83,  // 22: biblio.v1.AddPublicationCommentResponse.error:type_name -> google.rpc.Status
+	83,  // 23: biblio.v1.PurgePublicationResponse.error:type_name -> google.rpc.Status
+	83,  // 24: biblio.v1.PurgeAllPublicationsResponse.error:type_name -> google.rpc.Status
+	0,   // 25: biblio.v1.MergePublicationsResponse.publication:type_name -> biblio.v1.Publication
+	83,  // 26: biblio.v1.MergePublicationsResponse.error:type_name -> google.rpc.Status
+	0,   // 27: biblio.v1.ValidatePublicationsRequest.publication:type_name -> biblio.v1.Publication
+	41,  // 28: biblio.v1.ValidatePublicationsResponse.results:type_name -> biblio.v1.ValidateResults
+	83,  // 29: biblio.v1.ValidatePublicationsResponse.error:type_name -> google.rpc.Status
+	83,  // 30: biblio.v1.ReindexPublicationsResponse.error:type_name -> google.rpc.Status
+	83,  // 31: biblio.v1.TransferPublicationsResponse.error:type_name -> google.rpc.Status
+	83,  // 32: biblio.v1.CleanupPublicationsResponse.error:type_name -> google.rpc.Status
+	1,   // 33: biblio.v1.GetDatasetResponse.dataset:type_name -> biblio.v1.Dataset
+	83,  // 34: biblio.v1.GetDatasetResponse.error:type_name -> google.rpc.Status
+	1,   // 35: biblio.v1.GetAllDatasetsResponse.dataset:type_name -> biblio.v1.Dataset
+	83,  // 36: biblio.v1.GetAllDatasetsResponse.error:type_name -> google.rpc.Status
+	1,   // 37: biblio.v1.SearchDatasetsResponse.hits:type_name -> biblio.v1.Dataset
+	1,   // 38: biblio.v1.UpdateDatasetRequest.dataset:type_name -> biblio.v1.Dataset
+	83,  // 39: biblio.v1.UpdateDatasetResponse.error:type_name -> google.rpc.Status
+	1,   // 40: biblio.v1.AddDatasetsRequest.dataset:type_name -> biblio.v1.Dataset
+	83,  // 41: biblio.v1.AddDatasetsResponse.error:type_name -> google.rpc.Status
+	1,   // 42: biblio.v1.ImportDatasetsRequest.dataset:type_name -> biblio.v1.Dataset
+	83,  // 43: biblio.v1.importDatasetsResponse.error:type_name -> google.rpc.Status
+	1,   // 44: biblio.v1.GetDatasetHistoryResponse.dataset:type_name -> biblio.v1.Dataset
+	83,  // 45: biblio.v1.GetDatasetHistoryResponse.error:type_name -> google.rpc.Status
+	2,   // 46: biblio.v1.GetDatasetChangesResponse.entry:type_name -> biblio.v1.HistoryEntry
+	83,  // 47: biblio.v1.GetDatasetChangesResponse.error:type_name -> google.rpc.Status
+	1,   // 48: biblio.v1.RestoreDatasetResponse.dataset:type_name -> biblio.v1.Dataset
+	83,  // 49: biblio.v1.RestoreDatasetResponse.error:type_name -> google.rpc.Status
+	3,   // 50: biblio.v1.GetDatasetCommentsResponse.comment:type_name -> biblio.v1.Comment
+	83,  // 51: biblio.v1.GetDatasetCommentsResponse.error:type_name -> google.rpc.Status
+	3,   // 52: biblio.v1.AddDatasetCommentResponse.comment:type_name -> biblio.v1.Comment
+	83,  // 53: biblio.v1.AddDatasetCommentResponse.error:type_name -> google.rpc.Status
+	83,  // 54: biblio.v1.PurgeDatasetResponse.error:type_name -> google.rpc.Status
+	83,  // 55: biblio.v1.PurgeAllDatasetsResponse.error:type_name -> google.rpc.Status
+	1,   // 56: biblio.v1.ValidateDatasetsRequest.dataset:type_name -> biblio.v1.Dataset
+	41,  // 57: biblio.v1.ValidateDatasetsResponse.results:type_name -> biblio.v1.ValidateResults
+	83,  // 58: biblio.v1.ValidateDatasetsResponse.error:type_name -> google.rpc.Status
+	83,  // 59: biblio.v1.ReindexDatasetsResponse.error:type_name -> google.rpc.Status
+	83,  // 60: biblio.v1.CleanupDatasetsResponse.error:type_name -> google.rpc.Status
+	83,  // 61: biblio.v1.RelateResponse.error:type_name -> google.rpc.Status
+	6,   // 62: biblio.v1.Biblio.GetFile:input_type -> biblio.v1.GetFileRequest
+	10,  // 63: biblio.v1.Biblio.AddFile:input_type -> biblio.v1.AddFileRequest
+	8,   // 64: biblio.v1.Biblio.ExistsFile:input_type -> biblio.v1.ExistsFileRequest
+	12,  // 65: biblio.v1.Biblio.GetPublication:input_type -> biblio.v1.GetPublicationRequest
+	14,  // 66: biblio.v1.Biblio.GetAllPublications:input_type -> biblio.v1.GetAllPublicationsRequest
+	16,  // 67: biblio.v1.Biblio.SearchPublications:input_type -> biblio.v1.SearchPublicationsRequest
+	18,  // 68: biblio.v1.Biblio.UpdatePublication:input_type -> biblio.v1.UpdatePublicationRequest
+	20,  // 69: biblio.v1.Biblio.AddPublications:input_type -> biblio.v1.AddPublicationsRequest
+	22,  // 70: biblio.v1.Biblio.ImportPublications:input_type -> biblio.v1.ImportPublicationsRequest
+	4,   // 71: biblio.v1.Biblio.MutatePublications:input_type -> biblio.v1.MutateRequest
+	24,  // 72: biblio.v1.Biblio.GetPublicationHistory:input_type -> biblio.v1.GetPublicationHistoryRequest
+	26,  // 73: biblio.v1.Biblio.GetPublicationChanges:input_type -> biblio.v1.GetPublicationChangesRequest
+	28,  // 74: biblio.v1.Biblio.RestorePublication:input_type -> biblio.v1.RestorePublicationRequest
+	30,  // 75: biblio.v1.Biblio.GetPublicationComments:input_type -> biblio.v1.GetPublicationCommentsRequest
+	32,  // 76: biblio.v1.Biblio.AddPublicationComment:input_type -> biblio.v1.AddPublicationCommentRequest
+	34,  // 77: biblio.v1.Biblio.PurgePublication:input_type -> biblio.v1.PurgePublicationRequest
+	36,  // 78: biblio.v1.Biblio.PurgeAllPublications:input_type -> biblio.v1.PurgeAllPublicationsRequest
+	38,  // 79: biblio.v1.Biblio.MergePublications:input_type -> biblio.v1.MergePublicationsRequest
+	40,  // 80: biblio.v1.Biblio.ValidatePublications:input_type -> biblio.v1.ValidatePublicationsRequest
+	43,  // 81: biblio.v1.Biblio.ReindexPublications:input_type -> biblio.v1.ReindexPublicationsRequest
+	45,  // 82: biblio.v1.Biblio.TransferPublications:input_type -> biblio.v1.TransferPublicationsRequest
+	47,  // 83: biblio.v1.Biblio.CleanupPublications:input_type -> biblio.v1.CleanupPublicationsRequest
+	49,  // 84: biblio.v1.Biblio.GetDataset:input_type -> biblio.v1.GetDatasetRequest
+	51,  // 85: biblio.v1.Biblio.GetAllDatasets:input_type -> biblio.v1.GetAllDatasetsRequest
+	53,  // 86: biblio.v1.Biblio.SearchDatasets:input_type -> biblio.v1.SearchDatasetsRequest
+	55,  // 87: biblio.v1.Biblio.UpdateDataset:input_type -> biblio.v1.UpdateDatasetRequest
+	57,  // 88: biblio.v1.Biblio.AddDatasets:input_type -> biblio.v1.AddDatasetsRequest
+	59,  // 89: biblio.v1.Biblio.ImportDatasets:input_type -> biblio.v1.ImportDatasetsRequest
+	4,   // 90: biblio.v1.Biblio.MutateDatasets:input_type -> biblio.v1.MutateRequest
+	61,  // 91: biblio.v1.Biblio.GetDatasetHistory:input_type -> biblio.v1.GetDatasetHistoryRequest
+	63,  // 92: biblio.v1.Biblio.GetDatasetChanges:input_type -> biblio.v1.GetDatasetChangesRequest
+	65,  // 93: biblio.v1.Biblio.RestoreDataset:input_type -> biblio.v1.RestoreDatasetRequest
+	67,  // 94: biblio.v1.Biblio.GetDatasetComments:input_type -> biblio.v1.GetDatasetCommentsRequest
+	69,  // 95: biblio.v1.Biblio.AddDatasetComment:input_type -> biblio.v1.AddDatasetCommentRequest
+	71,  // 96: biblio.v1.Biblio.PurgeDataset:input_type -> biblio.v1.PurgeDatasetRequest
+	73,  // 97: biblio.v1.Biblio.PurgeAllDatasets:input_type -> biblio.v1.PurgeAllDatasetsRequest
+	75,  // 98: biblio.v1.Biblio.ValidateDatasets:input_type -> biblio.v1.ValidateDatasetsRequest
+	77,  // 99: biblio.v1.Biblio.ReindexDatasets:input_type -> biblio.v1.ReindexDatasetsRequest
+	79,  // 100: biblio.v1.Biblio.CleanupDatasets:input_type -> biblio.v1.CleanupDatasetsRequest
+	81,  // 101: biblio.v1.Biblio.Relate:input_type -> biblio.v1.RelateRequest
+	7,   // 102: biblio.v1.Biblio.GetFile:output_type -> biblio.v1.GetFileResponse
+	11,  // 103: biblio.v1.Biblio.AddFile:output_type -> biblio.v1.AddFileResponse
+	9,   // 104: biblio.v1.Biblio.ExistsFile:output_type -> biblio.v1.ExistsFileResponse
+	13,  // 105: biblio.v1.Biblio.GetPublication:output_type -> biblio.v1.GetPublicationResponse
+	15,  // 106: biblio.v1.Biblio.GetAllPublications:output_type -> biblio.v1.GetAllPublicationsResponse
+	17,  // 107: biblio.v1.Biblio.SearchPublications:output_type -> biblio.v1.SearchPublicationsResponse
+	19,  // 108: biblio.v1.Biblio.UpdatePublication:output_type -> biblio.v1.UpdatePublicationResponse
+	21,  // 109: biblio.v1.Biblio.AddPublications:output_type -> biblio.v1.AddPublicationsResponse
+	23,  // 110: biblio.v1.Biblio.ImportPublications:output_type -> biblio.v1.ImportPublicationsResponse
+	5,   // 111: biblio.v1.Biblio.MutatePublications:output_type -> biblio.v1.MutateResponse
+	25,  // 112: biblio.v1.Biblio.GetPublicationHistory:output_type -> biblio.v1.GetPublicationHistoryResponse
+	27,  // 113: biblio.v1.Biblio.GetPublicationChanges:output_type -> biblio.v1.GetPublicationChangesResponse
+	29,  // 114: biblio.v1.Biblio.RestorePublication:output_type -> biblio.v1.RestorePublicationResponse
+	31,  // 115: biblio.v1.Biblio.GetPublicationComments:output_type -> biblio.v1.GetPublicationCommentsResponse
+	33,  // 116: biblio.v1.Biblio.AddPublicationComment:output_type -> biblio.v1.AddPublicationCommentResponse
+	35,  // 117: biblio.v1.Biblio.PurgePublication:output_type -> biblio.v1.PurgePublicationResponse
+	37,  // 118: biblio.v1.Biblio.PurgeAllPublications:output_type -> biblio.v1.PurgeAllPublicationsResponse
+	39,  // 119: biblio.v1.Biblio.MergePublications:output_type -> biblio.v1.MergePublicationsResponse
+	42,  // 120: biblio.v1.Biblio.ValidatePublications:output_type -> biblio.v1.ValidatePublicationsResponse
+	44,  // 121: biblio.v1.Biblio.ReindexPublications:output_type -> biblio.v1.ReindexPublicationsResponse
+	46,  // 122: biblio.v1.Biblio.TransferPublications:output_type -> biblio.v1.TransferPublicationsResponse
+	48,  // 123: biblio.v1.Biblio.CleanupPublications:output_type -> biblio.v1.CleanupPublicationsResponse
+	50,  // 124: biblio.v1.Biblio.GetDataset:output_type -> biblio.v1.GetDatasetResponse
+	52,  // 125: biblio.v1.Biblio.GetAllDatasets:output_type -> biblio.v1.GetAllDatasetsResponse
+	54,  // 126: biblio.v1.Biblio.SearchDatasets:output_type -> biblio.v1.SearchDatasetsResponse
+	56,  // 127: biblio.v1.Biblio.UpdateDataset:output_type -> biblio.v1.UpdateDatasetResponse
+	58,  // 128: biblio.v1.Biblio.AddDatasets:output_type -> biblio.v1.AddDatasetsResponse
+	60,  // 129: biblio.v1.Biblio.ImportDatasets:output_type -> biblio.v1.importDatasetsResponse
+	5,   // 130: biblio.v1.Biblio.MutateDatasets:output_type -> biblio.v1.MutateResponse
+	62,  // 131: biblio.v1.Biblio.GetDatasetHistory:output_type -> biblio.v1.GetDatasetHistoryResponse
+	64,  // 132: biblio.v1.Biblio.GetDatasetChanges:output_type -> biblio.v1.GetDatasetChangesResponse
+	66,  // 133: biblio.v1.Biblio.RestoreDataset:output_type -> biblio.v1.RestoreDatasetResponse
+	68,  // 134: biblio.v1.Biblio.GetDatasetComments:output_type -> biblio.v1.GetDatasetCommentsResponse
+	70,  // 135: biblio.v1.Biblio.AddDatasetComment:output_type -> biblio.v1.AddDatasetCommentResponse
+	72,  // 136: biblio.v1.Biblio.PurgeDataset:output_type -> biblio.v1.PurgeDatasetResponse
+	74,  // 137: biblio.v1.Biblio.PurgeAllDatasets:output_type -> biblio.v1.PurgeAllDatasetsResponse
+	76,  // 138: biblio.v1.Biblio.ValidateDatasets:output_type -> biblio.v1.ValidateDatasetsResponse
+	78,  // 139: biblio.v1.Biblio.ReindexDatasets:output_type -> biblio.v1.ReindexDatasetsResponse
+	80,  // 140: biblio.v1.Biblio.CleanupDatasets:output_type -> biblio.v1.CleanupDatasetsResponse
+	82,  // 141: biblio.v1.Biblio.Relate:output_type -> biblio.v1.RelateResponse
+	102, // [102:142] is the sub-list for method output_type
+	62,  // [62:102] is the sub-list for method input_type
+	62,  // [62:62] is the sub-list for extension type_name
+	62,  // [62:62] is the sub-list for extension extendee
+	0,   // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_biblio_proto_init() }
@@ -4761,8 +6386,224 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Dataset); i {
+		file_biblio_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dataset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllPublicationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllPublicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPublicationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPublicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePublicationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePublicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4773,8 +6614,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MutateRequest); i {
+		file_biblio_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4785,8 +6626,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MutateResponse); i {
+		file_biblio_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4797,8 +6638,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileRequest); i {
+		file_biblio_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4809,8 +6650,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileResponse); i {
+		file_biblio_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4821,8 +6662,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExistsFileRequest); i {
+		file_biblio_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4833,8 +6674,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExistsFileResponse); i {
+		file_biblio_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4845,8 +6686,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileRequest); i {
+		file_biblio_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4857,8 +6698,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileResponse); i {
+		file_biblio_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationChangesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4869,8 +6710,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPublicationRequest); i {
+		file_biblio_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePublicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4881,8 +6722,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPublicationResponse); i {
+		file_biblio_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePublicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4893,8 +6734,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllPublicationsRequest); i {
+		file_biblio_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationCommentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4905,8 +6746,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllPublicationsResponse); i {
+		file_biblio_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicationCommentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4917,8 +6758,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchPublicationsRequest); i {
+		file_biblio_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPublicationCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4929,8 +6770,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchPublicationsResponse); i {
+		file_biblio_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPublicationCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4941,8 +6782,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePublicationRequest); i {
+		file_biblio_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgePublicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4953,8 +6794,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePublicationResponse); i {
+		file_biblio_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgePublicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4965,8 +6806,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPublicationsRequest); i {
+		file_biblio_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeAllPublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4977,8 +6818,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPublicationsResponse); i {
+		file_biblio_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeAllPublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4989,8 +6830,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportPublicationsRequest); i {
+		file_biblio_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5001,8 +6842,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportPublicationsResponse); i {
+		file_biblio_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5013,8 +6854,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPublicationHistoryRequest); i {
+		file_biblio_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatePublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5025,8 +6866,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPublicationHistoryResponse); i {
+		file_biblio_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateResults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5037,8 +6878,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PurgePublicationRequest); i {
+		file_biblio_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatePublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5049,8 +6890,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PurgePublicationResponse); i {
+		file_biblio_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReindexPublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5061,8 +6902,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PurgeAllPublicationsRequest); i {
+		file_biblio_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReindexPublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5073,8 +6914,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PurgeAllPublicationsResponse); i {
+		file_biblio_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5085,8 +6926,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatePublicationsRequest); i {
+		file_biblio_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5097,8 +6938,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateResults); i {
+		file_biblio_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupPublicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5109,8 +6950,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatePublicationsResponse); i {
+		file_biblio_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupPublicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5121,8 +6962,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReindexPublicationsRequest); i {
+		file_biblio_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5133,8 +6974,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReindexPublicationsResponse); i {
+		file_biblio_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5145,8 +6986,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferPublicationsRequest); i {
+		file_biblio_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllDatasetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5157,8 +6998,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferPublicationsResponse); i {
+		file_biblio_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllDatasetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5169,8 +7010,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanupPublicationsRequest); i {
+		file_biblio_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchDatasetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5181,8 +7022,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanupPublicationsResponse); i {
+		file_biblio_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchDatasetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5193,8 +7034,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatasetRequest); i {
+		file_biblio_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDatasetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5205,8 +7046,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatasetResponse); i {
+		file_biblio_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDatasetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5217,8 +7058,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllDatasetsRequest); i {
+		file_biblio_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDatasetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5229,8 +7070,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllDatasetsResponse); i {
+		file_biblio_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDatasetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5241,8 +7082,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchDatasetsRequest); i {
+		file_biblio_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportDatasetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5253,8 +7094,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchDatasetsResponse); i {
+		file_biblio_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportDatasetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5265,8 +7106,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDatasetRequest); i {
+		file_biblio_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5277,8 +7118,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDatasetResponse); i {
+		file_biblio_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5289,8 +7130,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDatasetsRequest); i {
+		file_biblio_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5301,8 +7142,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDatasetsResponse); i {
+		file_biblio_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetChangesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5313,8 +7154,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportDatasetsRequest); i {
+		file_biblio_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDatasetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5325,8 +7166,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportDatasetsResponse); i {
+		file_biblio_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDatasetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5337,8 +7178,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatasetHistoryRequest); i {
+		file_biblio_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetCommentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5349,8 +7190,8 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatasetHistoryResponse); i {
+		file_biblio_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatasetCommentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5361,7 +7202,31 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDatasetCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDatasetCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_biblio_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurgeDatasetRequest); i {
 			case 0:
 				return &v.state
@@ -5373,7 +7238,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurgeDatasetResponse); i {
 			case 0:
 				return &v.state
@@ -5385,7 +7250,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurgeAllDatasetsRequest); i {
 			case 0:
 				return &v.state
@@ -5397,7 +7262,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurgeAllDatasetsResponse); i {
 			case 0:
 				return &v.state
@@ -5409,7 +7274,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidateDatasetsRequest); i {
 			case 0:
 				return &v.state
@@ -5421,7 +7286,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidateDatasetsResponse); i {
 			case 0:
 				return &v.state
@@ -5433,7 +7298,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReindexDatasetsRequest); i {
 			case 0:
 				return &v.state
@@ -5445,7 +7310,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReindexDatasetsResponse); i {
 			case 0:
 				return &v.state
@@ -5457,7 +7322,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CleanupDatasetsRequest); i {
 			case 0:
 				return &v.state
@@ -5469,7 +7334,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CleanupDatasetsResponse); i {
 			case 0:
 				return &v.state
@@ -5481,7 +7346,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RelateRequest); i {
 			case 0:
 				return &v.state
@@ -5493,7 +7358,7 @@ func file_biblio_proto_init() {
 				return nil
 			}
 		}
-		file_biblio_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_biblio_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RelateResponse); i {
 			case 0:
 				return &v.state
@@ -5506,113 +7371,149 @@ func file_biblio_proto_init() {
 			}
 		}
 	}
-	file_biblio_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*MutateResponse_Message)(nil),
 		(*MutateResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*AddFileResponse_Sha256)(nil),
 		(*AddFileResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*GetPublicationResponse_Publication)(nil),
 		(*GetPublicationResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*GetAllPublicationsResponse_Publication)(nil),
 		(*GetAllPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*UpdatePublicationResponse_Message)(nil),
 		(*UpdatePublicationResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*AddPublicationsResponse_Message)(nil),
 		(*AddPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*ImportPublicationsResponse_Message)(nil),
 		(*ImportPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*GetPublicationHistoryResponse_Publication)(nil),
 		(*GetPublicationHistoryResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*GetPublicationChangesResponse_Entry)(nil),
+		(*GetPublicationChangesResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*RestorePublicationResponse_Publication)(nil),
+		(*RestorePublicationResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*GetPublicationCommentsResponse_Comment)(nil),
+		(*GetPublicationCommentsResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[33].OneofWrappers = []interface{}{
+		(*AddPublicationCommentResponse_Comment)(nil),
+		(*AddPublicationCommentResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*PurgePublicationResponse_Ok)(nil),
 		(*PurgePublicationResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*PurgeAllPublicationsResponse_Ok)(nil),
 		(*PurgeAllPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*MergePublicationsResponse_Publication)(nil),
+		(*MergePublicationsResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*ValidatePublicationsResponse_Results)(nil),
 		(*ValidatePublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[44].OneofWrappers = []interface{}{
 		(*ReindexPublicationsResponse_Message)(nil),
 		(*ReindexPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[46].OneofWrappers = []interface{}{
 		(*TransferPublicationsResponse_Message)(nil),
 		(*TransferPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[48].OneofWrappers = []interface{}{
 		(*CleanupPublicationsResponse_Message)(nil),
 		(*CleanupPublicationsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[38].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[50].OneofWrappers = []interface{}{
 		(*GetDatasetResponse_Dataset)(nil),
 		(*GetDatasetResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[40].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[52].OneofWrappers = []interface{}{
 		(*GetAllDatasetsResponse_Dataset)(nil),
 		(*GetAllDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[44].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*UpdateDatasetResponse_Message)(nil),
 		(*UpdateDatasetResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[46].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[58].OneofWrappers = []interface{}{
 		(*AddDatasetsResponse_Message)(nil),
 		(*AddDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[48].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[60].OneofWrappers = []interface{}{
 		(*ImportDatasetsResponse_Message)(nil),
 		(*ImportDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[50].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[62].OneofWrappers = []interface{}{
 		(*GetDatasetHistoryResponse_Dataset)(nil),
 		(*GetDatasetHistoryResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[52].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[64].OneofWrappers = []interface{}{
+		(*GetDatasetChangesResponse_Entry)(nil),
+		(*GetDatasetChangesResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[66].OneofWrappers = []interface{}{
+		(*RestoreDatasetResponse_Dataset)(nil),
+		(*RestoreDatasetResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[68].OneofWrappers = []interface{}{
+		(*GetDatasetCommentsResponse_Comment)(nil),
+		(*GetDatasetCommentsResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[70].OneofWrappers = []interface{}{
+		(*AddDatasetCommentResponse_Comment)(nil),
+		(*AddDatasetCommentResponse_Error)(nil),
+	}
+	file_biblio_proto_msgTypes[72].OneofWrappers = []interface{}{
 		(*PurgeDatasetResponse_Ok)(nil),
 		(*PurgeDatasetResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[54].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[74].OneofWrappers = []interface{}{
 		(*PurgeAllDatasetsResponse_Ok)(nil),
 		(*PurgeAllDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[56].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[76].OneofWrappers = []interface{}{
 		(*ValidateDatasetsResponse_Results)(nil),
 		(*ValidateDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[58].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[78].OneofWrappers = []interface{}{
 		(*ReindexDatasetsResponse_Message)(nil),
 		(*ReindexDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[60].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[80].OneofWrappers = []interface{}{
 		(*CleanupDatasetsResponse_Message)(nil),
 		(*CleanupDatasetsResponse_Error)(nil),
 	}
-	file_biblio_proto_msgTypes[61].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[81].OneofWrappers = []interface{}{
 		(*RelateRequest_PublicationOne)(nil),
 		(*RelateRequest_DatasetOne)(nil),
 		(*RelateRequest_PublicationTwo)(nil),
 		(*RelateRequest_DatasetTwo)(nil),
 	}
-	file_biblio_proto_msgTypes[62].OneofWrappers = []interface{}{
+	file_biblio_proto_msgTypes[82].OneofWrappers = []interface{}{
 		(*RelateResponse_Message)(nil),
 		(*RelateResponse_Error)(nil),
 	}
@@ -5622,7 +7523,7 @@ func file_biblio_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_biblio_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   63,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,37 +26,46 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Biblio_GetFile_FullMethodName               = "/biblio.v1.Biblio/GetFile"
-	Biblio_AddFile_FullMethodName               = "/biblio.v1.Biblio/AddFile"
-	Biblio_ExistsFile_FullMethodName            = "/biblio.v1.Biblio/ExistsFile"
-	Biblio_GetPublication_FullMethodName        = "/biblio.v1.Biblio/GetPublication"
-	Biblio_GetAllPublications_FullMethodName    = "/biblio.v1.Biblio/GetAllPublications"
-	Biblio_SearchPublications_FullMethodName    = "/biblio.v1.Biblio/SearchPublications"
-	Biblio_UpdatePublication_FullMethodName     = "/biblio.v1.Biblio/UpdatePublication"
-	Biblio_AddPublications_FullMethodName       = "/biblio.v1.Biblio/AddPublications"
-	Biblio_ImportPublications_FullMethodName    = "/biblio.v1.Biblio/ImportPublications"
-	Biblio_MutatePublications_FullMethodName    = "/biblio.v1.Biblio/MutatePublications"
-	Biblio_GetPublicationHistory_FullMethodName = "/biblio.v1.Biblio/GetPublicationHistory"
-	Biblio_PurgePublication_FullMethodName      = "/biblio.v1.Biblio/PurgePublication"
-	Biblio_PurgeAllPublications_FullMethodName  = "/biblio.v1.Biblio/PurgeAllPublications"
-	Biblio_ValidatePublications_FullMethodName  = "/biblio.v1.Biblio/ValidatePublications"
-	Biblio_ReindexPublications_FullMethodName   = "/biblio.v1.Biblio/ReindexPublications"
-	Biblio_TransferPublications_FullMethodName  = "/biblio.v1.Biblio/TransferPublications"
-	Biblio_CleanupPublications_FullMethodName   = "/biblio.v1.Biblio/CleanupPublications"
-	Biblio_GetDataset_FullMethodName            = "/biblio.v1.Biblio/GetDataset"
-	Biblio_GetAllDatasets_FullMethodName        = "/biblio.v1.Biblio/GetAllDatasets"
-	Biblio_SearchDatasets_FullMethodName        = "/biblio.v1.Biblio/SearchDatasets"
-	Biblio_UpdateDataset_FullMethodName         = "/biblio.v1.Biblio/UpdateDataset"
-	Biblio_AddDatasets_FullMethodName           = "/biblio.v1.Biblio/AddDatasets"
-	Biblio_ImportDatasets_FullMethodName        = "/biblio.v1.Biblio/ImportDatasets"
-	Biblio_MutateDatasets_FullMethodName        = "/biblio.v1.Biblio/MutateDatasets"
-	Biblio_GetDatasetHistory_FullMethodName     = "/biblio.v1.Biblio/GetDatasetHistory"
-	Biblio_PurgeDataset_FullMethodName          = "/biblio.v1.Biblio/PurgeDataset"
-	Biblio_PurgeAllDatasets_FullMethodName      = "/biblio.v1.Biblio/PurgeAllDatasets"
-	Biblio_ValidateDatasets_FullMethodName      = "/biblio.v1.Biblio/ValidateDatasets"
-	Biblio_ReindexDatasets_FullMethodName       = "/biblio.v1.Biblio/ReindexDatasets"
-	Biblio_CleanupDatasets_FullMethodName       = "/biblio.v1.Biblio/CleanupDatasets"
-	Biblio_Relate_FullMethodName                = "/biblio.v1.Biblio/Relate"
+	Biblio_GetFile_FullMethodName                = "/biblio.v1.Biblio/GetFile"
+	Biblio_AddFile_FullMethodName                = "/biblio.v1.Biblio/AddFile"
+	Biblio_ExistsFile_FullMethodName             = "/biblio.v1.Biblio/ExistsFile"
+	Biblio_GetPublication_FullMethodName         = "/biblio.v1.Biblio/GetPublication"
+	Biblio_GetAllPublications_FullMethodName     = "/biblio.v1.Biblio/GetAllPublications"
+	Biblio_SearchPublications_FullMethodName     = "/biblio.v1.Biblio/SearchPublications"
+	Biblio_UpdatePublication_FullMethodName      = "/biblio.v1.Biblio/UpdatePublication"
+	Biblio_AddPublications_FullMethodName        = "/biblio.v1.Biblio/AddPublications"
+	Biblio_ImportPublications_FullMethodName     = "/biblio.v1.Biblio/ImportPublications"
+	Biblio_MutatePublications_FullMethodName     = "/biblio.v1.Biblio/MutatePublications"
+	Biblio_GetPublicationHistory_FullMethodName  = "/biblio.v1.Biblio/GetPublicationHistory"
+	Biblio_GetPublicationChanges_FullMethodName  = "/biblio.v1.Biblio/GetPublicationChanges"
+	Biblio_RestorePublication_FullMethodName     = "/biblio.v1.Biblio/RestorePublication"
+	Biblio_GetPublicationComments_FullMethodName = "/biblio.v1.Biblio/GetPublicationComments"
+	Biblio_AddPublicationComment_FullMethodName  = "/biblio.v1.Biblio/AddPublicationComment"
+	Biblio_PurgePublication_FullMethodName       = "/biblio.v1.Biblio/PurgePublication"
+	Biblio_PurgeAllPublications_FullMethodName   = "/biblio.v1.Biblio/PurgeAllPublications"
+	Biblio_MergePublications_FullMethodName      = "/biblio.v1.Biblio/MergePublications"
+	Biblio_ValidatePublications_FullMethodName   = "/biblio.v1.Biblio/ValidatePublications"
+	Biblio_ReindexPublications_FullMethodName    = "/biblio.v1.Biblio/ReindexPublications"
+	Biblio_TransferPublications_FullMethodName   = "/biblio.v1.Biblio/TransferPublications"
+	Biblio_CleanupPublications_FullMethodName    = "/biblio.v1.Biblio/CleanupPublications"
+	Biblio_GetDataset_FullMethodName             = "/biblio.v1.Biblio/GetDataset"
+	Biblio_GetAllDatasets_FullMethodName         = "/biblio.v1.Biblio/GetAllDatasets"
+	Biblio_SearchDatasets_FullMethodName         = "/biblio.v1.Biblio/SearchDatasets"
+	Biblio_UpdateDataset_FullMethodName          = "/biblio.v1.Biblio/UpdateDataset"
+	Biblio_AddDatasets_FullMethodName            = "/biblio.v1.Biblio/AddDatasets"
+	Biblio_ImportDatasets_FullMethodName         = "/biblio.v1.Biblio/ImportDatasets"
+	Biblio_MutateDatasets_FullMethodName         = "/biblio.v1.Biblio/MutateDatasets"
+	Biblio_GetDatasetHistory_FullMethodName      = "/biblio.v1.Biblio/GetDatasetHistory"
+	Biblio_GetDatasetChanges_FullMethodName      = "/biblio.v1.Biblio/GetDatasetChanges"
+	Biblio_RestoreDataset_FullMethodName         = "/biblio.v1.Biblio/RestoreDataset"
+	Biblio_GetDatasetComments_FullMethodName     = "/biblio.v1.Biblio/GetDatasetComments"
+	Biblio_AddDatasetComment_FullMethodName      = "/biblio.v1.Biblio/AddDatasetComment"
+	Biblio_PurgeDataset_FullMethodName           = "/biblio.v1.Biblio/PurgeDataset"
+	Biblio_PurgeAllDatasets_FullMethodName       = "/biblio.v1.Biblio/PurgeAllDatasets"
+	Biblio_ValidateDatasets_FullMethodName       = "/biblio.v1.Biblio/ValidateDatasets"
+	Biblio_ReindexDatasets_FullMethodName        = "/biblio.v1.Biblio/ReindexDatasets"
+	Biblio_CleanupDatasets_FullMethodName        = "/biblio.v1.Biblio/CleanupDatasets"
+	Biblio_Relate_FullMethodName                 = "/biblio.v1.Biblio/Relate"
 )
 
 // BiblioClient is the client API for Biblio service.
@@ -74,8 +83,13 @@ type BiblioClient interface {
 	ImportPublications(ctx context.Context, opts ...grpc.CallOption) (Biblio_ImportPublicationsClient, error)
 	MutatePublications(ctx context.Context, opts ...grpc.CallOption) (Biblio_MutatePublicationsClient, error)
 	GetPublicationHistory(ctx context.Context, in *GetPublicationHistoryRequest, opts ...grpc.CallOption) (Biblio_GetPublicationHistoryClient, error)
+	GetPublicationChanges(ctx context.Context, in *GetPublicationChangesRequest, opts ...grpc.CallOption) (Biblio_GetPublicationChangesClient, error)
+	RestorePublication(ctx context.Context, in *RestorePublicationRequest, opts ...grpc.CallOption) (*RestorePublicationResponse, error)
+	GetPublicationComments(ctx context.Context, in *GetPublicationCommentsRequest, opts ...grpc.CallOption) (Biblio_GetPublicationCommentsClient, error)
+	AddPublicationComment(ctx context.Context, in *AddPublicationCommentRequest, opts ...grpc.CallOption) (*AddPublicationCommentResponse, error)
 	PurgePublication(ctx context.Context, in *PurgePublicationRequest, opts ...grpc.CallOption) (*PurgePublicationResponse, error)
 	PurgeAllPublications(ctx context.Context, in *PurgeAllPublicationsRequest, opts ...grpc.CallOption) (*PurgeAllPublicationsResponse, error)
+	MergePublications(ctx context.Context, in *MergePublicationsRequest, opts ...grpc.CallOption) (*MergePublicationsResponse, error)
 	ValidatePublications(ctx context.Context, opts ...grpc.CallOption) (Biblio_ValidatePublicationsClient, error)
 	ReindexPublications(ctx context.Context, in *ReindexPublicationsRequest, opts ...grpc.CallOption) (Biblio_ReindexPublicationsClient, error)
 	TransferPublications(ctx context.Context, in *TransferPublicationsRequest, opts ...grpc.CallOption) (Biblio_TransferPublicationsClient, error)
@@ -88,6 +102,10 @@ type BiblioClient interface {
 	ImportDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_ImportDatasetsClient, error)
 	MutateDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_MutateDatasetsClient, error)
 	GetDatasetHistory(ctx context.Context, in *GetDatasetHistoryRequest, opts ...grpc.CallOption) (Biblio_GetDatasetHistoryClient, error)
+	GetDatasetChanges(ctx context.Context, in *GetDatasetChangesRequest, opts ...grpc.CallOption) (Biblio_GetDatasetChangesClient, error)
+	RestoreDataset(ctx context.Context, in *RestoreDatasetRequest, opts ...grpc.CallOption) (*RestoreDatasetResponse, error)
+	GetDatasetComments(ctx context.Context, in *GetDatasetCommentsRequest, opts ...grpc.CallOption) (Biblio_GetDatasetCommentsClient, error)
+	AddDatasetComment(ctx context.Context, in *AddDatasetCommentRequest, opts ...grpc.CallOption) (*AddDatasetCommentResponse, error)
 	PurgeDataset(ctx context.Context, in *PurgeDatasetRequest, opts ...grpc.CallOption) (*PurgeDatasetResponse, error)
 	PurgeAllDatasets(ctx context.Context, in *PurgeAllDatasetsRequest, opts ...grpc.CallOption) (*PurgeAllDatasetsResponse, error)
 	ValidateDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_ValidateDatasetsClient, error)
@@ -363,6 +381,88 @@ func (x *biblioGetPublicationHistoryClient) Recv() (*GetPublicationHistoryRespon
 	return m, nil
 }
 
+func (c *biblioClient) GetPublicationChanges(ctx context.Context, in *GetPublicationChangesRequest, opts ...grpc.CallOption) (Biblio_GetPublicationChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[7], Biblio_GetPublicationChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &biblioGetPublicationChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Biblio_GetPublicationChangesClient interface {
+	Recv() (*GetPublicationChangesResponse, error)
+	grpc.ClientStream
+}
+
+type biblioGetPublicationChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *biblioGetPublicationChangesClient) Recv() (*GetPublicationChangesResponse, error) {
+	m := new(GetPublicationChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *biblioClient) RestorePublication(ctx context.Context, in *RestorePublicationRequest, opts ...grpc.CallOption) (*RestorePublicationResponse, error) {
+	out := new(RestorePublicationResponse)
+	err := c.cc.Invoke(ctx, Biblio_RestorePublication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *biblioClient) GetPublicationComments(ctx context.Context, in *GetPublicationCommentsRequest, opts ...grpc.CallOption) (Biblio_GetPublicationCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[8], Biblio_GetPublicationComments_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &biblioGetPublicationCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Biblio_GetPublicationCommentsClient interface {
+	Recv() (*GetPublicationCommentsResponse, error)
+	grpc.ClientStream
+}
+
+type biblioGetPublicationCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *biblioGetPublicationCommentsClient) Recv() (*GetPublicationCommentsResponse, error) {
+	m := new(GetPublicationCommentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *biblioClient) AddPublicationComment(ctx context.Context, in *AddPublicationCommentRequest, opts ...grpc.CallOption) (*AddPublicationCommentResponse, error) {
+	out := new(AddPublicationCommentResponse)
+	err := c.cc.Invoke(ctx, Biblio_AddPublicationComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *biblioClient) PurgePublication(ctx context.Context, in *PurgePublicationRequest, opts ...grpc.CallOption) (*PurgePublicationResponse, error) {
 	out := new(PurgePublicationResponse)
 	err := c.cc.Invoke(ctx, Biblio_PurgePublication_FullMethodName, in, out, opts...)
@@ -381,8 +481,17 @@ func (c *biblioClient) PurgeAllPublications(ctx context.Context, in *PurgeAllPub
 	return out, nil
 }
 
+func (c *biblioClient) MergePublications(ctx context.Context, in *MergePublicationsRequest, opts ...grpc.CallOption) (*MergePublicationsResponse, error) {
+	out := new(MergePublicationsResponse)
+	err := c.cc.Invoke(ctx, Biblio_MergePublications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *biblioClient) ValidatePublications(ctx context.Context, opts ...grpc.CallOption) (Biblio_ValidatePublicationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[7], Biblio_ValidatePublications_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[9], Biblio_ValidatePublications_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -413,7 +522,7 @@ func (x *biblioValidatePublicationsClient) Recv() (*ValidatePublicationsResponse
 }
 
 func (c *biblioClient) ReindexPublications(ctx context.Context, in *ReindexPublicationsRequest, opts ...grpc.CallOption) (Biblio_ReindexPublicationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[8], Biblio_ReindexPublications_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[10], Biblio_ReindexPublications_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +554,7 @@ func (x *biblioReindexPublicationsClient) Recv() (*ReindexPublicationsResponse,
 }
 
 func (c *biblioClient) TransferPublications(ctx context.Context, in *TransferPublicationsRequest, opts ...grpc.CallOption) (Biblio_TransferPublicationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[9], Biblio_TransferPublications_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[11], Biblio_TransferPublications_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -477,7 +586,7 @@ func (x *biblioTransferPublicationsClient) Recv() (*TransferPublicationsResponse
 }
 
 func (c *biblioClient) CleanupPublications(ctx context.Context, in *CleanupPublicationsRequest, opts ...grpc.CallOption) (Biblio_CleanupPublicationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[10], Biblio_CleanupPublications_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[12], Biblio_CleanupPublications_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -518,7 +627,7 @@ func (c *biblioClient) GetDataset(ctx context.Context, in *GetDatasetRequest, op
 }
 
 func (c *biblioClient) GetAllDatasets(ctx context.Context, in *GetAllDatasetsRequest, opts ...grpc.CallOption) (Biblio_GetAllDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[11], Biblio_GetAllDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[13], Biblio_GetAllDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +677,7 @@ func (c *biblioClient) UpdateDataset(ctx context.Context, in *UpdateDatasetReque
 }
 
 func (c *biblioClient) AddDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_AddDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[12], Biblio_AddDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[14], Biblio_AddDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -599,7 +708,7 @@ func (x *biblioAddDatasetsClient) Recv() (*AddDatasetsResponse, error) {
 }
 
 func (c *biblioClient) ImportDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_ImportDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[13], Biblio_ImportDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[15], Biblio_ImportDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -630,7 +739,7 @@ func (x *biblioImportDatasetsClient) Recv() (*ImportDatasetsResponse, error) {
 }
 
 func (c *biblioClient) MutateDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_MutateDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[14], Biblio_MutateDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[16], Biblio_MutateDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -661,7 +770,7 @@ func (x *biblioMutateDatasetsClient) Recv() (*MutateResponse, error) {
 }
 
 func (c *biblioClient) GetDatasetHistory(ctx context.Context, in *GetDatasetHistoryRequest, opts ...grpc.CallOption) (Biblio_GetDatasetHistoryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[15], Biblio_GetDatasetHistory_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[17], Biblio_GetDatasetHistory_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -692,6 +801,88 @@ func (x *biblioGetDatasetHistoryClient) Recv() (*GetDatasetHistoryResponse, erro
 	return m, nil
 }
 
+func (c *biblioClient) GetDatasetChanges(ctx context.Context, in *GetDatasetChangesRequest, opts ...grpc.CallOption) (Biblio_GetDatasetChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[18], Biblio_GetDatasetChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &biblioGetDatasetChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Biblio_GetDatasetChangesClient interface {
+	Recv() (*GetDatasetChangesResponse, error)
+	grpc.ClientStream
+}
+
+type biblioGetDatasetChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *biblioGetDatasetChangesClient) Recv() (*GetDatasetChangesResponse, error) {
+	m := new(GetDatasetChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *biblioClient) RestoreDataset(ctx context.Context, in *RestoreDatasetRequest, opts ...grpc.CallOption) (*RestoreDatasetResponse, error) {
+	out := new(RestoreDatasetResponse)
+	err := c.cc.Invoke(ctx, Biblio_RestoreDataset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *biblioClient) GetDatasetComments(ctx context.Context, in *GetDatasetCommentsRequest, opts ...grpc.CallOption) (Biblio_GetDatasetCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[19], Biblio_GetDatasetComments_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &biblioGetDatasetCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Biblio_GetDatasetCommentsClient interface {
+	Recv() (*GetDatasetCommentsResponse, error)
+	grpc.ClientStream
+}
+
+type biblioGetDatasetCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *biblioGetDatasetCommentsClient) Recv() (*GetDatasetCommentsResponse, error) {
+	m := new(GetDatasetCommentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *biblioClient) AddDatasetComment(ctx context.Context, in *AddDatasetCommentRequest, opts ...grpc.CallOption) (*AddDatasetCommentResponse, error) {
+	out := new(AddDatasetCommentResponse)
+	err := c.cc.Invoke(ctx, Biblio_AddDatasetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *biblioClient) PurgeDataset(ctx context.Context, in *PurgeDatasetRequest, opts ...grpc.CallOption) (*PurgeDatasetResponse, error) {
 	out := new(PurgeDatasetResponse)
 	err := c.cc.Invoke(ctx, Biblio_PurgeDataset_FullMethodName, in, out, opts...)
@@ -711,7 +902,7 @@ func (c *biblioClient) PurgeAllDatasets(ctx context.Context, in *PurgeAllDataset
 }
 
 func (c *biblioClient) ValidateDatasets(ctx context.Context, opts ...grpc.CallOption) (Biblio_ValidateDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[16], Biblio_ValidateDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[20], Biblio_ValidateDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -742,7 +933,7 @@ func (x *biblioValidateDatasetsClient) Recv() (*ValidateDatasetsResponse, error)
 }
 
 func (c *biblioClient) ReindexDatasets(ctx context.Context, in *ReindexDatasetsRequest, opts ...grpc.CallOption) (Biblio_ReindexDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[17], Biblio_ReindexDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[21], Biblio_ReindexDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -774,7 +965,7 @@ func (x *biblioReindexDatasetsClient) Recv() (*ReindexDatasetsResponse, error) {
 }
 
 func (c *biblioClient) CleanupDatasets(ctx context.Context, in *CleanupDatasetsRequest, opts ...grpc.CallOption) (Biblio_CleanupDatasetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[18], Biblio_CleanupDatasets_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Biblio_ServiceDesc.Streams[22], Biblio_CleanupDatasets_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -829,8 +1020,13 @@ type BiblioServer interface {
 	ImportPublications(Biblio_ImportPublicationsServer) error
 	MutatePublications(Biblio_MutatePublicationsServer) error
 	GetPublicationHistory(*GetPublicationHistoryRequest, Biblio_GetPublicationHistoryServer) error
+	GetPublicationChanges(*GetPublicationChangesRequest, Biblio_GetPublicationChangesServer) error
+	RestorePublication(context.Context, *RestorePublicationRequest) (*RestorePublicationResponse, error)
+	GetPublicationComments(*GetPublicationCommentsRequest, Biblio_GetPublicationCommentsServer) error
+	AddPublicationComment(context.Context, *AddPublicationCommentRequest) (*AddPublicationCommentResponse, error)
 	PurgePublication(context.Context, *PurgePublicationRequest) (*PurgePublicationResponse, error)
 	PurgeAllPublications(context.Context, *PurgeAllPublicationsRequest) (*PurgeAllPublicationsResponse, error)
+	MergePublications(context.Context, *MergePublicationsRequest) (*MergePublicationsResponse, error)
 	ValidatePublications(Biblio_ValidatePublicationsServer) error
 	ReindexPublications(*ReindexPublicationsRequest, Biblio_ReindexPublicationsServer) error
 	TransferPublications(*TransferPublicationsRequest, Biblio_TransferPublicationsServer) error
@@ -843,6 +1039,10 @@ type BiblioServer interface {
 	ImportDatasets(Biblio_ImportDatasetsServer) error
 	MutateDatasets(Biblio_MutateDatasetsServer) error
 	GetDatasetHistory(*GetDatasetHistoryRequest, Biblio_GetDatasetHistoryServer) error
+	GetDatasetChanges(*GetDatasetChangesRequest, Biblio_GetDatasetChangesServer) error
+	RestoreDataset(context.Context, *RestoreDatasetRequest) (*RestoreDatasetResponse, error)
+	GetDatasetComments(*GetDatasetCommentsRequest, Biblio_GetDatasetCommentsServer) error
+	AddDatasetComment(context.Context, *AddDatasetCommentRequest) (*AddDatasetCommentResponse, error)
 	PurgeDataset(context.Context, *PurgeDatasetRequest) (*PurgeDatasetResponse, error)
 	PurgeAllDatasets(context.Context, *PurgeAllDatasetsRequest) (*PurgeAllDatasetsResponse, error)
 	ValidateDatasets(Biblio_ValidateDatasetsServer) error
@@ -889,12 +1089,27 @@ func (UnimplementedBiblioServer) MutatePublications(Biblio_MutatePublicationsSer
 func (UnimplementedBiblioServer) GetPublicationHistory(*GetPublicationHistoryRequest, Biblio_GetPublicationHistoryServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetPublicationHistory not implemented")
 }
+func (UnimplementedBiblioServer) GetPublicationChanges(*GetPublicationChangesRequest, Biblio_GetPublicationChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPublicationChanges not implemented")
+}
+func (UnimplementedBiblioServer) RestorePublication(context.Context, *RestorePublicationRequest) (*RestorePublicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePublication not implemented")
+}
+func (UnimplementedBiblioServer) GetPublicationComments(*GetPublicationCommentsRequest, Biblio_GetPublicationCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPublicationComments not implemented")
+}
+func (UnimplementedBiblioServer) AddPublicationComment(context.Context, *AddPublicationCommentRequest) (*AddPublicationCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPublicationComment not implemented")
+}
 func (UnimplementedBiblioServer) PurgePublication(context.Context, *PurgePublicationRequest) (*PurgePublicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePublication not implemented")
 }
 func (UnimplementedBiblioServer) PurgeAllPublications(context.Context, *PurgeAllPublicationsRequest) (*PurgeAllPublicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeAllPublications not implemented")
 }
+func (UnimplementedBiblioServer) MergePublications(context.Context, *MergePublicationsRequest) (*MergePublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePublications not implemented")
+}
 func (UnimplementedBiblioServer) ValidatePublications(Biblio_ValidatePublicationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ValidatePublications not implemented")
 }
@@ -931,6 +1146,18 @@ func (UnimplementedBiblioServer) MutateDatasets(Biblio_MutateDatasetsServer) err
 func (UnimplementedBiblioServer) GetDatasetHistory(*GetDatasetHistoryRequest, Biblio_GetDatasetHistoryServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetDatasetHistory not implemented")
 }
+func (UnimplementedBiblioServer) GetDatasetChanges(*GetDatasetChangesRequest, Biblio_GetDatasetChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDatasetChanges not implemented")
+}
+func (UnimplementedBiblioServer) RestoreDataset(context.Context, *RestoreDatasetRequest) (*RestoreDatasetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDataset not implemented")
+}
+func (UnimplementedBiblioServer) GetDatasetComments(*GetDatasetCommentsRequest, Biblio_GetDatasetCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDatasetComments not implemented")
+}
+func (UnimplementedBiblioServer) AddDatasetComment(context.Context, *AddDatasetCommentRequest) (*AddDatasetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDatasetComment not implemented")
+}
 func (UnimplementedBiblioServer) PurgeDataset(context.Context, *PurgeDatasetRequest) (*PurgeDatasetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeDataset not implemented")
 }
@@ -1201,6 +1428,84 @@ func (x *biblioGetPublicationHistoryServer) Send(m *GetPublicationHistoryRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Biblio_GetPublicationChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPublicationChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BiblioServer).GetPublicationChanges(m, &biblioGetPublicationChangesServer{stream})
+}
+
+type Biblio_GetPublicationChangesServer interface {
+	Send(*GetPublicationChangesResponse) error
+	grpc.ServerStream
+}
+
+type biblioGetPublicationChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *biblioGetPublicationChangesServer) Send(m *GetPublicationChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Biblio_RestorePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiblioServer).RestorePublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Biblio_RestorePublication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiblioServer).RestorePublication(ctx, req.(*RestorePublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Biblio_GetPublicationComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPublicationCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BiblioServer).GetPublicationComments(m, &biblioGetPublicationCommentsServer{stream})
+}
+
+type Biblio_GetPublicationCommentsServer interface {
+	Send(*GetPublicationCommentsResponse) error
+	grpc.ServerStream
+}
+
+type biblioGetPublicationCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *biblioGetPublicationCommentsServer) Send(m *GetPublicationCommentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Biblio_AddPublicationComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPublicationCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiblioServer).AddPublicationComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Biblio_AddPublicationComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiblioServer).AddPublicationComment(ctx, req.(*AddPublicationCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Biblio_PurgePublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgePublicationRequest)
 	if err := dec(in); err != nil {
@@ -1237,6 +1542,24 @@ func _Biblio_PurgeAllPublications_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Biblio_MergePublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePublicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiblioServer).MergePublications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Biblio_MergePublications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiblioServer).MergePublications(ctx, req.(*MergePublicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Biblio_ValidatePublications_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(BiblioServer).ValidatePublications(&biblioValidatePublicationsServer{stream})
 }
@@ -1500,6 +1823,84 @@ func (x *biblioGetDatasetHistoryServer) Send(m *GetDatasetHistoryResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Biblio_GetDatasetChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDatasetChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BiblioServer).GetDatasetChanges(m, &biblioGetDatasetChangesServer{stream})
+}
+
+type Biblio_GetDatasetChangesServer interface {
+	Send(*GetDatasetChangesResponse) error
+	grpc.ServerStream
+}
+
+type biblioGetDatasetChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *biblioGetDatasetChangesServer) Send(m *GetDatasetChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Biblio_RestoreDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDatasetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiblioServer).RestoreDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Biblio_RestoreDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiblioServer).RestoreDataset(ctx, req.(*RestoreDatasetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Biblio_GetDatasetComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDatasetCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BiblioServer).GetDatasetComments(m, &biblioGetDatasetCommentsServer{stream})
+}
+
+type Biblio_GetDatasetCommentsServer interface {
+	Send(*GetDatasetCommentsResponse) error
+	grpc.ServerStream
+}
+
+type biblioGetDatasetCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *biblioGetDatasetCommentsServer) Send(m *GetDatasetCommentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Biblio_AddDatasetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDatasetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiblioServer).AddDatasetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Biblio_AddDatasetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiblioServer).AddDatasetComment(ctx, req.(*AddDatasetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Biblio_PurgeDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgeDatasetRequest)
 	if err := dec(in); err != nil {
@@ -1645,6 +2046,14 @@ var Biblio_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePublication",
 			Handler:    _Biblio_UpdatePublication_Handler,
 		},
+		{
+			MethodName: "RestorePublication",
+			Handler:    _Biblio_RestorePublication_Handler,
+		},
+		{
+			MethodName: "AddPublicationComment",
+			Handler:    _Biblio_AddPublicationComment_Handler,
+		},
 		{
 			MethodName: "PurgePublication",
 			Handler:    _Biblio_PurgePublication_Handler,
@@ -1653,6 +2062,10 @@ var Biblio_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeAllPublications",
 			Handler:    _Biblio_PurgeAllPublications_Handler,
 		},
+		{
+			MethodName: "MergePublications",
+			Handler:    _Biblio_MergePublications_Handler,
+		},
 		{
 			MethodName: "GetDataset",
 			Handler:    _Biblio_GetDataset_Handler,
@@ -1665,6 +2078,14 @@ var Biblio_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDataset",
 			Handler:    _Biblio_UpdateDataset_Handler,
 		},
+		{
+			MethodName: "RestoreDataset",
+			Handler:    _Biblio_RestoreDataset_Handler,
+		},
+		{
+			MethodName: "AddDatasetComment",
+			Handler:    _Biblio_AddDatasetComment_Handler,
+		},
 		{
 			MethodName: "PurgeDataset",
 			Handler:    _Biblio_PurgeDataset_Handler,
@@ -1717,6 +2138,16 @@ var Biblio_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Biblio_GetPublicationHistory_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetPublicationChanges",
+			Handler:       _Biblio_GetPublicationChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPublicationComments",
+			Handler:       _Biblio_GetPublicationComments_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ValidatePublications",
 			Handler:       _Biblio_ValidatePublications_Handler,
@@ -1766,6 +2197,16 @@ var Biblio_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Biblio_GetDatasetHistory_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetDatasetChanges",
+			Handler:       _Biblio_GetDatasetChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDatasetComments",
+			Handler:       _Biblio_GetDatasetComments_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ValidateDatasets",
 			Handler:       _Biblio_ValidateDatasets_Handler,
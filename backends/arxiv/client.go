@@ -18,17 +18,20 @@ var reNormalizeID = regexp.MustCompile(`(?i)^arxiv:`)
 type Feed struct {
 	XMLName      xml.Name `xml:"feed"`
 	TotalResults int      `xml:"totalResults"`
-	Entry        Entry    `xml:"entry"`
+	Entries      []Entry  `xml:"entry"`
 }
 
 type Entry struct {
+	// e.g. http://arxiv.org/abs/2101.00001v1
+	ID         string `xml:"id"`
 	Title      string `xml:"title"`
 	Summary    string `xml:"summary"`
 	Published  string `xml:"published"`
 	DOI        string `xml:"doi"`
 	JournalRef string `xml:"journal_ref"`
 	Author     []struct {
-		Name string `xml:"name"`
+		Name        string   `xml:"name"`
+		Affiliation []string `xml:"affiliation"`
 	} `xml:"author"`
 	Comment string `xml:"comment"`
 }
@@ -80,35 +83,40 @@ func (c *Client) GetPublication(id string) (*models.Publication, error) {
 		return nil, fmt.Errorf("arxiv: unmarshalling response failed: %w", err)
 	}
 
-	if feed.TotalResults != 1 {
+	if feed.TotalResults != 1 || len(feed.Entries) != 1 {
 		return nil, fmt.Errorf("arxiv: expected 1 entry, but found %d", feed.TotalResults)
 	}
 
+	return MapEntry(id, feed.Entries[0]), nil
+}
+
+// MapEntry maps a feed entry to a publication
+func MapEntry(id string, entry Entry) *models.Publication {
 	p := &models.Publication{
 		Type:               "journal_article",
 		PublicationStatus:  "unpublished",
 		JournalArticleType: "original",
 		ArxivID:            id,
-		Title:              feed.Entry.Title,
-		DOI:                feed.Entry.DOI,
-		Publication:        feed.Entry.JournalRef,
-		AdditionalInfo:     feed.Entry.Comment,
+		Title:              entry.Title,
+		DOI:                entry.DOI,
+		Publication:        entry.JournalRef,
+		AdditionalInfo:     entry.Comment,
 	}
 
-	if feed.Entry.Summary != "" {
+	if entry.Summary != "" {
 		p.AddAbstract(&models.Text{
-			Text: feed.Entry.Summary,
+			Text: entry.Summary,
 			Lang: "und",
 		})
 	}
 
-	if len(feed.Entry.Published) > 4 {
-		p.Year = feed.Entry.Published[0:4]
-	} else if len(feed.Entry.Published) == 4 {
-		p.Year = feed.Entry.Published
+	if len(entry.Published) > 4 {
+		p.Year = entry.Published[0:4]
+	} else if len(entry.Published) == 4 {
+		p.Year = entry.Published
 	}
 
-	for _, a := range feed.Entry.Author {
+	for _, a := range entry.Author {
 		nameParts := strings.Split(a.Name, " ")
 		firstName := nameParts[0]
 		lastName := nameParts[0]
@@ -118,5 +126,5 @@ func (c *Client) GetPublication(id string) (*models.Publication, error) {
 		p.Author = append(p.Author, models.ContributorFromFirstLastName(firstName, lastName))
 	}
 
-	return p, nil
+	return p
 }
@@ -110,6 +110,31 @@ func (c *Client) GetPerson(id string) (*models.Person, error) {
 	return c.recordToPerson(record)
 }
 
+func (c *Client) EachPersonORCID(ctx context.Context, fn func(string) error) error {
+	cursor, err := c.mongo.Database("authority").Collection("person").Find(
+		ctx,
+		bson.M{"active": 1, "orcid_id": bson.M{"$exists": true, "$ne": ""}},
+		options.Find().SetProjection(bson.M{"orcid_id": 1}),
+	)
+	if err != nil {
+		return errors.Wrap(err, "unexpected error during document retrieval")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var record struct {
+			ORCID string `bson:"orcid_id"`
+		}
+		if err := cursor.Decode(&record); err != nil {
+			return errors.Wrap(err, "unexpected error during document retrieval")
+		}
+		if err := fn(record.ORCID); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
 func (c *Client) SuggestPeople(q string) ([]*models.Person, error) {
 	limit := 20
 	persons := make([]*models.Person, 0, limit)
@@ -0,0 +1,201 @@
+package bibtex
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const ContentType = "application/x-bibtex"
+
+type field struct {
+	name  string
+	value string
+}
+
+func EncodePublication(p *models.Publication) ([]byte, error) {
+	b := &bytes.Buffer{}
+	writeEntry(b, p)
+	return b.Bytes(), nil
+}
+
+type Exporter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func NewExporter(w io.Writer) backends.PublicationListExporter {
+	return &Exporter{w: bufio.NewWriter(w)}
+}
+
+func (x *Exporter) GetContentType() string {
+	return ContentType
+}
+
+// Add writes the entry immediately, errors are returned by Flush
+func (x *Exporter) Add(p *models.Publication) {
+	if x.err != nil {
+		return
+	}
+	b := &bytes.Buffer{}
+	writeEntry(b, p)
+	b.WriteString("\n")
+	_, x.err = x.w.Write(b.Bytes())
+}
+
+func (x *Exporter) Flush() error {
+	if x.err != nil {
+		return x.err
+	}
+	return x.w.Flush()
+}
+
+func writeEntry(b *bytes.Buffer, p *models.Publication) {
+	b.WriteString("@")
+	b.WriteString(entryType(p))
+	b.WriteString("{")
+	b.WriteString(p.ID)
+	b.WriteString(",\n")
+
+	for _, f := range fields(p) {
+		if f.value == "" {
+			continue
+		}
+		b.WriteString("  ")
+		b.WriteString(f.name)
+		b.WriteString(" = {")
+		b.WriteString(escape(f.value))
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("}\n")
+}
+
+// entryType is the inverse of the type mapping in the decoder
+func entryType(p *models.Publication) string {
+	switch p.Type {
+	case "journal_article":
+		return "article"
+	case "book", "book_editor":
+		return "book"
+	case "book_chapter":
+		return "incollection"
+	case "conference":
+		return "inproceedings"
+	case "dissertation":
+		return "phdthesis"
+	case "miscellaneous":
+		switch p.MiscellaneousType {
+		case "preprint":
+			return "unpublished"
+		case "report":
+			return "techreport"
+		}
+	}
+	return "misc"
+}
+
+func fields(p *models.Publication) []field {
+	fields := []field{
+		{"author", names(p.Author)},
+		{"editor", names(p.Editor)},
+		{"title", p.Title},
+		{"year", p.Year},
+	}
+
+	switch p.Type {
+	case "journal_article":
+		fields = append(fields, field{"journal", p.Publication})
+	case "book_chapter", "conference":
+		fields = append(fields, field{"booktitle", p.Publication})
+	}
+
+	pages := p.PageFirst
+	if p.PageLast != "" {
+		pages += "--" + p.PageLast
+	}
+
+	fields = append(fields,
+		field{"volume", p.Volume},
+		field{"number", p.Issue},
+		field{"pages", pages},
+		field{"series", p.SeriesTitle},
+	)
+
+	if p.Type == "dissertation" {
+		fields = append(fields, field{"school", p.Publisher})
+	} else {
+		fields = append(fields, field{"publisher", p.Publisher})
+	}
+
+	fields = append(fields,
+		field{"address", p.PlaceOfPublication},
+		field{"doi", p.DOI},
+		field{"issn", first(p.ISSN, p.EISSN)},
+		field{"isbn", first(p.ISBN, p.EISBN)},
+		field{"keywords", strings.Join(p.Keyword, ", ")},
+	)
+
+	if len(p.Abstract) > 0 {
+		fields = append(fields, field{"abstract", p.Abstract[0].Text})
+	}
+
+	fields = append(fields, field{"url", p.Handle})
+
+	return fields
+}
+
+func names(contributors []*models.Contributor) string {
+	names := make([]string, 0, len(contributors))
+	for _, c := range contributors {
+		if c.FirstName() != "" {
+			names = append(names, c.LastName()+", "+c.FirstName())
+		} else {
+			names = append(names, c.LastName())
+		}
+	}
+	return strings.Join(names, " and ")
+}
+
+func first(vals ...[]string) string {
+	for _, v := range vals {
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// escape makes sure the value can't break out of its braces by dropping every
+// brace that doesn't belong to a properly nested group. Line breaks are
+// removed because a line starting with % is a comment.
+func escape(v string) string {
+	b := []byte(strings.Join(strings.Fields(v), " "))
+	keep := make([]bool, len(b))
+	var open []int
+	for i, c := range b {
+		switch c {
+		case '{':
+			open = append(open, i)
+		case '}':
+			if len(open) > 0 {
+				keep[open[len(open)-1]] = true
+				keep[i] = true
+				open = open[:len(open)-1]
+			}
+		default:
+			keep[i] = true
+		}
+	}
+	out := make([]byte, 0, len(b))
+	for i, c := range b {
+		if keep[i] {
+			out = append(out, c)
+		}
+	}
+	return string(out)
+}
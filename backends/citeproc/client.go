@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/tidwall/gjson"
+	"github.com/ugent-library/biblio-backoffice/backends/csl"
 	"github.com/ugent-library/biblio-backoffice/models"
 )
 
@@ -36,7 +37,7 @@ func New(baseURL, style string) *Client {
 
 func (c *Client) EncodePublication(p *models.Publication) ([]byte, error) {
 	buf := &bytes.Buffer{}
-	json.NewEncoder(buf).Encode(&RequestBody{Items: []Item{publicationToItem(p)}})
+	json.NewEncoder(buf).Encode(&RequestBody{Items: []csl.Item{csl.PublicationToItem(p)}})
 
 	req, err := http.NewRequest(http.MethodPost, c.url, buf)
 	if err != nil {
@@ -64,58 +65,5 @@ func (c *Client) EncodePublication(p *models.Publication) ([]byte, error) {
 }
 
 type RequestBody struct {
-	Items []Item `json:"items"`
-}
-
-type Item struct {
-	ID             string   `json:"id"`
-	Type           string   `json:"type,omitempty"`
-	Title          string   `json:"title,omitempty"`
-	Author         []Person `json:"author,omitempty"`
-	Edition        string   `json:"edition,omitempty"`
-	Issued         Issued   `json:"issued,omitempty"`
-	Publisher      string   `json:"publisher,omitempty"`
-	PublisherPlace string   `json:"publisher-place,omitempty"`
-	DOI            string   `json:"DOI,omitempty"`
-	ISBN           string   `json:"ISBN,omitempty"`
-}
-
-type Issued struct {
-	Raw string `json:"raw,omitempty"`
-}
-
-type Person struct {
-	Family string `json:"family,omitempty"`
-	Given  string `json:"given,omitempty"`
-}
-
-func publicationToItem(p *models.Publication) Item {
-	item := Item{
-		ID:             p.ID,
-		Title:          p.Title,
-		Edition:        p.Edition,
-		Publisher:      p.Publisher,
-		PublisherPlace: p.PlaceOfPublication,
-		DOI:            p.DOI,
-	}
-
-	switch p.Type {
-	case "book":
-		item.Type = "book"
-	case "journal_article":
-		item.Type = "article-journal"
-	case "chapter", "book_chapter":
-		item.Type = "chapter"
-	case "dissertation":
-		item.Type = "thesis"
-	}
-	item.Issued.Raw = p.Year
-	for _, a := range p.Author {
-		item.Author = append(item.Author, Person{Family: a.LastName(), Given: a.FirstName()})
-	}
-	if len(p.ISBN) > 0 {
-		item.ISBN = p.ISBN[0]
-	}
-
-	return item
+	Items []csl.Item `json:"items"`
 }
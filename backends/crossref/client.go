@@ -62,8 +62,11 @@ func (c *Client) GetPublication(id string) (*models.Publication, error) {
 		return nil, fmt.Errorf("crossref: reading response failed: %w", err)
 	}
 
-	attrs := gjson.ParseBytes(src).Get("message")
+	return MapWork(gjson.ParseBytes(src).Get("message")), nil
+}
 
+// MapWork maps a Crossref work to a publication
+func MapWork(attrs gjson.Result) *models.Publication {
 	p := &models.Publication{
 		Type:              "miscellaneous",
 		PublicationStatus: "published",
@@ -226,5 +229,5 @@ func (c *Client) GetPublication(id string) (*models.Publication, error) {
 		p.PublicationAbbreviation = res.String()
 	}
 
-	return p, nil
+	return p
 }
@@ -0,0 +1,178 @@
+// Package csl maps publications to CSL-JSON, the format used by citeproc
+// processors and reference managers like Zotero.
+package csl
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const ContentType = "application/vnd.citationstyles.csl+json"
+
+type Item struct {
+	ID             string   `json:"id"`
+	Type           string   `json:"type,omitempty"`
+	Title          string   `json:"title,omitempty"`
+	Author         []Person `json:"author,omitempty"`
+	Editor         []Person `json:"editor,omitempty"`
+	ContainerTitle string   `json:"container-title,omitempty"`
+	CollectionName string   `json:"collection-title,omitempty"`
+	Edition        string   `json:"edition,omitempty"`
+	Volume         string   `json:"volume,omitempty"`
+	Issue          string   `json:"issue,omitempty"`
+	Page           string   `json:"page,omitempty"`
+	Issued         Issued   `json:"issued,omitempty"`
+	Publisher      string   `json:"publisher,omitempty"`
+	PublisherPlace string   `json:"publisher-place,omitempty"`
+	Event          string   `json:"event,omitempty"`
+	EventPlace     string   `json:"event-place,omitempty"`
+	Abstract       string   `json:"abstract,omitempty"`
+	Keyword        string   `json:"keyword,omitempty"`
+	DOI            string   `json:"DOI,omitempty"`
+	ISBN           string   `json:"ISBN,omitempty"`
+	ISSN           string   `json:"ISSN,omitempty"`
+	PMID           string   `json:"PMID,omitempty"`
+	URL            string   `json:"URL,omitempty"`
+}
+
+type Issued struct {
+	DateParts [][]int `json:"date-parts,omitempty"`
+	Raw       string  `json:"raw,omitempty"`
+}
+
+type Person struct {
+	Family string `json:"family,omitempty"`
+	Given  string `json:"given,omitempty"`
+}
+
+func PublicationToItem(p *models.Publication) Item {
+	item := Item{
+		ID:             p.ID,
+		Type:           "document",
+		Title:          p.Title,
+		ContainerTitle: p.Publication,
+		CollectionName: p.SeriesTitle,
+		Edition:        p.Edition,
+		Volume:         p.Volume,
+		Issue:          p.Issue,
+		Publisher:      p.Publisher,
+		PublisherPlace: p.PlaceOfPublication,
+		Event:          p.ConferenceName,
+		EventPlace:     p.ConferenceLocation,
+		DOI:            p.DOI,
+		PMID:           p.PubMedID,
+		URL:            p.Handle,
+	}
+
+	switch p.Type {
+	case "book", "book_editor":
+		item.Type = "book"
+	case "journal_article":
+		item.Type = "article-journal"
+	case "chapter", "book_chapter":
+		item.Type = "chapter"
+	case "conference":
+		item.Type = "paper-conference"
+	case "dissertation":
+		item.Type = "thesis"
+	case "miscellaneous":
+		if p.MiscellaneousType == "report" {
+			item.Type = "report"
+		}
+	}
+
+	if year, err := strconv.Atoi(p.Year); err == nil {
+		item.Issued.DateParts = [][]int{{year}}
+	} else {
+		item.Issued.Raw = p.Year
+	}
+
+	item.Page = p.PageFirst
+	if p.PageLast != "" {
+		item.Page += "-" + p.PageLast
+	}
+
+	for _, c := range p.Author {
+		item.Author = append(item.Author, Person{Family: c.LastName(), Given: c.FirstName()})
+	}
+	for _, c := range p.Editor {
+		item.Editor = append(item.Editor, Person{Family: c.LastName(), Given: c.FirstName()})
+	}
+
+	if len(p.Abstract) > 0 {
+		item.Abstract = p.Abstract[0].Text
+	}
+	for i, kw := range p.Keyword {
+		if i > 0 {
+			item.Keyword += ", "
+		}
+		item.Keyword += kw
+	}
+
+	if len(p.ISBN) > 0 {
+		item.ISBN = p.ISBN[0]
+	} else if len(p.EISBN) > 0 {
+		item.ISBN = p.EISBN[0]
+	}
+	if len(p.ISSN) > 0 {
+		item.ISSN = p.ISSN[0]
+	} else if len(p.EISSN) > 0 {
+		item.ISSN = p.EISSN[0]
+	}
+
+	return item
+}
+
+func EncodePublication(p *models.Publication) ([]byte, error) {
+	return json.Marshal(PublicationToItem(p))
+}
+
+// Exporter streams a CSL-JSON array
+type Exporter struct {
+	w   *bufio.Writer
+	n   int
+	err error
+}
+
+func NewExporter(w io.Writer) backends.PublicationListExporter {
+	return &Exporter{w: bufio.NewWriter(w)}
+}
+
+func (x *Exporter) GetContentType() string {
+	return ContentType
+}
+
+// Add writes the item immediately, errors are returned by Flush
+func (x *Exporter) Add(p *models.Publication) {
+	if x.err != nil {
+		return
+	}
+	b, err := EncodePublication(p)
+	if err != nil {
+		x.err = err
+		return
+	}
+	if x.n == 0 {
+		x.w.WriteString("[\n")
+	} else {
+		x.w.WriteString(",\n")
+	}
+	_, x.err = x.w.Write(b)
+	x.n++
+}
+
+func (x *Exporter) Flush() error {
+	if x.err != nil {
+		return x.err
+	}
+	if x.n == 0 {
+		x.w.WriteString("[")
+	}
+	x.w.WriteString("\n]\n")
+	return x.w.Flush()
+}
@@ -0,0 +1,77 @@
+package csv
+
+import (
+	"bufio"
+	encodingcsv "encoding/csv"
+	"io"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	excel_dataset "github.com/ugent-library/biblio-backoffice/backends/excel/dataset"
+	excel_publication "github.com/ugent-library/biblio-backoffice/backends/excel/publication"
+)
+
+const ContentType = "text/csv; charset=utf-8"
+
+// Exporter writes rows as they are added instead of building the whole
+// file in memory. The columns are the same as in the xlsx export.
+type Exporter[T any] struct {
+	bw      *bufio.Writer
+	w       *encodingcsv.Writer
+	headers []string
+	toRow   func(T) []string
+	started bool
+	err     error
+}
+
+func NewPublicationExporter(w io.Writer) backends.PublicationListExporter {
+	return newExporter(w, excel_publication.Headers, excel_publication.PublicationToRow)
+}
+
+func NewDatasetExporter(w io.Writer) backends.DatasetListExporter {
+	return newExporter(w, excel_dataset.Headers, excel_dataset.DatasetToRow)
+}
+
+func newExporter[T any](w io.Writer, headers []string, toRow func(T) []string) *Exporter[T] {
+	bw := bufio.NewWriter(w)
+	return &Exporter[T]{
+		bw:      bw,
+		w:       encodingcsv.NewWriter(bw),
+		headers: headers,
+		toRow:   toRow,
+	}
+}
+
+func (x *Exporter[T]) GetContentType() string {
+	return ContentType
+}
+
+// Add writes the row immediately, errors are returned by Flush
+func (x *Exporter[T]) Add(rec T) {
+	if x.err != nil {
+		return
+	}
+	if !x.started {
+		x.started = true
+		if x.err = x.w.Write(x.headers); x.err != nil {
+			return
+		}
+	}
+	x.err = x.w.Write(x.toRow(rec))
+}
+
+func (x *Exporter[T]) Flush() error {
+	if x.err != nil {
+		return x.err
+	}
+	if !x.started {
+		x.started = true
+		if err := x.w.Write(x.headers); err != nil {
+			return err
+		}
+	}
+	x.w.Flush()
+	if err := x.w.Error(); err != nil {
+		return err
+	}
+	return x.bw.Flush()
+}
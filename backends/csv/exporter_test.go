@@ -0,0 +1,38 @@
+package csv
+
+import (
+	"bytes"
+	encodingcsv "encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	excel_publication "github.com/ugent-library/biblio-backoffice/backends/excel/publication"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestPublicationExporter(t *testing.T) {
+	now := time.Now()
+	b := &bytes.Buffer{}
+	x := NewPublicationExporter(b)
+	x.Add(&models.Publication{ID: "1", Type: "book", Title: "First, with a comma", DateCreated: &now, DateUpdated: &now})
+	x.Add(&models.Publication{ID: "2", Type: "dissertation", Title: "Second\n\"quoted\"", DateCreated: &now, DateUpdated: &now})
+	require.NoError(t, x.Flush())
+
+	rows, err := encodingcsv.NewReader(b).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, excel_publication.Headers, rows[0])
+	require.Equal(t, "1", rows[1][0])
+	require.Equal(t, "First, with a comma", rows[1][10])
+	require.Equal(t, "Second\n\"quoted\"", rows[2][10])
+}
+
+func TestEmptyExport(t *testing.T) {
+	b := &bytes.Buffer{}
+	require.NoError(t, NewDatasetExporter(b).Flush())
+
+	rows, err := encodingcsv.NewReader(b).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+}
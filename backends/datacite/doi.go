@@ -0,0 +1,165 @@
+package datacite
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	APIURL     = "https://api.datacite.org"
+	TestAPIURL = "https://api.test.datacite.org"
+
+	jsonAPIContentType = "application/vnd.api+json"
+)
+
+var ErrNotFound = errors.New("datacite: doi not found")
+
+type DOIConfig struct {
+	// BaseURL defaults to the DataCite REST API
+	BaseURL  string
+	Prefix   string
+	Username string
+	Password string
+}
+
+// DOIClient registers DOIs and their metadata with the DataCite REST API
+type DOIClient struct {
+	config DOIConfig
+	http   *http.Client
+}
+
+// the JSON:API document used by the DataCite REST API, metadata is sent
+// as base64 encoded DataCite XML
+type doiDocument struct {
+	Data doiData `json:"data"`
+}
+
+type doiData struct {
+	ID         string        `json:"id,omitempty"`
+	Type       string        `json:"type"`
+	Attributes doiAttributes `json:"attributes"`
+}
+
+type doiAttributes struct {
+	DOI   string `json:"doi,omitempty"`
+	Event string `json:"event,omitempty"`
+	URL   string `json:"url,omitempty"`
+	XML   []byte `json:"xml,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+func NewDOIClient(c DOIConfig) *DOIClient {
+	if c.BaseURL == "" {
+		c.BaseURL = APIURL
+	}
+	return &DOIClient{
+		config: c,
+		http: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// NewDOI returns the DOI under our prefix for a record id
+func (c *DOIClient) NewDOI(id string) string {
+	return c.config.Prefix + "/" + strings.ToLower(id)
+}
+
+// Manages reports if the DOI was minted under our prefix
+func (c *DOIClient) Manages(doi string) bool {
+	return c.config.Prefix != "" && strings.HasPrefix(strings.ToLower(doi), strings.ToLower(c.config.Prefix)+"/")
+}
+
+// UpsertDOI creates or updates a DOI. Findable DOIs are published, other
+// DOIs are created as draft or hidden if they were findable before.
+func (c *DOIClient) UpsertDOI(doi, target string, metadata []byte, findable bool) error {
+	var event string
+	if findable {
+		event = "publish"
+	} else {
+		state, err := c.getState(doi)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		if state == "findable" {
+			event = "hide"
+		}
+	}
+
+	body, err := json.Marshal(doiDocument{
+		Data: doiData{
+			ID:   doi,
+			Type: "dois",
+			Attributes: doiAttributes{
+				DOI:   doi,
+				Event: event,
+				URL:   target,
+				XML:   metadata,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("datacite.UpsertDOI %s: %w", doi, err)
+	}
+
+	res, err := c.do(http.MethodPut, doi, body)
+	if err != nil {
+		return fmt.Errorf("datacite.UpsertDOI %s: %w", doi, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("datacite.UpsertDOI %s: unexpected status %d: %s", doi, res.StatusCode, msg)
+	}
+
+	return nil
+}
+
+func (c *DOIClient) getState(doi string) (string, error) {
+	res, err := c.do(http.MethodGet, doi, nil)
+	if err != nil {
+		return "", fmt.Errorf("datacite.getState %s: %w", doi, err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", ErrNotFound
+	default:
+		return "", fmt.Errorf("datacite.getState %s: unexpected status %d", doi, res.StatusCode)
+	}
+
+	doc := doiDocument{}
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("datacite.getState %s: %w", doi, err)
+	}
+
+	return doc.Data.Attributes.State, nil
+}
+
+func (c *DOIClient) do(method, doi string, body []byte) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, c.config.BaseURL+"/dois/"+url.PathEscape(doi), r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", jsonAPIContentType)
+	}
+	req.Header.Set("Accept", jsonAPIContentType)
+	req.SetBasicAuth(c.config.Username, c.config.Password)
+
+	return c.http.Do(req)
+}
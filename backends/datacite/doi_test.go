@@ -0,0 +1,149 @@
+package datacite
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDataCite is a minimal in memory stand-in for the DataCite REST API
+type fakeDataCite struct {
+	mu   sync.Mutex
+	dois map[string]doiAttributes
+}
+
+func newFakeDataCite(t *testing.T) (*fakeDataCite, *httptest.Server) {
+	api := &fakeDataCite{dois: make(map[string]doiAttributes)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /dois/{doi}", func(w http.ResponseWriter, r *http.Request) {
+		if !api.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		attrs, ok := api.dois[r.PathValue("doi")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", jsonAPIContentType)
+		json.NewEncoder(w).Encode(doiDocument{Data: doiData{ID: attrs.DOI, Type: "dois", Attributes: attrs}})
+	})
+	mux.HandleFunc("PUT /dois/{doi}", func(w http.ResponseWriter, r *http.Request) {
+		if !api.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		doc := doiDocument{}
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		doi := r.PathValue("doi")
+		attrs, exists := api.dois[doi]
+		if !exists {
+			attrs.State = "draft"
+		}
+		switch doc.Data.Attributes.Event {
+		case "publish":
+			attrs.State = "findable"
+		case "register":
+			attrs.State = "registered"
+		case "hide":
+			if attrs.State != "findable" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			attrs.State = "registered"
+		}
+		attrs.DOI = doi
+		attrs.URL = doc.Data.Attributes.URL
+		attrs.XML = doc.Data.Attributes.XML
+		api.dois[doi] = attrs
+		if exists {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusCreated)
+		}
+		json.NewEncoder(w).Encode(doiDocument{Data: doiData{ID: doi, Type: "dois", Attributes: attrs}})
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return api, srv
+}
+
+func (api *fakeDataCite) authorized(r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	return ok && username == "UGENT.BIBLIO" && password == "secret"
+}
+
+func (api *fakeDataCite) doi(doi string) (doiAttributes, bool) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	attrs, ok := api.dois[doi]
+	return attrs, ok
+}
+
+func newTestDOIClient(srv *httptest.Server) *DOIClient {
+	return NewDOIClient(DOIConfig{
+		BaseURL:  srv.URL,
+		Prefix:   "10.5072",
+		Username: "UGENT.BIBLIO",
+		Password: "secret",
+	})
+}
+
+func TestNewDOI(t *testing.T) {
+	c := NewDOIClient(DOIConfig{Prefix: "10.5072"})
+
+	doi := c.NewDOI("01HXYZ")
+	require.Equal(t, "10.5072/01hxyz", doi)
+	require.True(t, c.Manages(doi))
+	require.True(t, c.Manages("10.5072/OTHER"))
+	require.False(t, c.Manages("10.1234/01hxyz"))
+}
+
+func TestUpsertDOI(t *testing.T) {
+	api, srv := newFakeDataCite(t)
+	c := newTestDOIClient(srv)
+
+	doi := c.NewDOI("01HXYZ")
+
+	// new non public datasets get a draft doi
+	require.NoError(t, c.UpsertDOI(doi, "https://biblio.ugent.be/dataset/01HXYZ", []byte("<resource/>"), false))
+	attrs, ok := api.doi(doi)
+	require.True(t, ok)
+	require.Equal(t, "draft", attrs.State)
+	require.Equal(t, []byte("<resource/>"), attrs.XML)
+
+	require.NoError(t, c.UpsertDOI(doi, "https://biblio.ugent.be/dataset/01HXYZ", []byte("<resource/>"), true))
+	attrs, _ = api.doi(doi)
+	require.Equal(t, "findable", attrs.State)
+
+	// withdrawn datasets are hidden
+	require.NoError(t, c.UpsertDOI(doi, "https://biblio.ugent.be/dataset/01HXYZ", []byte("<resource/>"), false))
+	attrs, _ = api.doi(doi)
+	require.Equal(t, "registered", attrs.State)
+
+	// and hiding them again is a noop
+	require.NoError(t, c.UpsertDOI(doi, "https://biblio.ugent.be/dataset/01HXYZ", []byte("<resource/>"), false))
+	attrs, _ = api.doi(doi)
+	require.Equal(t, "registered", attrs.State)
+}
+
+func TestUpsertDOIUnauthorized(t *testing.T) {
+	_, srv := newFakeDataCite(t)
+	c := NewDOIClient(DOIConfig{BaseURL: srv.URL, Prefix: "10.5072"})
+
+	require.Error(t, c.UpsertDOI(c.NewDOI("01HXYZ"), "https://biblio.ugent.be/dataset/01HXYZ", nil, true))
+}
@@ -0,0 +1,374 @@
+package datacite
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/caltechlibrary/doitools"
+	"github.com/ugent-library/biblio-backoffice/identifiers"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/repositories"
+	"golang.org/x/text/language"
+)
+
+const (
+	Namespace      = "http://datacite.org/schema/kernel-4"
+	SchemaLocation = "http://schema.datacite.org/meta/kernel-4/metadata.xsd"
+)
+
+// ErrNoDOI is returned for datasets without a DOI, kernel 4 requires one
+var ErrNoDOI = errors.New("datacite: dataset has no doi")
+
+var licenseURIs = map[string]string{
+	"CC0-1.0":         "https://creativecommons.org/publicdomain/zero/1.0/legalcode",
+	"CC-BY-4.0":       "https://creativecommons.org/licenses/by/4.0/legalcode",
+	"CC-BY-SA-4.0":    "https://creativecommons.org/licenses/by-sa/4.0/legalcode",
+	"CC-BY-NC-4.0":    "https://creativecommons.org/licenses/by-nc/4.0/legalcode",
+	"CC-BY-ND-4.0":    "https://creativecommons.org/licenses/by-nd/4.0/legalcode",
+	"CC-BY-NC-SA-4.0": "https://creativecommons.org/licenses/by-nc-sa/4.0/legalcode",
+	"CC-BY-NC-ND-4.0": "https://creativecommons.org/licenses/by-nc-nd/4.0/legalcode",
+}
+
+var licenseNames = map[string]string{
+	"CC0-1.0":         "Creative Commons Zero v1.0 Universal",
+	"CC-BY-4.0":       "Creative Commons Attribution 4.0 International",
+	"CC-BY-SA-4.0":    "Creative Commons Attribution Share Alike 4.0 International",
+	"CC-BY-NC-4.0":    "Creative Commons Attribution Non Commercial 4.0 International",
+	"CC-BY-ND-4.0":    "Creative Commons Attribution No Derivatives 4.0 International",
+	"CC-BY-NC-SA-4.0": "Creative Commons Attribution Non Commercial Share Alike 4.0 International",
+	"CC-BY-NC-ND-4.0": "Creative Commons Attribution Non Commercial No Derivatives 4.0 International",
+}
+
+// dataset identifier types that are also DataCite identifier types, others
+// are exported as url
+var identifierTypes = map[string]string{
+	"Handle": "Handle",
+}
+
+type Resource struct {
+	XMLName              xml.Name              `xml:"resource"`
+	Xmlns                string                `xml:"xmlns,attr"`
+	XmlnsXSI             string                `xml:"xmlns:xsi,attr"`
+	SchemaLocation       string                `xml:"xsi:schemaLocation,attr"`
+	Identifier           *Identifier           `xml:"identifier,omitempty"`
+	Creators             []Creator             `xml:"creators>creator"`
+	Titles               []Title               `xml:"titles>title"`
+	Publisher            string                `xml:"publisher"`
+	PublicationYear      string                `xml:"publicationYear"`
+	ResourceType         ResourceType          `xml:"resourceType"`
+	Subjects             []string              `xml:"subjects>subject,omitempty"`
+	Contributors         []Contributor         `xml:"contributors>contributor,omitempty"`
+	Dates                []Date                `xml:"dates>date,omitempty"`
+	Language             string                `xml:"language,omitempty"`
+	AlternateIdentifiers []AlternateIdentifier `xml:"alternateIdentifiers>alternateIdentifier,omitempty"`
+	RelatedIdentifiers   []RelatedIdentifier   `xml:"relatedIdentifiers>relatedIdentifier,omitempty"`
+	Formats              []string              `xml:"formats>format,omitempty"`
+	RightsList           []Rights              `xml:"rightsList>rights,omitempty"`
+	Descriptions         []Description         `xml:"descriptions>description,omitempty"`
+	FundingReferences    []FundingReference    `xml:"fundingReferences>fundingReference,omitempty"`
+}
+
+type Identifier struct {
+	Type  string `xml:"identifierType,attr"`
+	Value string `xml:",chardata"`
+}
+
+type AlternateIdentifier struct {
+	Type  string `xml:"alternateIdentifierType,attr"`
+	Value string `xml:",chardata"`
+}
+
+type RelatedIdentifier struct {
+	Type         string `xml:"relatedIdentifierType,attr"`
+	RelationType string `xml:"relationType,attr"`
+	Value        string `xml:",chardata"`
+}
+
+type NameIdentifier struct {
+	Scheme    string `xml:"nameIdentifierScheme,attr"`
+	SchemeURI string `xml:"schemeURI,attr,omitempty"`
+	Value     string `xml:",chardata"`
+}
+
+type Name struct {
+	Type  string `xml:"nameType,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type Creator struct {
+	Name            Name             `xml:"creatorName"`
+	GivenName       string           `xml:"givenName,omitempty"`
+	FamilyName      string           `xml:"familyName,omitempty"`
+	NameIdentifiers []NameIdentifier `xml:"nameIdentifier,omitempty"`
+	Affiliations    []string         `xml:"affiliation,omitempty"`
+}
+
+type Contributor struct {
+	Type            string           `xml:"contributorType,attr"`
+	Name            Name             `xml:"contributorName"`
+	GivenName       string           `xml:"givenName,omitempty"`
+	FamilyName      string           `xml:"familyName,omitempty"`
+	NameIdentifiers []NameIdentifier `xml:"nameIdentifier,omitempty"`
+	Affiliations    []string         `xml:"affiliation,omitempty"`
+}
+
+type Title struct {
+	Lang  string `xml:"xml:lang,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type ResourceType struct {
+	General string `xml:"resourceTypeGeneral,attr"`
+	Value   string `xml:",chardata"`
+}
+
+type Date struct {
+	Type  string `xml:"dateType,attr"`
+	Value string `xml:",chardata"`
+}
+
+type Rights struct {
+	URI        string `xml:"rightsURI,attr,omitempty"`
+	Identifier string `xml:"rightsIdentifier,attr,omitempty"`
+	Scheme     string `xml:"rightsIdentifierScheme,attr,omitempty"`
+	Value      string `xml:",chardata"`
+}
+
+type Description struct {
+	Lang  string `xml:"xml:lang,attr,omitempty"`
+	Type  string `xml:"descriptionType,attr"`
+	Value string `xml:",chardata"`
+}
+
+type FundingReference struct {
+	FunderName  string `xml:"funderName"`
+	AwardNumber string `xml:"awardNumber,omitempty"`
+	AwardTitle  string `xml:"awardTitle,omitempty"`
+}
+
+type Encoder struct {
+	repo    *repositories.Repo
+	baseURL string
+}
+
+func NewEncoder(repo *repositories.Repo, baseURL string) *Encoder {
+	return &Encoder{
+		repo:    repo,
+		baseURL: baseURL,
+	}
+}
+
+// EncodeDataset encodes a dataset as DataCite XML (metadata kernel 4)
+func (e *Encoder) EncodeDataset(d *models.Dataset) ([]byte, error) {
+	r, err := e.Resource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bytes.Buffer{}
+	enc := xml.NewEncoder(b)
+	enc.Indent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Resource maps a dataset to the DataCite metadata schema. Datasets without a
+// DOI can't be expressed in kernel 4 and return ErrNoDOI.
+func (e *Encoder) Resource(d *models.Dataset) (*Resource, error) {
+	doi := d.Identifiers.Get("DOI")
+	if doi == "" {
+		return nil, ErrNoDOI
+	}
+	if normalizedDOI, err := doitools.NormalizeDOI(doi); err == nil {
+		doi = normalizedDOI
+	}
+
+	r := &Resource{
+		Xmlns:           Namespace,
+		XmlnsXSI:        "http://www.w3.org/2001/XMLSchema-instance",
+		SchemaLocation:  Namespace + " " + SchemaLocation,
+		Publisher:       d.Publisher,
+		PublicationYear: d.Year,
+		ResourceType:    ResourceType{General: "Dataset", Value: "Dataset"},
+		Subjects:        d.Keyword,
+		Formats:         d.Format,
+		Identifier:      &Identifier{Type: "DOI", Value: doi},
+	}
+
+	for _, c := range d.Author {
+		r.Creators = append(r.Creators, mapCreator(c))
+	}
+	for _, c := range d.Contributor {
+		cr := mapCreator(c)
+		r.Contributors = append(r.Contributors, Contributor{
+			Type:            "Other",
+			Name:            cr.Name,
+			GivenName:       cr.GivenName,
+			FamilyName:      cr.FamilyName,
+			NameIdentifiers: cr.NameIdentifiers,
+			Affiliations:    cr.Affiliations,
+		})
+	}
+
+	if d.Title != "" {
+		r.Titles = append(r.Titles, Title{Value: d.Title})
+	}
+
+	if len(d.Language) > 0 {
+		r.Language = languageTag(d.Language[0])
+	}
+
+	if d.DateUpdated != nil {
+		r.Dates = append(r.Dates, Date{Type: "Updated", Value: d.DateUpdated.UTC().Format("2006-01-02")})
+	}
+	if d.AccessLevel == "info:eu-repo/semantics/embargoedAccess" && d.EmbargoDate != "" {
+		r.Dates = append(r.Dates, Date{Type: "Available", Value: d.EmbargoDate})
+	}
+
+	r.AlternateIdentifiers = append(r.AlternateIdentifiers, AlternateIdentifier{
+		Type:  "URL",
+		Value: e.baseURL + "/dataset/" + d.ID,
+	})
+	if d.Handle != "" {
+		r.AlternateIdentifiers = append(r.AlternateIdentifiers, AlternateIdentifier{
+			Type:  "Handle",
+			Value: strings.TrimPrefix(d.Handle, "http://hdl.handle.net/"),
+		})
+	}
+	for _, typ := range slices.Sorted(maps.Keys(d.Identifiers)) {
+		if typ == "DOI" {
+			continue
+		}
+		for _, val := range d.Identifiers[typ] {
+			if t, ok := identifierTypes[typ]; ok {
+				r.AlternateIdentifiers = append(r.AlternateIdentifiers, AlternateIdentifier{Type: t, Value: val})
+			} else if u := identifiers.Resolve(typ, val); u != "" {
+				r.AlternateIdentifiers = append(r.AlternateIdentifiers, AlternateIdentifier{Type: "URL", Value: u})
+			}
+		}
+	}
+
+	if len(d.RelatedPublication) > 0 && e.repo != nil {
+		ids := make([]string, len(d.RelatedPublication))
+		for i, rp := range d.RelatedPublication {
+			ids[i] = rp.ID
+		}
+		relatedPublications, err := e.repo.GetPublications(ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range relatedPublications {
+			if p.Status != "public" {
+				continue
+			}
+			if p.DOI != "" {
+				r.RelatedIdentifiers = append(r.RelatedIdentifiers, RelatedIdentifier{
+					Type:         "DOI",
+					RelationType: "IsSupplementTo",
+					Value:        p.DOI,
+				})
+			} else {
+				r.RelatedIdentifiers = append(r.RelatedIdentifiers, RelatedIdentifier{
+					Type:         "URL",
+					RelationType: "IsSupplementTo",
+					Value:        e.baseURL + "/publication/" + p.ID,
+				})
+			}
+		}
+	}
+	for _, l := range d.Link {
+		if l.URL == "" {
+			continue
+		}
+		relationType := "References"
+		switch l.Relation {
+		case "data_management_plan":
+			relationType = "IsDocumentedBy"
+		case "software":
+			relationType = "IsCompiledBy"
+		}
+		r.RelatedIdentifiers = append(r.RelatedIdentifiers, RelatedIdentifier{
+			Type:         "URL",
+			RelationType: relationType,
+			Value:        l.URL,
+		})
+	}
+
+	if d.AccessLevel != "" {
+		r.RightsList = append(r.RightsList, Rights{URI: d.AccessLevel})
+	}
+	if uri, ok := licenseURIs[d.License]; ok {
+		r.RightsList = append(r.RightsList, Rights{
+			URI:        uri,
+			Identifier: strings.ToLower(d.License),
+			Scheme:     "SPDX",
+			Value:      licenseNames[d.License],
+		})
+	} else if d.OtherLicense != "" {
+		r.RightsList = append(r.RightsList, Rights{Value: d.OtherLicense})
+	}
+
+	for _, a := range d.Abstract {
+		r.Descriptions = append(r.Descriptions, Description{
+			Lang:  languageTag(a.Lang),
+			Type:  "Abstract",
+			Value: a.Text,
+		})
+	}
+
+	for _, rp := range d.RelatedProjects {
+		if rp.Project == nil || rp.Project.EUProject == nil || rp.Project.EUProject.ID == "" {
+			continue
+		}
+		r.FundingReferences = append(r.FundingReferences, FundingReference{
+			FunderName:  "European Commission",
+			AwardNumber: rp.Project.EUProject.ID,
+			AwardTitle:  rp.Project.Title,
+		})
+	}
+
+	return r, nil
+}
+
+// languageTag converts our ISO 639-2 language codes to the BCP 47 tags
+// DataCite expects, e.g. eng to en
+func languageTag(code string) string {
+	tag, err := language.Parse(code)
+	if err != nil || tag == language.Und {
+		return ""
+	}
+	return tag.String()
+}
+
+func mapCreator(c *models.Contributor) Creator {
+	cr := Creator{
+		Name:       Name{Type: "Personal", Value: c.Name()},
+		GivenName:  c.FirstName(),
+		FamilyName: c.LastName(),
+	}
+	if cr.FamilyName != "" && cr.GivenName != "" {
+		cr.Name.Value = cr.FamilyName + ", " + cr.GivenName
+	}
+	if orcid := c.ORCID(); orcid != "" {
+		cr.NameIdentifiers = append(cr.NameIdentifiers, NameIdentifier{
+			Scheme:    "ORCID",
+			SchemeURI: "https://orcid.org",
+			Value:     "https://orcid.org/" + orcid,
+		})
+	}
+	if c.Person != nil {
+		for _, aff := range c.Person.Affiliations {
+			if aff.Organization != nil {
+				cr.Affiliations = append(cr.Affiliations, aff.Organization.Name)
+			}
+		}
+	}
+	return cr
+}
@@ -0,0 +1,76 @@
+package datacite
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestEncodeDataset(t *testing.T) {
+	updated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	d := &models.Dataset{
+		ID:          "01HXYZ",
+		Title:       "Measurements",
+		Publisher:   "Ghent University",
+		Year:        "2023",
+		DateUpdated: &updated,
+		Identifiers: models.Values{"DOI": {"https://doi.org/10.5072/ABC"}, "ENA": {"PRJEB1234"}},
+		Keyword:     []string{"soil"},
+		Language:    []string{"dut"},
+		Author: []*models.Contributor{
+			models.ContributorFromFirstLastName("Jane", "Doe"),
+		},
+		AccessLevel: "info:eu-repo/semantics/openAccess",
+		License:     "CC-BY-4.0",
+		Abstract:    []*models.Text{{Text: "An abstract", Lang: "eng"}},
+	}
+
+	b, err := NewEncoder(nil, "https://biblio.ugent.be").EncodeDataset(d)
+	require.NoError(t, err)
+
+	r := struct {
+		Identifier struct {
+			Type  string `xml:"identifierType,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"identifier"`
+		CreatorNames         []string `xml:"creators>creator>creatorName"`
+		Titles               []string `xml:"titles>title"`
+		PublicationYear      string   `xml:"publicationYear"`
+		ResourceType         string   `xml:"resourceType"`
+		AlternateIdentifiers []string `xml:"alternateIdentifiers>alternateIdentifier"`
+		Rights               []Rights `xml:"rightsList>rights"`
+		Dates                []Date   `xml:"dates>date"`
+		Language             string   `xml:"language"`
+		DescriptionLangs     []struct {
+			Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		} `xml:"descriptions>description"`
+	}{}
+	require.NoError(t, xml.Unmarshal(b, &r))
+
+	require.Equal(t, "DOI", r.Identifier.Type)
+	require.Equal(t, "10.5072/ABC", r.Identifier.Value)
+	require.Equal(t, []string{"Doe, Jane"}, r.CreatorNames)
+	require.Equal(t, []string{"Measurements"}, r.Titles)
+	require.Equal(t, "2023", r.PublicationYear)
+	require.Equal(t, "Dataset", r.ResourceType)
+	require.Contains(t, r.AlternateIdentifiers, "https://biblio.ugent.be/dataset/01HXYZ")
+	require.Len(t, r.Rights, 2)
+	require.Equal(t, "cc-by-4.0", r.Rights[1].Identifier)
+	require.Equal(t, []Date{{Type: "Updated", Value: "2024-03-01"}}, r.Dates)
+	require.Equal(t, "nl", r.Language)
+	require.Equal(t, "en", r.DescriptionLangs[0].Lang)
+}
+
+func TestEncodeDatasetWithoutDOI(t *testing.T) {
+	d := &models.Dataset{
+		ID:          "01HXYZ",
+		Title:       "Measurements",
+		Identifiers: models.Values{"Handle": {"1854/LU-01HXYZ"}},
+	}
+
+	_, err := NewEncoder(nil, "https://biblio.ugent.be").EncodeDataset(d)
+	require.ErrorIs(t, err, ErrNoDOI)
+}
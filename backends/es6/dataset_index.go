@@ -262,7 +262,7 @@ func (di *DatasetIndex) getScopedFacetValues(fields ...string) (map[string][]str
 	return m, nil
 }
 
-func (di *DatasetIndex) Each(searchArgs *models.SearchArgs, maxSize int, cb func(string)) error {
+func (di *DatasetIndex) Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(string)) error {
 	nProcessed := 0
 	start := 0
 	limit := 200
@@ -300,7 +300,7 @@ func (di *DatasetIndex) Each(searchArgs *models.SearchArgs, maxSize int, cb func
 		}
 
 		opts := []func(*esapi.SearchRequest){
-			di.client.Search.WithContext(context.Background()),
+			di.client.Search.WithContext(ctx),
 			di.client.Search.WithIndex(di.index),
 			di.client.Search.WithTrackTotalHits(true),
 			di.client.Search.WithSort("id:asc"),
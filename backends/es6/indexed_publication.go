@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/identifiers"
 	"github.com/ugent-library/biblio-backoffice/models"
 	internal_time "github.com/ugent-library/biblio-backoffice/time"
 	"github.com/ugent-library/biblio-backoffice/vocabularies"
@@ -154,6 +155,10 @@ func NewIndexedPublication(p *models.Publication) *indexedPublication {
 	}
 	if p.ArxivID != "" {
 		ip.Identifier = append(ip.Identifier, p.ArxivID)
+		// all versions of a preprint share the id without prefix and version
+		if id, err := identifiers.ArXiv.Normalize(p.ArxivID); err == nil && id != "" && id != p.ArxivID {
+			ip.Identifier = append(ip.Identifier, id)
+		}
 	}
 	if p.PubMedID != "" {
 		ip.Identifier = append(ip.Identifier, p.PubMedID)
@@ -190,7 +190,7 @@ func (pi *PublicationIndex) Search(args *models.SearchArgs) (*models.SearchHits,
 	return hits, nil
 }
 
-func (pi *PublicationIndex) Each(searchArgs *models.SearchArgs, maxSize int, cb func(string)) error {
+func (pi *PublicationIndex) Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(string)) error {
 	nProcessed := 0
 	start := 0
 	limit := 200
@@ -228,7 +228,7 @@ func (pi *PublicationIndex) Each(searchArgs *models.SearchArgs, maxSize int, cb
 		}
 
 		opts := []func(*esapi.SearchRequest){
-			pi.client.Search.WithContext(context.Background()),
+			pi.client.Search.WithContext(ctx),
 			pi.client.Search.WithIndex(pi.index),
 			pi.client.Search.WithTrackTotalHits(true),
 			pi.client.Search.WithSort("id:asc"),
@@ -13,7 +13,7 @@ import (
 
 const sep = " ; "
 
-var headers = []string{
+var Headers = []string{
 	"id",
 	"status",
 	"creator",
@@ -48,17 +48,18 @@ type xlsx struct {
 
 func NewExporter(writer io.Writer) backends.DatasetListExporter {
 	baseExporter := excel.NewBaseExporter(writer)
-	baseExporter.Headers = headers
+	baseExporter.Headers = Headers
 	return &xlsx{
 		BaseExporter: *baseExporter,
 	}
 }
 
 func (x *xlsx) Add(dataset *models.Dataset) {
-	x.BaseExporter.Add(x.datasetToRow(dataset))
+	x.BaseExporter.Add(DatasetToRow(dataset))
 }
 
-func (x *xlsx) datasetToRow(d *models.Dataset) []string {
+// DatasetToRow maps a dataset to a row of values in the order of Headers
+func DatasetToRow(d *models.Dataset) []string {
 	//see also: biblio/lib/Catmandu/Fix/publication_to_csv.pm
 
 	m := map[string]string{}
@@ -140,8 +141,8 @@ func (x *xlsx) datasetToRow(d *models.Dataset) []string {
 	m["year"] = d.Year
 
 	//hash to ordered list
-	row := make([]string, 0, len(headers))
-	for _, h := range headers {
+	row := make([]string, 0, len(Headers))
+	for _, h := range Headers {
 		row = append(row, m[h])
 	}
 
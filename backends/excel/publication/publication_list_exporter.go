@@ -13,7 +13,7 @@ import (
 
 const sep = " ; "
 
-var headers = []string{
+var Headers = []string{
 	"id",
 	"type",
 	"status",
@@ -108,17 +108,18 @@ type xlsx struct {
 
 func NewExporter(writer io.Writer) backends.PublicationListExporter {
 	baseExporter := excel.NewBaseExporter(writer)
-	baseExporter.Headers = headers
+	baseExporter.Headers = Headers
 	return &xlsx{
 		BaseExporter: *baseExporter,
 	}
 }
 
 func (x *xlsx) Add(pub *models.Publication) {
-	x.BaseExporter.Add(x.publicationToRow(pub))
+	x.BaseExporter.Add(PublicationToRow(pub))
 }
 
-func (x *xlsx) publicationToRow(pub *models.Publication) []string {
+// PublicationToRow maps a publication to a row of values in the order of Headers
+func PublicationToRow(pub *models.Publication) []string {
 	//see also: biblio/lib/Catmandu/Fix/publication_to_csv.pm
 	//see also: librecat/ugent/config/route.yml
 	//see also: librecat/ugent/fixes/to_reviewer_xlsx.fix
@@ -269,8 +270,8 @@ func (x *xlsx) publicationToRow(pub *models.Publication) []string {
 	m["locked"] = fmt.Sprintf("%t", pub.Locked)
 
 	//hash to ordered list
-	row := make([]string, 0, len(headers))
-	for _, h := range headers {
+	row := make([]string, 0, len(Headers))
+	for _, h := range Headers {
 		row = append(row, m[h])
 	}
 
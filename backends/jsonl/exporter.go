@@ -0,0 +1,55 @@
+package jsonl
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const ContentType = "application/x-ndjson"
+
+// Exporter writes one JSON record per line as they are added. The output
+// can be read back with the jsonl decoder.
+type Exporter[T any] struct {
+	bw  *bufio.Writer
+	enc *json.Encoder
+	err error
+}
+
+func NewPublicationExporter(w io.Writer) backends.PublicationListExporter {
+	return newExporter[*models.Publication](w)
+}
+
+func NewDatasetExporter(w io.Writer) backends.DatasetListExporter {
+	return newExporter[*models.Dataset](w)
+}
+
+func newExporter[T any](w io.Writer) *Exporter[T] {
+	bw := bufio.NewWriter(w)
+	return &Exporter[T]{
+		bw:  bw,
+		enc: json.NewEncoder(bw),
+	}
+}
+
+func (x *Exporter[T]) GetContentType() string {
+	return ContentType
+}
+
+// Add writes the record immediately, errors are returned by Flush
+func (x *Exporter[T]) Add(rec T) {
+	if x.err != nil {
+		return
+	}
+	x.err = x.enc.Encode(rec)
+}
+
+func (x *Exporter[T]) Flush() error {
+	if x.err != nil {
+		return x.err
+	}
+	return x.bw.Flush()
+}
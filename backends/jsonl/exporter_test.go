@@ -0,0 +1,26 @@
+package jsonl
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestPublicationExporter(t *testing.T) {
+	b := &bytes.Buffer{}
+	x := NewPublicationExporter(b)
+	x.Add(&models.Publication{ID: "1", Type: "book", Title: "First"})
+	x.Add(&models.Publication{ID: "2", Type: "dissertation", Title: "Second"})
+	require.NoError(t, x.Flush())
+
+	dec := NewDecoder(b)
+	p1, p2 := &models.Publication{}, &models.Publication{}
+	require.NoError(t, dec.Decode(p1))
+	require.NoError(t, dec.Decode(p2))
+	require.ErrorIs(t, dec.Decode(&models.Publication{}), io.EOF)
+	require.Equal(t, "1", p1.ID)
+	require.Equal(t, "Second", p2.Title)
+}
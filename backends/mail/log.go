@@ -0,0 +1,23 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+)
+
+// LogSender logs mail instead of delivering it. It is used when no SMTP
+// relay is configured.
+type LogSender struct {
+	logger *slog.Logger
+}
+
+func NewLogSender(logger *slog.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+func (s *LogSender) SendMail(ctx context.Context, msg *backends.MailMessage) error {
+	s.logger.Info("mail not sent, no smtp relay configured", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
@@ -0,0 +1,66 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+)
+
+type SMTPConfig struct {
+	Addr     string // host:port
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPSender delivers mail through an SMTP relay
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+func NewSMTPSender(c SMTPConfig) *SMTPSender {
+	return &SMTPSender{config: c}
+}
+
+func (s *SMTPSender) SendMail(ctx context.Context, msg *backends.MailMessage) error {
+	if len(msg.To) == 0 {
+		return errors.New("mail.SendMail: no recipients")
+	}
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		host, _, err := net.SplitHostPort(s.config.Addr)
+		if err != nil {
+			return fmt.Errorf("mail.SendMail: %w", err)
+		}
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, host)
+	}
+
+	if err := smtp.SendMail(s.config.Addr, auth, s.config.From, msg.To, s.format(msg)); err != nil {
+		return fmt.Errorf("mail.SendMail: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SMTPSender) format(msg *backends.MailMessage) []byte {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
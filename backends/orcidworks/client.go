@@ -0,0 +1,258 @@
+package orcidworks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/orcid"
+)
+
+var (
+	ErrNotFound  = errors.New("orcid work not found")
+	ErrDuplicate = errors.New("orcid work already exists")
+
+	rePutCode = regexp.MustCompile(`([^/]+)$`)
+)
+
+type Config struct {
+	// BaseURL defaults to the (sandbox) ORCID member API
+	BaseURL     string
+	Sandbox     bool
+	FrontendURL string
+	// HTTPClient defaults to a client with a 30 second timeout
+	HTTPClient *http.Client
+}
+
+// Client pushes works to ORCID records on behalf of the record owner. The
+// member client in the orcid package only knows how to add works and
+// authenticates with client credentials, while writing to a researcher's
+// record needs the researcher's own access token.
+type Client struct {
+	config Config
+	http   *http.Client
+}
+
+func NewClient(c Config) *Client {
+	if c.BaseURL == "" {
+		if c.Sandbox {
+			c.BaseURL = orcid.SandboxMemberUrl
+		} else {
+			c.BaseURL = orcid.MemberUrl
+		}
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{
+		config: c,
+		http:   httpClient,
+	}
+}
+
+func (c *Client) AddWork(orcidID, token string, work *orcid.Work) (int, error) {
+	res, err := c.do("POST", fmt.Sprintf("/%s/work", orcidID), token, work)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusCreated:
+	case http.StatusConflict:
+		return 0, ErrDuplicate
+	default:
+		return 0, fmt.Errorf("orcidworks.AddWork %s: unexpected status %d", orcidID, res.StatusCode)
+	}
+
+	loc, err := res.Location()
+	if err != nil {
+		return 0, fmt.Errorf("orcidworks.AddWork %s: %w", orcidID, err)
+	}
+	putCode, err := strconv.Atoi(rePutCode.FindString(loc.Path))
+	if err != nil {
+		return 0, fmt.Errorf("orcidworks.AddWork %s: invalid put code in %s", orcidID, loc)
+	}
+
+	return putCode, nil
+}
+
+func (c *Client) UpdateWork(orcidID, token string, work *orcid.Work) error {
+	res, err := c.do("PUT", fmt.Sprintf("/%s/work/%d", orcidID, work.PutCode), token, work)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrDuplicate
+	default:
+		return fmt.Errorf("orcidworks.UpdateWork %s/%d: unexpected status %d", orcidID, work.PutCode, res.StatusCode)
+	}
+}
+
+func (c *Client) DeleteWork(orcidID, token string, putCode int) error {
+	res, err := c.do("DELETE", fmt.Sprintf("/%s/work/%d", orcidID, putCode), token, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ErrNotFound
+	default:
+		return fmt.Errorf("orcidworks.DeleteWork %s/%d: unexpected status %d", orcidID, putCode, res.StatusCode)
+	}
+}
+
+// SyncPublication brings the ORCID records of all contributors with an ORCID
+// token in line with the publication: works are added or updated for public
+// publications and deleted otherwise. The put codes are kept in
+// p.ORCIDWork, the returned bool indicates if they changed. If orcidIDs are
+// given, only those ORCID records are touched.
+func (c *Client) SyncPublication(p *models.Publication, orcidIDs ...string) (bool, error) {
+	var (
+		changed bool
+		errs    []error
+	)
+
+	selected := func(orcidID string) bool {
+		return len(orcidIDs) == 0 || slices.Contains(orcidIDs, orcidID)
+	}
+
+	tokens, orcidIDsInOrder := contributorTokens(p)
+
+	var work *orcid.Work
+	if p.Status == "public" {
+		work = PublicationToWork(p, c.config.FrontendURL)
+	}
+
+	// update or remove known works
+	var orcidWorks []models.PublicationORCIDWork
+	for _, w := range p.ORCIDWork {
+		token, isContributor := tokens[w.ORCID]
+
+		if !selected(w.ORCID) || (isContributor && token == "") {
+			orcidWorks = append(orcidWorks, w)
+			continue
+		}
+
+		if work == nil || !isContributor {
+			// the token of a removed contributor is not available anymore,
+			// the work stays on the record until the researcher removes it
+			if isContributor {
+				if err := c.DeleteWork(w.ORCID, token, w.PutCode); err != nil && !errors.Is(err, ErrNotFound) {
+					errs = append(errs, err)
+					orcidWorks = append(orcidWorks, w)
+					continue
+				}
+			}
+			changed = true
+			continue
+		}
+
+		work.PutCode = w.PutCode
+		err := c.UpdateWork(w.ORCID, token, work)
+		if errors.Is(err, ErrNotFound) {
+			// work was removed from the ORCID record, add it again below
+			changed = true
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+		orcidWorks = append(orcidWorks, w)
+	}
+
+	// add missing works
+	if work != nil {
+		for _, orcidID := range orcidIDsInOrder {
+			token := tokens[orcidID]
+			if token == "" || !selected(orcidID) {
+				continue
+			}
+			if slices.ContainsFunc(orcidWorks, func(w models.PublicationORCIDWork) bool { return w.ORCID == orcidID }) {
+				continue
+			}
+			work.PutCode = 0
+			putCode, err := c.AddWork(orcidID, token, work)
+			if errors.Is(err, ErrDuplicate) {
+				continue
+			}
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			orcidWorks = append(orcidWorks, models.PublicationORCIDWork{
+				ORCID:   orcidID,
+				PutCode: putCode,
+			})
+			changed = true
+		}
+	}
+
+	if changed {
+		p.ORCIDWork = orcidWorks
+	}
+
+	return changed, errors.Join(errs...)
+}
+
+func (c *Client) do(method, path, token string, body any) (*http.Response, error) {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, c.config.BaseURL+path, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", orcid.ContentType)
+	}
+	req.Header.Set("Accept", orcid.ContentType)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return c.http.Do(req)
+}
+
+// contributorTokens maps the ORCID iD of every linked author and editor to
+// their ORCID access token (which can be empty), the ORCID iDs are also
+// returned in contributor order
+func contributorTokens(p *models.Publication) (map[string]string, []string) {
+	tokens := make(map[string]string)
+	var orcidIDs []string
+	for _, role := range []string{"author", "editor"} {
+		for _, c := range p.Contributors(role) {
+			if c.Person == nil || c.Person.ORCID == "" {
+				continue
+			}
+			if _, ok := tokens[c.Person.ORCID]; !ok {
+				orcidIDs = append(orcidIDs, c.Person.ORCID)
+			}
+			if tokens[c.Person.ORCID] == "" {
+				tokens[c.Person.ORCID] = c.Person.ORCIDToken
+			}
+		}
+	}
+	return tokens, orcidIDs
+}
@@ -0,0 +1,223 @@
+package orcidworks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/orcid"
+)
+
+const (
+	testORCID = "0000-0002-1825-0097"
+	testToken = "secret-token"
+)
+
+// fakeMemberAPI is a minimal in memory stand-in for the ORCID member API
+type fakeMemberAPI struct {
+	mu          sync.Mutex
+	nextPutCode int
+	works       map[string]map[int]*orcid.Work
+}
+
+func newFakeMemberAPI(t *testing.T) (*fakeMemberAPI, *httptest.Server) {
+	api := &fakeMemberAPI{
+		nextPutCode: 1000,
+		works:       make(map[string]map[int]*orcid.Work),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /{orcid}/work", func(w http.ResponseWriter, r *http.Request) {
+		if !api.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		work := &orcid.Work{}
+		if err := json.NewDecoder(r.Body).Decode(work); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		orcidID := r.PathValue("orcid")
+		if api.works[orcidID] == nil {
+			api.works[orcidID] = make(map[int]*orcid.Work)
+		}
+		api.nextPutCode++
+		work.PutCode = api.nextPutCode
+		api.works[orcidID][work.PutCode] = work
+		w.Header().Set("Location", fmt.Sprintf("http://%s/%s/work/%d", r.Host, orcidID, work.PutCode))
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("PUT /{orcid}/work/{put_code}", func(w http.ResponseWriter, r *http.Request) {
+		if !api.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		work := &orcid.Work{}
+		if err := json.NewDecoder(r.Body).Decode(work); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		orcidID := r.PathValue("orcid")
+		putCode, _ := strconv.Atoi(r.PathValue("put_code"))
+		if _, ok := api.works[orcidID][putCode]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		api.works[orcidID][putCode] = work
+		json.NewEncoder(w).Encode(work)
+	})
+	mux.HandleFunc("DELETE /{orcid}/work/{put_code}", func(w http.ResponseWriter, r *http.Request) {
+		if !api.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		orcidID := r.PathValue("orcid")
+		putCode, _ := strconv.Atoi(r.PathValue("put_code"))
+		if _, ok := api.works[orcidID][putCode]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(api.works[orcidID], putCode)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return api, srv
+}
+
+func (api *fakeMemberAPI) authorized(r *http.Request) bool {
+	return r.Header.Get("Authorization") == "Bearer "+testToken
+}
+
+func (api *fakeMemberAPI) work(orcidID string, putCode int) *orcid.Work {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.works[orcidID][putCode]
+}
+
+func newTestPublication() *models.Publication {
+	return &models.Publication{
+		ID:     "01HXYZ",
+		Type:   "journal_article",
+		Status: "public",
+		Title:  "A test publication",
+		Year:   "2024",
+		DOI:    "10.1234/test",
+		Author: []*models.Contributor{
+			{
+				PersonID: "1234",
+				Person: &models.Person{
+					ID:         "1234",
+					FullName:   "Jane Doe",
+					ORCID:      testORCID,
+					ORCIDToken: testToken,
+				},
+			},
+			models.ContributorFromFirstLastName("John", "Smith"),
+		},
+	}
+}
+
+func TestSyncPublicationAddsWork(t *testing.T) {
+	api, srv := newFakeMemberAPI(t)
+	c := NewClient(Config{BaseURL: srv.URL, FrontendURL: "https://biblio.example.com"})
+
+	p := newTestPublication()
+	changed, err := c.SyncPublication(p)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Len(t, p.ORCIDWork, 1)
+	require.Equal(t, testORCID, p.ORCIDWork[0].ORCID)
+
+	work := api.work(testORCID, p.ORCIDWork[0].PutCode)
+	require.NotNil(t, work)
+	require.Equal(t, "A test publication", work.Title.Title.Value)
+	require.Equal(t, "journal-article", work.Type)
+	require.Len(t, work.Contributors.Contributor, 2)
+}
+
+func TestSyncPublicationUpdatesWork(t *testing.T) {
+	api, srv := newFakeMemberAPI(t)
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	p := newTestPublication()
+	_, err := c.SyncPublication(p)
+	require.NoError(t, err)
+	putCode := p.ORCIDWork[0].PutCode
+
+	p.Title = "An updated title"
+	changed, err := c.SyncPublication(p)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, putCode, p.ORCIDWork[0].PutCode)
+	require.Equal(t, "An updated title", api.work(testORCID, putCode).Title.Title.Value)
+}
+
+func TestSyncPublicationDeletesWithdrawnWork(t *testing.T) {
+	api, srv := newFakeMemberAPI(t)
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	p := newTestPublication()
+	_, err := c.SyncPublication(p)
+	require.NoError(t, err)
+	putCode := p.ORCIDWork[0].PutCode
+
+	p.Status = "returned"
+	changed, err := c.SyncPublication(p)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Empty(t, p.ORCIDWork)
+	require.Nil(t, api.work(testORCID, putCode))
+}
+
+func TestSyncPublicationReaddsRemovedWork(t *testing.T) {
+	api, srv := newFakeMemberAPI(t)
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	p := newTestPublication()
+	p.ORCIDWork = []models.PublicationORCIDWork{{ORCID: testORCID, PutCode: 1}}
+
+	changed, err := c.SyncPublication(p)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Len(t, p.ORCIDWork, 1)
+	require.NotEqual(t, 1, p.ORCIDWork[0].PutCode)
+	require.NotNil(t, api.work(testORCID, p.ORCIDWork[0].PutCode))
+}
+
+func TestSyncPublicationSkipsUnselectedORCIDs(t *testing.T) {
+	_, srv := newFakeMemberAPI(t)
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	p := newTestPublication()
+	changed, err := c.SyncPublication(p, "0000-0001-5109-3700")
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Empty(t, p.ORCIDWork)
+}
+
+func TestSyncPublicationReportsErrors(t *testing.T) {
+	_, srv := newFakeMemberAPI(t)
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	p := newTestPublication()
+	p.Author[0].Person.ORCIDToken = "wrong-token"
+	changed, err := c.SyncPublication(p)
+	require.Error(t, err)
+	require.False(t, changed)
+	require.Empty(t, p.ORCIDWork)
+}
@@ -0,0 +1,171 @@
+package orcidworks
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/identifiers"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/orcid"
+)
+
+// ORCID limits the length of the short description
+const maxShortDescriptionLen = 5000
+
+var workTypes = map[string]string{
+	"book":            "book",
+	"book_chapter":    "book-chapter",
+	"book_editor":     "edited-book",
+	"conference":      "conference-paper",
+	"dissertation":    "dissertation",
+	"issue_editor":    "other",
+	"journal_article": "journal-article",
+	"miscellaneous":   "other",
+}
+
+var miscellaneousWorkTypes = map[string]string{
+	"bookReview":        "book-review",
+	"dictionaryEntry":   "dictionary-entry",
+	"encyclopediaEntry": "encyclopedia-entry",
+	"lectureSpeech":     "lecture-speech",
+	"magazinePiece":     "magazine-article",
+	"newsArticle":       "newspaper-article",
+	"newspaperPiece":    "newspaper-article",
+	"report":            "report",
+	"technicalStandard": "standards-and-policy",
+	"workingPaper":      "working-paper",
+}
+
+func PublicationToWork(p *models.Publication, frontendURL string) *orcid.Work {
+	url := frontendURL + "/publication/" + p.ID
+
+	w := &orcid.Work{
+		URL:  orcid.String(url),
+		Type: "other",
+		ExternalIDs: &orcid.ExternalIDs{
+			ExternalID: []orcid.ExternalID{
+				{
+					Type:         "handle",
+					Relationship: "self",
+					Value:        strings.TrimPrefix(p.Handle, "http://hdl.handle.net/"),
+					Url:          orcid.String(p.Handle),
+				},
+				{
+					Type:         "source-work-id",
+					Relationship: "self",
+					Value:        p.ID,
+					Url:          orcid.String(url),
+				},
+			},
+		},
+	}
+
+	if p.Handle == "" {
+		w.ExternalIDs.ExternalID = w.ExternalIDs.ExternalID[1:]
+	}
+
+	if p.Title != "" {
+		w.Title = &orcid.Title{Title: orcid.String(p.Title)}
+	}
+
+	if t, ok := workTypes[p.Type]; ok {
+		w.Type = t
+	}
+	if t, ok := miscellaneousWorkTypes[p.MiscellaneousType]; ok && p.Type == "miscellaneous" {
+		w.Type = t
+	}
+
+	if p.Year != "" {
+		w.PublicationDate = &orcid.PublicationDate{Year: orcid.String(p.Year)}
+	}
+
+	if p.Publication != "" && p.UsesPublication() {
+		w.JournalTitle = orcid.String(p.Publication)
+	}
+
+	if len(p.Abstract) > 0 {
+		desc := p.Abstract[0].Text
+		if r := []rune(desc); len(r) > maxShortDescriptionLen {
+			desc = string(r[:maxShortDescriptionLen])
+		}
+		w.ShortDescription = desc
+	}
+
+	if p.DOI != "" {
+		w.ExternalIDs.ExternalID = append(w.ExternalIDs.ExternalID, orcid.ExternalID{
+			Type:         "doi",
+			Relationship: "self",
+			Value:        p.DOI,
+			Url:          orcid.String(identifiers.DOI.Resolve(p.DOI)),
+		})
+	}
+	if p.PubMedID != "" {
+		w.ExternalIDs.ExternalID = append(w.ExternalIDs.ExternalID, orcid.ExternalID{
+			Type:         "pmid",
+			Relationship: "self",
+			Value:        p.PubMedID,
+			Url:          orcid.String(identifiers.PubMed.Resolve(p.PubMedID)),
+		})
+	}
+	if p.ArxivID != "" {
+		w.ExternalIDs.ExternalID = append(w.ExternalIDs.ExternalID, orcid.ExternalID{
+			Type:         "arxiv",
+			Relationship: "self",
+			Value:        p.ArxivID,
+		})
+	}
+	if p.WOSID != "" {
+		w.ExternalIDs.ExternalID = append(w.ExternalIDs.ExternalID, orcid.ExternalID{
+			Type:         "wosuid",
+			Relationship: "self",
+			Value:        p.WOSID,
+		})
+	}
+	for _, isbn := range slices.Concat(p.ISBN, p.EISBN) {
+		rel := "self"
+		if p.Type == "book_chapter" {
+			rel = "part-of"
+		}
+		w.ExternalIDs.ExternalID = append(w.ExternalIDs.ExternalID, orcid.ExternalID{
+			Type:         "isbn",
+			Relationship: rel,
+			Value:        isbn,
+		})
+	}
+	for _, issn := range slices.Concat(p.ISSN, p.EISSN) {
+		w.ExternalIDs.ExternalID = append(w.ExternalIDs.ExternalID, orcid.ExternalID{
+			Type:         "issn",
+			Relationship: "part-of",
+			Value:        issn,
+		})
+	}
+
+	var contributors []orcid.Contributor
+	for _, role := range []string{"author", "editor"} {
+		for i, c := range p.Contributors(role) {
+			oc := orcid.Contributor{
+				CreditName: orcid.String(c.Name()),
+				Attributes: &orcid.ContributorAttributes{
+					Role:     role,
+					Sequence: "additional",
+				},
+			}
+			if i == 0 {
+				oc.Attributes.Sequence = "first"
+			}
+			if orcidID := c.ORCID(); orcidID != "" {
+				oc.ORCID = &orcid.URI{
+					Path: orcidID,
+					Host: "orcid.org",
+					URI:  "https://orcid.org/" + orcidID,
+				}
+			}
+			contributors = append(contributors, oc)
+		}
+	}
+	if len(contributors) > 0 {
+		w.Contributors = &orcid.Contributors{Contributor: contributors}
+	}
+
+	return w
+}
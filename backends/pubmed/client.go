@@ -55,17 +55,20 @@ func (c *Client) GetPublication(id string) (*models.Publication, error) {
 		return nil, fmt.Errorf("pubmed: reading response failed: %w", err)
 	}
 
-	p := &models.Publication{
-		Type: "journal_article",
-	}
-
 	attrs := gjson.ParseBytes(src)
 
 	if n := attrs.Get("hitCount").Int(); n != 1 {
 		return nil, fmt.Errorf("pubmed: expected 1 result, but found %d", n)
 	}
 
-	attrs = attrs.Get("resultList.result.0")
+	return MapResult(attrs.Get("resultList.result.0")), nil
+}
+
+// MapResult maps a core search result to a publication
+func MapResult(attrs gjson.Result) *models.Publication {
+	p := &models.Publication{
+		Type: "journal_article",
+	}
 
 	if res := attrs.Get("pmid"); res.Exists() {
 		p.PubMedID = res.String()
@@ -132,5 +135,5 @@ func (c *Client) GetPublication(id string) (*models.Publication, error) {
 		p.Language = append(p.Language, res.String())
 	}
 
-	return p, nil
+	return p
 }
@@ -72,13 +72,28 @@ var (
 	}
 )
 
+// risTags maps RIS tags that mean something else in the WoS tagged format to
+// the WoS tag with the same meaning
+var risTags = map[string]string{
+	"SP": "BP",
+	"DO": "DI",
+}
+
 type Record map[string][]string
 
 type Decoder struct {
 	scanner *bufio.Scanner
+	tags    map[string]string
 }
 
+// NewDecoder returns a decoder for RIS records
 func NewDecoder(r io.Reader) backends.PublicationDecoder {
+	return &Decoder{scanner: bufio.NewScanner(r), tags: risTags}
+}
+
+// NewWOSDecoder returns a decoder for records in the Web of Science tagged
+// format
+func NewWOSDecoder(r io.Reader) backends.PublicationDecoder {
 	return &Decoder{scanner: bufio.NewScanner(r)}
 }
 
@@ -99,6 +114,9 @@ func (d *Decoder) Decode(p *models.Publication) error {
 
 		if match := reTag.FindStringSubmatch(line); match != nil {
 			tag = match[1]
+			if t, ok := d.tags[tag]; ok {
+				tag = t
+			}
 			rec[tag] = append(rec[tag], match[2])
 		} else {
 			rec[tag] = append(rec[tag], strings.TrimPrefix(line, "  "))
@@ -129,7 +147,7 @@ func mapRecord(r Record, p *models.Publication) {
 			switch {
 			case slices.Contains(types, "article") && slices.Contains(types, "proceedings paper"):
 				p.JournalArticleType = "proceedingsPaper"
-			case firstType == "journal article" || firstType == "article" || firstType == "journal paper":
+			case firstType == "journal article" || firstType == "article" || firstType == "journal paper" || firstType == "jour":
 				p.JournalArticleType = "original"
 			case firstType == "review":
 				p.JournalArticleType = "review"
@@ -137,12 +155,19 @@ func mapRecord(r Record, p *models.Publication) {
 				p.JournalArticleType = "letterNote"
 			case firstType == "book":
 				p.Type = "book"
-			case firstType == "book chapter":
+			case firstType == "edbook":
+				p.Type = "book_editor"
+			case firstType == "book chapter" || firstType == "chap":
 				p.Type = "book_chapter"
+			case firstType == "thes":
+				p.Type = "dissertation"
+			case firstType == "rprt":
+				p.Type = "miscellaneous"
+				p.MiscellaneousType = "report"
 			case firstType == "meeting abstract":
 				p.Type = "conference"
 				p.ConferenceType = "abstract"
-			case firstType == "conference proceeding" || firstType == "proceedings paper" || firstType == "conference paper":
+			case firstType == "conference proceeding" || firstType == "proceedings paper" || firstType == "conference paper" || firstType == "cpaper":
 				p.Type = "conference"
 				p.ConferenceType = "proceedingsPaper"
 			case firstType == "poster":
@@ -192,7 +217,7 @@ func mapRecord(r Record, p *models.Publication) {
 				p.MiscellaneousType = "preprint"
 			case firstType == "data paper":
 				p.Type = "miscellaneous"
-			case firstType == "other" || firstType == "discussion" || firstType == "slide":
+			case firstType == "other" || firstType == "discussion" || firstType == "slide" || firstType == "gen":
 				p.Type = "miscellaneous"
 				p.MiscellaneousType = "other"
 			}
@@ -0,0 +1,29 @@
+package ris
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestDecodeStartPage(t *testing.T) {
+	in := "TY  - JOUR\nTI  - A study of things\nSP  - 100\nEP  - 110\nDO  - 10.1234/things\nER  - \n"
+
+	p := &models.Publication{}
+	require.NoError(t, NewDecoder(strings.NewReader(in)).Decode(p))
+	require.Equal(t, "100", p.PageFirst)
+	require.Equal(t, "110", p.PageLast)
+	require.Equal(t, "10.1234/things", p.DOI)
+}
+
+func TestDecodeWOSSponsors(t *testing.T) {
+	// SP holds the conference sponsors in the WoS tagged format
+	in := "PT J\nDT Proceedings Paper\nTI A study of things\nSP Some Society\nBP 100\nEP 110\nER\n"
+
+	p := &models.Publication{}
+	require.NoError(t, NewWOSDecoder(strings.NewReader(in)).Decode(p))
+	require.Equal(t, "100", p.PageFirst)
+	require.Equal(t, "110", p.PageLast)
+}
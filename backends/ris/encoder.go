@@ -0,0 +1,130 @@
+package ris
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const ContentType = "application/x-research-info-systems"
+
+func EncodePublication(p *models.Publication) ([]byte, error) {
+	b := &bytes.Buffer{}
+	writeRecord(b, p)
+	return b.Bytes(), nil
+}
+
+type Exporter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func NewExporter(w io.Writer) backends.PublicationListExporter {
+	return &Exporter{w: bufio.NewWriter(w)}
+}
+
+func (x *Exporter) GetContentType() string {
+	return ContentType
+}
+
+// Add writes the record immediately, errors are returned by Flush
+func (x *Exporter) Add(p *models.Publication) {
+	if x.err != nil {
+		return
+	}
+	b := &bytes.Buffer{}
+	writeRecord(b, p)
+	b.WriteString("\n")
+	_, x.err = x.w.Write(b.Bytes())
+}
+
+func (x *Exporter) Flush() error {
+	if x.err != nil {
+		return x.err
+	}
+	return x.w.Flush()
+}
+
+func writeRecord(b *bytes.Buffer, p *models.Publication) {
+	writeTag(b, "TY", recordType(p))
+	for _, c := range p.Author {
+		writeTag(b, "AU", name(c))
+	}
+	for _, c := range p.Editor {
+		writeTag(b, "ED", name(c))
+	}
+	writeTag(b, "TI", p.Title)
+	if p.Type == "journal_article" {
+		writeTag(b, "JO", p.Publication)
+	} else {
+		writeTag(b, "T2", p.Publication)
+	}
+	writeTag(b, "T3", p.SeriesTitle)
+	writeTag(b, "PY", p.Year)
+	writeTag(b, "VL", p.Volume)
+	writeTag(b, "IS", p.Issue)
+	writeTag(b, "SP", p.PageFirst)
+	writeTag(b, "EP", p.PageLast)
+	writeTag(b, "PB", p.Publisher)
+	writeTag(b, "DO", p.DOI)
+	for _, val := range p.ISSN {
+		writeTag(b, "SN", val)
+	}
+	for _, val := range p.ISBN {
+		writeTag(b, "BN", val)
+	}
+	for _, val := range p.Keyword {
+		writeTag(b, "KW", val)
+	}
+	if len(p.Abstract) > 0 {
+		writeTag(b, "AB", p.Abstract[0].Text)
+	}
+	writeTag(b, "UR", p.Handle)
+	b.WriteString("ER  - \n")
+}
+
+// recordType is the inverse of the type mapping in the decoder
+func recordType(p *models.Publication) string {
+	switch p.Type {
+	case "journal_article":
+		return "JOUR"
+	case "book":
+		return "BOOK"
+	case "book_editor":
+		return "EDBOOK"
+	case "book_chapter":
+		return "CHAP"
+	case "conference":
+		return "CPAPER"
+	case "dissertation":
+		return "THES"
+	case "miscellaneous":
+		if p.MiscellaneousType == "report" {
+			return "RPRT"
+		}
+	}
+	return "GEN"
+}
+
+func name(c *models.Contributor) string {
+	if c.FirstName() != "" {
+		return c.LastName() + ", " + c.FirstName()
+	}
+	return c.LastName()
+}
+
+func writeTag(b *bytes.Buffer, tag, val string) {
+	// a record can't contain line breaks
+	val = strings.Join(strings.Fields(val), " ")
+	if val == "" {
+		return
+	}
+	b.WriteString(tag)
+	b.WriteString("  - ")
+	b.WriteString(val)
+	b.WriteString("\n")
+}
@@ -0,0 +1,64 @@
+package ris
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestEncodeRoundTrip(t *testing.T) {
+	p := &models.Publication{
+		ID:          "01HXYZ",
+		Type:        "journal_article",
+		Title:       "A study of things",
+		Year:        "2023",
+		Publication: "Journal of Things",
+		Volume:      "12",
+		Issue:       "3",
+		PageFirst:   "100",
+		PageLast:    "110",
+		DOI:         "10.1234/things",
+		Author: []*models.Contributor{
+			models.ContributorFromFirstLastName("Jane", "Doe"),
+			models.ContributorFromFirstLastName("John", "Smith"),
+		},
+	}
+
+	b, err := EncodePublication(p)
+	require.NoError(t, err)
+
+	got := &models.Publication{}
+	require.NoError(t, NewDecoder(bytes.NewReader(b)).Decode(got))
+
+	require.Equal(t, p.Type, got.Type)
+	require.Equal(t, p.Title, got.Title)
+	require.Equal(t, p.Year, got.Year)
+	require.Equal(t, p.Volume, got.Volume)
+	require.Equal(t, p.Issue, got.Issue)
+	require.Equal(t, p.PageFirst, got.PageFirst)
+	require.Equal(t, p.PageLast, got.PageLast)
+	require.Equal(t, p.DOI, got.DOI)
+	require.Len(t, got.Author, 2)
+	require.Equal(t, "Doe", got.Author[0].LastName())
+	require.Equal(t, "Jane", got.Author[0].FirstName())
+	require.Equal(t, "Smith", got.Author[1].LastName())
+}
+
+func TestExporter(t *testing.T) {
+	b := &bytes.Buffer{}
+	x := NewExporter(b)
+	x.Add(&models.Publication{ID: "1", Type: "book", Title: "First"})
+	x.Add(&models.Publication{ID: "2", Type: "dissertation", Title: "Second"})
+	require.NoError(t, x.Flush())
+
+	dec := NewDecoder(bytes.NewReader(b.Bytes()))
+	p1, p2 := &models.Publication{}, &models.Publication{}
+	require.NoError(t, dec.Decode(p1))
+	require.NoError(t, dec.Decode(p2))
+	require.Equal(t, "First", p1.Title)
+	require.Equal(t, "book", p1.Type)
+	require.Equal(t, "Second", p2.Title)
+	require.Equal(t, "dissertation", p2.Type)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 
+	"github.com/ugent-library/biblio-backoffice/changefeed"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/biblio-backoffice/repositories"
 	"github.com/ugent-library/orcid"
@@ -24,6 +25,7 @@ type Services struct {
 	UserService               UserService
 	OrganizationSearchService OrganizationSearchService
 	PersonSearchService       PersonSearchService
+	PersonORCIDService        PersonORCIDService
 	ProjectSearchService      ProjectSearchService
 	UserSearchService         UserSearchService
 	LicenseSearchService      LicenseSearchService
@@ -35,6 +37,10 @@ type Services struct {
 	PublicationListExporters  map[string]PublicationListExporterFactory
 	DatasetListExporters      map[string]DatasetListExporterFactory
 	HandleService             HandleService
+	DOIService                DOIService
+	ORCIDWorkService          ORCIDWorkService
+	MailSender                MailSender
+	ChangeFeed                *changefeed.Feed
 }
 
 type PublicationEncoder func(*models.Publication) ([]byte, error)
@@ -78,7 +84,7 @@ type BulkIndexer[T any] interface {
 
 type DatasetIDIndex interface {
 	Search(*models.SearchArgs) (*models.SearchHits, error)
-	Each(searchArgs *models.SearchArgs, maxSize int, cb func(string)) error
+	Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(string)) error
 	Delete(id string) error
 	DeleteAll() error
 	WithScope(string, ...string) DatasetIDIndex
@@ -86,7 +92,7 @@ type DatasetIDIndex interface {
 
 type DatasetIndex interface {
 	Search(*models.SearchArgs) (*models.DatasetHits, error)
-	Each(searchArgs *models.SearchArgs, maxSize int, cb func(*models.Dataset)) error
+	Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(*models.Dataset)) error
 	Delete(id string) error
 	DeleteAll() error
 	WithScope(string, ...string) DatasetIndex
@@ -122,8 +128,8 @@ func (ds *datasetIndex) Search(args *models.SearchArgs) (*models.DatasetHits, er
 	}, nil
 }
 
-func (ds *datasetIndex) Each(searchArgs *models.SearchArgs, maxSize int, cb func(*models.Dataset)) error {
-	return ds.DatasetIDIndex.Each(searchArgs, maxSize, func(id string) {
+func (ds *datasetIndex) Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(*models.Dataset)) error {
+	return ds.DatasetIDIndex.Each(ctx, searchArgs, maxSize, func(id string) {
 		// TODO handle error
 		dataset, _ := ds.repo.GetDataset(id)
 		cb(dataset)
@@ -139,7 +145,7 @@ func (ds *datasetIndex) WithScope(field string, terms ...string) DatasetIndex {
 
 type PublicationIDIndex interface {
 	Search(*models.SearchArgs) (*models.SearchHits, error)
-	Each(searchArgs *models.SearchArgs, maxSize int, cb func(string)) error
+	Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(string)) error
 	Delete(id string) error
 	DeleteAll() error
 	WithScope(string, ...string) PublicationIDIndex
@@ -147,7 +153,7 @@ type PublicationIDIndex interface {
 
 type PublicationIndex interface {
 	Search(*models.SearchArgs) (*models.PublicationHits, error)
-	Each(searchArgs *models.SearchArgs, maxSize int, cb func(*models.Publication)) error
+	Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(*models.Publication)) error
 	Delete(id string) error
 	DeleteAll() error
 	WithScope(string, ...string) PublicationIndex
@@ -183,8 +189,8 @@ func (pi *publicationIndex) Search(args *models.SearchArgs) (*models.Publication
 	}, nil
 }
 
-func (pi *publicationIndex) Each(searchArgs *models.SearchArgs, maxSize int, cb func(*models.Publication)) error {
-	return pi.PublicationIDIndex.Each(searchArgs, maxSize, func(id string) {
+func (pi *publicationIndex) Each(ctx context.Context, searchArgs *models.SearchArgs, maxSize int, cb func(*models.Publication)) error {
+	return pi.PublicationIDIndex.Each(ctx, searchArgs, maxSize, func(id string) {
 		// TODO handle error
 		publication, _ := pi.repo.GetPublication(id)
 		cb(publication)
@@ -232,6 +238,12 @@ type PersonSearchService interface {
 	SuggestPeople(string) ([]*models.Person, error)
 }
 
+// PersonORCIDService lists the ORCID iDs of the active people in the person
+// authority
+type PersonORCIDService interface {
+	EachPersonORCID(context.Context, func(string) error) error
+}
+
 type ProjectSearchService interface {
 	SuggestProjects(string) ([]*models.Project, error)
 }
@@ -270,6 +282,28 @@ type HandleService interface {
 	UpsertHandle(string) (*models.Handle, error)
 }
 
+type DOIService interface {
+	NewDOI(string) string
+	Manages(string) bool
+	UpsertDOI(doi, url string, metadata []byte, findable bool) error
+}
+
+type ORCIDWorkService interface {
+	SyncPublication(*models.Publication, ...string) (bool, error)
+}
+
+type MailMessage struct {
+	To      []string
+	Subject string
+	Body    string // plain text
+}
+
+// MailSender delivers mail, a stand-in can be used in tests and during local
+// development
+type MailSender interface {
+	SendMail(context.Context, *MailMessage) error
+}
+
 const MissingValue = "missing"
 
 type PersonWithOrganizationsService struct {
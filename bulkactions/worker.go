@@ -0,0 +1,121 @@
+// Package bulkactions runs curator bulk actions on candidate records in the
+// background so that hundreds of records don't have to be handled in a single
+// HTTP request.
+package bulkactions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	// records are handled in transactions of chunkSize records, progress is
+	// reported after every chunk, which also serves as a heartbeat for the job
+	chunkSize = 25
+	// running jobs without a heartbeat for this long are picked up again
+	staleAfter = 10 * time.Minute
+)
+
+type Worker struct {
+	services     *backends.Services
+	logger       *slog.Logger
+	pollInterval time.Duration
+}
+
+func NewWorker(services *backends.Services, logger *slog.Logger) *Worker {
+	return &Worker{
+		services:     services,
+		logger:       logger,
+		pollInterval: 5 * time.Second,
+	}
+}
+
+// Start processes bulk jobs until ctx is cancelled. It is safe to run a
+// worker in every server instance, a job is only claimed once.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			job, err := w.services.Repo.ClaimCandidateRecordBulkJob(ctx, time.Now().Add(-staleAfter))
+			if err != nil {
+				w.logger.Error("bulk actions: claim failed", "error", err)
+				break
+			}
+			if job == nil {
+				break
+			}
+			w.Run(ctx, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run executes a claimed job and records the outcome. A job that was claimed
+// again after a crash resumes after the last reported chunk.
+func (w *Worker) Run(ctx context.Context, job *models.CandidateRecordBulkJob) {
+	w.logger.Info("bulk actions: start", "id", job.ID, "action", job.Action, "records", job.Total)
+
+	user, err := w.services.UserService.GetUser(job.UserID)
+	if err != nil {
+		w.fail(ctx, job, fmt.Errorf("can't load user %s: %w", job.UserID, err))
+		return
+	}
+
+	for ids := range slices.Chunk(job.CandidateRecordIDs[min(job.Processed, len(job.CandidateRecordIDs)):], chunkSize) {
+		n, err := w.apply(ctx, job, ids, user)
+		if err != nil {
+			w.fail(ctx, job, err)
+			return
+		}
+		job.Processed += len(ids)
+		job.Skipped += len(ids) - n
+		if err := w.services.Repo.UpdateCandidateRecordBulkJobProgress(ctx, job.ID, job.Processed, job.Skipped); err != nil {
+			w.logger.Error("bulk actions: can't update progress", "id", job.ID, "error", err)
+		}
+	}
+
+	if err := w.services.Repo.CompleteCandidateRecordBulkJob(ctx, job); err != nil {
+		w.logger.Error("bulk actions: can't mark job as done", "id", job.ID, "error", err)
+		return
+	}
+
+	w.logger.Info("bulk actions: done", "id", job.ID, "action", job.Action, "records", job.Processed, "skipped", job.Skipped)
+}
+
+// apply runs the job action on a chunk of records and returns how many
+// records were changed
+func (w *Worker) apply(ctx context.Context, job *models.CandidateRecordBulkJob, ids []string, user *models.Person) (int, error) {
+	repo := w.services.Repo
+
+	switch job.Action {
+	case models.CandidateRecordBulkImport:
+		pubIDs, err := repo.ImportCandidateRecordsAsPublications(ctx, ids, user)
+		return len(pubIDs), err
+	case models.CandidateRecordBulkReject:
+		return repo.RejectCandidateRecords(ctx, ids, job.Reason, user)
+	case models.CandidateRecordBulkAssign:
+		return repo.AssignCandidateRecords(ctx, ids, job.PersonID, user)
+	}
+
+	return 0, fmt.Errorf("unknown bulk action %q", job.Action)
+}
+
+func (w *Worker) fail(ctx context.Context, job *models.CandidateRecordBulkJob, err error) {
+	w.logger.Error("bulk actions: failed", "id", job.ID, "error", err)
+	if err := w.services.Repo.FailCandidateRecordBulkJob(ctx, job.ID, err); err != nil {
+		w.logger.Error("bulk actions: can't mark job as failed", "id", job.ID, "error", err)
+	}
+}
@@ -0,0 +1,63 @@
+// Package changefeed wakes up change feed consumers when a publication or
+// dataset changes. Changes made by other processes are not signalled, consumers
+// are expected to poll now and then as well.
+package changefeed
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a position in the change feed. Snapshots are ordered by the id of
+// the transaction that added them, a cursor only moves past a transaction
+// once it has committed or rolled back.
+type Cursor struct {
+	TxID       int64
+	SnapshotID string
+}
+
+// String encodes a cursor as <transaction id>-<snapshot id>
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d-%s", c.TxID, c.SnapshotID)
+}
+
+func ParseCursor(str string) (Cursor, error) {
+	txid, snapshotID, ok := strings.Cut(str, "-")
+	if !ok {
+		return Cursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, str)
+	}
+	id, err := strconv.ParseInt(txid, 10, 64)
+	if err != nil || id < 0 {
+		return Cursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, str)
+	}
+	return Cursor{TxID: id, SnapshotID: snapshotID}, nil
+}
+
+type Feed struct {
+	mu      sync.Mutex
+	changed chan struct{}
+}
+
+func New() *Feed {
+	return &Feed{changed: make(chan struct{})}
+}
+
+// Notify wakes up all consumers that are waiting on Changed
+func (f *Feed) Notify() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// Changed returns a channel that is closed on the next change
+func (f *Feed) Changed() <-chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.changed
+}
@@ -0,0 +1,56 @@
+package changefeed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	c := Cursor{TxID: 48213, SnapshotID: "01HZX3V9Q2J8M6K4T0N5R7B1CD"}
+	require.Equal(t, "48213-01HZX3V9Q2J8M6K4T0N5R7B1CD", c.String())
+
+	parsed, err := ParseCursor(c.String())
+	require.NoError(t, err)
+	require.Equal(t, c, parsed)
+
+	// legacy snapshot ids are uuids
+	parsed, err = ParseCursor("48213-6f3c2a54-1b8e-4f1d-9c43-0e2b6a7d8f90")
+	require.NoError(t, err)
+	require.Equal(t, "6f3c2a54-1b8e-4f1d-9c43-0e2b6a7d8f90", parsed.SnapshotID)
+
+	// a cursor at the start of a transaction
+	parsed, err = ParseCursor("48213-")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{TxID: 48213}, parsed)
+
+	_, err = ParseCursor("01HZX3V9Q2J8M6K4T0N5R7B1CD")
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = ParseCursor("-1-01HZX3V9Q2J8M6K4T0N5R7B1CD")
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestFeed(t *testing.T) {
+	f := New()
+	changed := f.Changed()
+
+	select {
+	case <-changed:
+		t.Fatal("changed before notify")
+	default:
+	}
+
+	f.Notify()
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("not changed after notify")
+	}
+
+	select {
+	case <-f.Changed():
+		t.Fatal("next change already closed")
+	default:
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -14,6 +15,8 @@ import (
 	"github.com/ugent-library/biblio-backoffice/backends/bibtex"
 	"github.com/ugent-library/biblio-backoffice/backends/citeproc"
 	"github.com/ugent-library/biblio-backoffice/backends/crossref"
+	"github.com/ugent-library/biblio-backoffice/backends/csl"
+	"github.com/ugent-library/biblio-backoffice/backends/csv"
 	"github.com/ugent-library/biblio-backoffice/backends/datacite"
 	"github.com/ugent-library/biblio-backoffice/backends/es6"
 	excel_dataset "github.com/ugent-library/biblio-backoffice/backends/excel/dataset"
@@ -22,11 +25,15 @@ import (
 	"github.com/ugent-library/biblio-backoffice/backends/handle"
 	"github.com/ugent-library/biblio-backoffice/backends/s3store"
 	"github.com/ugent-library/biblio-backoffice/caching"
+	"github.com/ugent-library/biblio-backoffice/changefeed"
+	"github.com/ugent-library/biblio-backoffice/frontoffice"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/biblio-backoffice/mutate"
 
 	"github.com/ugent-library/biblio-backoffice/backends/ianamedia"
 	"github.com/ugent-library/biblio-backoffice/backends/jsonl"
+	"github.com/ugent-library/biblio-backoffice/backends/mail"
+	"github.com/ugent-library/biblio-backoffice/backends/orcidworks"
 	"github.com/ugent-library/biblio-backoffice/backends/pubmed"
 	"github.com/ugent-library/biblio-backoffice/backends/ris"
 	"github.com/ugent-library/biblio-backoffice/backends/spdxlicenses"
@@ -59,6 +66,15 @@ func newServices() *backends.Services {
 	}
 	orcidClient := orcid.NewMemberClient(orcidConfig)
 
+	var orcidWorkService backends.ORCIDWorkService = nil
+
+	if config.ORCID.SyncWorks {
+		orcidWorkService = orcidworks.NewClient(orcidworks.Config{
+			Sandbox:     config.ORCID.Sandbox,
+			FrontendURL: config.Frontend.URL,
+		})
+	}
+
 	citeprocURL := config.CiteprocURL
 
 	var handleService backends.HandleService = nil
@@ -75,6 +91,17 @@ func newServices() *backends.Services {
 		)
 	}
 
+	var doiService backends.DOIService = nil
+
+	if config.DataCite.Enabled {
+		doiService = datacite.NewDOIClient(datacite.DOIConfig{
+			BaseURL:  config.DataCite.URL,
+			Prefix:   config.DataCite.Prefix,
+			Username: config.DataCite.Username,
+			Password: config.DataCite.Password,
+		})
+	}
+
 	organizationService := caching.NewOrganizationService(authorityClient)
 
 	// always add organization info to user affiliations
@@ -91,7 +118,9 @@ func newServices() *backends.Services {
 
 	projectsService := caching.NewProjectService(authorityClient)
 
-	repo := newRepo(pool, personService, organizationService, projectsService)
+	changeFeed := changefeed.New()
+
+	repo := newRepo(pool, personService, organizationService, projectsService, orcidWorkService, doiService, changeFeed)
 
 	searchService := newSearchService()
 
@@ -109,6 +138,7 @@ func newServices() *backends.Services {
 		UserService:               userService,
 		OrganizationSearchService: authorityClient,
 		PersonSearchService:       authorityClient,
+		PersonORCIDService:        authorityClient,
 		ProjectSearchService:      authorityClient,
 		UserSearchService:         authorityClient,
 		LicenseSearchService:      spdxlicenses.New(),
@@ -128,51 +158,127 @@ func newServices() *backends.Services {
 			"cite-fwo":                 citeproc.New(citeprocURL, "fwo").EncodePublication,
 			"cite-vancouver":           citeproc.New(citeprocURL, "vancouver").EncodePublication,
 			"cite-ieee":                citeproc.New(citeprocURL, "ieee").EncodePublication,
+			"bibtex":                   bibtex.EncodePublication,
+			"ris":                      ris.EncodePublication,
+			"csl-json":                 csl.EncodePublication,
 		},
 		PublicationDecoders: map[string]backends.PublicationDecoderFactory{
 			"jsonl":  jsonl.NewDecoder,
 			"ris":    ris.NewDecoder,
-			"wos":    ris.NewDecoder,
+			"wos":    ris.NewWOSDecoder,
 			"bibtex": bibtex.NewDecoder,
 		},
 		PublicationListExporters: map[string]backends.PublicationListExporterFactory{
-			"xlsx": excel_publication.NewExporter,
+			"xlsx":     excel_publication.NewExporter,
+			"csv":      csv.NewPublicationExporter,
+			"jsonl":    jsonl.NewPublicationExporter,
+			"bibtex":   bibtex.NewExporter,
+			"ris":      ris.NewExporter,
+			"csl-json": csl.NewExporter,
 		},
 		DatasetListExporters: map[string]backends.DatasetListExporterFactory{
-			"xlsx": excel_dataset.NewExporter,
+			"xlsx":  excel_dataset.NewExporter,
+			"csv":   csv.NewDatasetExporter,
+			"jsonl": jsonl.NewDatasetExporter,
 		},
-		HandleService: handleService,
+		HandleService:    handleService,
+		DOIService:       doiService,
+		ORCIDWorkService: orcidWorkService,
+		MailSender:       newMailSender(),
+		ChangeFeed:       changeFeed,
 	}
 }
 
-func newRepo(conn *pgxpool.Pool, personService backends.PersonService, organizationService backends.OrganizationService, projectService backends.ProjectService) *repositories.Repo {
+func newRepo(conn *pgxpool.Pool, personService backends.PersonService, organizationService backends.OrganizationService, projectService backends.ProjectService, orcidWorkService backends.ORCIDWorkService, doiService backends.DOIService, changeFeed *changefeed.Feed) *repositories.Repo {
 	ctx := context.Background()
 
 	bp := newPublicationBulkIndexerService()
 	bd := newDatasetBulkIndexerService()
 
-	repo, err := repositories.New(repositories.Config{
-		Conn: conn,
+	var repo *repositories.Repo
 
-		PublicationListeners: []repositories.PublicationListener{
-			func(p *models.Publication) {
-				if p.DateUntil == nil {
-					if err := bp.Index(ctx, p); err != nil {
-						logger.Error("error indexing publication", "id", p.ID, "error", err)
-					}
+	publicationListeners := []repositories.PublicationListener{
+		func(p *models.Publication) {
+			if p.DateUntil == nil {
+				if err := bp.Index(ctx, p); err != nil {
+					logger.Error("error indexing publication", "id", p.ID, "error", err)
 				}
-			},
+			}
 		},
+	}
 
-		DatasetListeners: []repositories.DatasetListener{
-			func(d *models.Dataset) {
-				if d.DateUntil == nil {
-					if err := bd.Index(ctx, d); err != nil {
-						logger.Error("error indexing dataset", "id", d.ID, "error", err)
-					}
+	if orcidWorkService != nil {
+		publicationListeners = append(publicationListeners, newORCIDWorkListener(func() *repositories.Repo { return repo }))
+	}
+
+	publicationListeners = append(publicationListeners, func(p *models.Publication) {
+		if err := repo.NotifyPublicationChange(ctx, p); err != nil {
+			logger.Error("error adding notifications", "id", p.ID, "error", err)
+		}
+	})
+
+	publicationListeners = append(publicationListeners, func(p *models.Publication) {
+		if p.DateUntil == nil {
+			changeFeed.Notify()
+		}
+	})
+
+	if len(config.Webhooks.URLs) > 0 {
+		publicationListeners = append(publicationListeners, func(p *models.Publication) {
+			if p.DateUntil != nil {
+				return
+			}
+			created := p.DateCreated != nil && p.DateUpdated != nil && p.DateCreated.Equal(*p.DateUpdated)
+			if err := repo.AddWebhookEvent(ctx, "publication", p.ID, p.Status, created, frontoffice.MapPublication(p, repo), config.Webhooks.URLs); err != nil {
+				logger.Error("error queueing webhooks", "id", p.ID, "error", err)
+			}
+		})
+	}
+
+	datasetListeners := []repositories.DatasetListener{
+		func(d *models.Dataset) {
+			if d.DateUntil == nil {
+				if err := bd.Index(ctx, d); err != nil {
+					logger.Error("error indexing dataset", "id", d.ID, "error", err)
 				}
-			},
+			}
 		},
+	}
+
+	if doiService != nil {
+		datasetListeners = append(datasetListeners, newDOIListener(doiService, func() *repositories.Repo { return repo }))
+	}
+
+	datasetListeners = append(datasetListeners, func(d *models.Dataset) {
+		if err := repo.NotifyDatasetChange(ctx, d); err != nil {
+			logger.Error("error adding notifications", "id", d.ID, "error", err)
+		}
+	})
+
+	datasetListeners = append(datasetListeners, func(d *models.Dataset) {
+		if d.DateUntil == nil {
+			changeFeed.Notify()
+		}
+	})
+
+	if len(config.Webhooks.URLs) > 0 {
+		datasetListeners = append(datasetListeners, func(d *models.Dataset) {
+			if d.DateUntil != nil {
+				return
+			}
+			created := d.DateCreated != nil && d.DateUpdated != nil && d.DateCreated.Equal(*d.DateUpdated)
+			if err := repo.AddWebhookEvent(ctx, "dataset", d.ID, d.Status, created, frontoffice.MapDataset(d, repo), config.Webhooks.URLs); err != nil {
+				logger.Error("error queueing webhooks", "id", d.ID, "error", err)
+			}
+		})
+	}
+
+	repo, err := repositories.New(repositories.Config{
+		Conn: conn,
+
+		PublicationListeners: publicationListeners,
+
+		DatasetListeners: datasetListeners,
 
 		PublicationLoaders: []repositories.PublicationVisitor{
 			func(p *models.Publication) error {
@@ -360,6 +466,71 @@ func newRepo(conn *pgxpool.Pool, personService backends.PersonService, organizat
 						c.StatusPerson = person
 					}
 				}
+				if c.AssignedPersonID != "" {
+					person, err := personService.GetPerson(c.AssignedPersonID)
+					if err != nil {
+						logger.Warn("error loading assigned person in candidate record", "assignedPersonID", c.AssignedPersonID, "id", c.ID, "error", err)
+						c.AssignedPerson = backends.NewDummyPerson(c.AssignedPersonID)
+					} else {
+						c.AssignedPerson = person
+					}
+				}
+				return nil
+			},
+		},
+
+		CommentLoaders: []repositories.CommentVisitor{
+			func(c *models.Comment) error {
+				person, err := personService.GetPerson(c.UserID)
+				if err != nil {
+					logger.Warn("error loading user in comment", "personID", c.UserID, "id", c.ID, "error", err)
+					c.User = backends.NewDummyPerson(c.UserID)
+				} else {
+					c.User = person
+				}
+				return nil
+			},
+		},
+
+		NotificationLoaders: []repositories.NotificationVisitor{
+			func(n *models.Notification) error {
+				if n.ActorID != "" {
+					person, err := personService.GetPerson(n.ActorID)
+					if err != nil {
+						logger.Warn("error loading actor in notification", "personID", n.ActorID, "id", n.ID, "error", err)
+						n.Actor = backends.NewDummyPerson(n.ActorID)
+					} else {
+						n.Actor = person
+					}
+				}
+				return nil
+			},
+		},
+
+		PersonMatchLoaders: []repositories.PersonMatchVisitor{
+			func(m *models.PersonMatch) error {
+				person, err := personService.GetPerson(m.PersonID)
+				if err != nil {
+					logger.Warn("error loading person in person match", "personID", m.PersonID, "id", m.ID, "error", err)
+					m.Person = backends.NewDummyPerson(m.PersonID)
+				} else {
+					m.Person = person
+				}
+				return nil
+			},
+		},
+
+		CandidateRecordBulkJobLoaders: []repositories.CandidateRecordBulkJobVisitor{
+			func(j *models.CandidateRecordBulkJob) error {
+				if j.PersonID != "" {
+					person, err := personService.GetPerson(j.PersonID)
+					if err != nil {
+						logger.Warn("error loading person in candidate record bulk job", "personID", j.PersonID, "id", j.ID, "error", err)
+						j.Person = backends.NewDummyPerson(j.PersonID)
+					} else {
+						j.Person = person
+					}
+				}
 				return nil
 			},
 		},
@@ -373,6 +544,18 @@ func newRepo(conn *pgxpool.Pool, personService backends.PersonService, organizat
 	return repo
 }
 
+func newMailSender() backends.MailSender {
+	if config.SMTP.Addr == "" {
+		return mail.NewLogSender(logger)
+	}
+	return mail.NewSMTPSender(mail.SMTPConfig{
+		Addr:     config.SMTP.Addr,
+		Username: config.SMTP.Username,
+		Password: config.SMTP.Password,
+		From:     config.SMTP.From,
+	})
+}
+
 func newFileStore() backends.FileStore {
 	if baseDir := config.FileDir; baseDir != "" {
 		store, err := fsstore.New(fsstore.Config{
@@ -475,3 +658,48 @@ func newDatasetBulkIndexerService() backends.BulkIndexer[*models.Dataset] {
 
 	return bd
 }
+
+// newORCIDWorkListener queues public publications for a push to the ORCID
+// records of their contributors, see syncing.Worker
+func newORCIDWorkListener(getRepo func() *repositories.Repo) repositories.PublicationListener {
+	return func(p *models.Publication) {
+		if p.DateUntil != nil || (p.Status != "public" && len(p.ORCIDWork) == 0) {
+			return
+		}
+		if err := getRepo().AddSyncJob(context.Background(), models.SyncORCIDWorks, p.ID); err != nil {
+			logger.Error("error queueing orcid work sync", "id", p.ID, "error", err)
+		}
+	}
+}
+
+// newDOIListener queues datasets for DOI registration when they are
+// published, and the DOIs we manage whenever their dataset changes. See
+// syncing.Worker.
+func newDOIListener(doiService backends.DOIService, getRepo func() *repositories.Repo) repositories.DatasetListener {
+	return func(d *models.Dataset) {
+		if d.DateUntil != nil {
+			return
+		}
+		doi := d.Identifiers.Get("DOI")
+		if doi != "" && !doiService.Manages(doi) {
+			return
+		}
+		repo := getRepo()
+		if doi == "" {
+			if d.Status != "public" || d.DateFrom == nil {
+				return
+			}
+			prev, err := repo.GetDatasetSnapshotBefore(d.ID, *d.DateFrom)
+			if err != nil && !errors.Is(err, models.ErrNotFound) {
+				logger.Error("error queueing doi registration", "id", d.ID, "error", err)
+				return
+			}
+			if prev != nil && prev.Status == "public" {
+				return
+			}
+		}
+		if err := repo.AddSyncJob(context.Background(), models.SyncDOI, d.ID); err != nil {
+			logger.Error("error queueing doi registration", "id", d.ID, "error", err)
+		}
+	}
+}
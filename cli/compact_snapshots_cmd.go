@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+)
+
+func init() {
+	rootCmd.AddCommand(compactSnapshots)
+	compactSnapshots.Flags().Duration("window", 10*time.Minute, "collapse consecutive snapshots by the same user within this window")
+	compactSnapshots.Flags().Duration("retain-for", 365*24*time.Hour, "thin out snapshots that were replaced longer ago than this")
+	compactSnapshots.Flags().Duration("retain-window", 24*time.Hour, "keep the last thinned out snapshot of each window")
+	compactSnapshots.Flags().Bool("dry-run", false, "only report what would be removed")
+}
+
+var compactSnapshots = &cobra.Command{
+	Use:   "compact-snapshots",
+	Short: "Remove redundant publication and dataset snapshots",
+	Long: `Collapse consecutive snapshots by the same user and thin out old minor
+edits. The first snapshot of a record, the current one and the one before it,
+status and lock changes and snapshots involved in a restore are always kept.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		window, _ := cmd.Flags().GetDuration("window")
+		retainFor, _ := cmd.Flags().GetDuration("retain-for")
+		retainWindow, _ := cmd.Flags().GetDuration("retain-window")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		p := snapstore.CompactionPolicy{
+			Window:       window,
+			RetainFor:    retainFor,
+			RetainWindow: retainWindow,
+			DryRun:       dryRun,
+		}
+
+		services := newServices()
+
+		report, err := services.Repo.CompactPublicationSnapshots(cmd.Context(), p)
+		if err != nil {
+			return err
+		}
+		logCompactionReport("publication", report, dryRun)
+
+		report, err = services.Repo.CompactDatasetSnapshots(cmd.Context(), p)
+		if err != nil {
+			return err
+		}
+		logCompactionReport("dataset", report, dryRun)
+
+		return nil
+	},
+}
+
+func logCompactionReport(recordType string, report *snapstore.CompactionReport, dryRun bool) {
+	verb := "removed"
+	if dryRun {
+		verb = "would remove"
+	}
+	logger.Info(fmt.Sprintf("%s %d of %d snapshots of %d %ss", verb, report.Removed, report.Snapshots, report.Records, recordType))
+}
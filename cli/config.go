@@ -1,5 +1,7 @@
 package cli
 
+import "time"
+
 // Version info
 type Version struct {
 	Branch string `env:"SOURCE_BRANCH"`
@@ -60,6 +62,8 @@ type Config struct {
 		ClientID     string `env:"CLIENT_ID"`
 		ClientSecret string `env:"CLIENT_SECRET"`
 		Sandbox      bool   `env:"SANDBOX"`
+		// push publications to the ORCID records of their authors and editors
+		SyncWorks bool `env:"SYNC_WORKS"`
 	} `envPrefix:"ORCID_"`
 	OIDC struct {
 		URL           string `env:"URL"`
@@ -77,6 +81,44 @@ type Config struct {
 		Username string `env:"USERNAME"`
 		Password string `env:"PASSWORD"`
 	} `envPrefix:"HDL_SRV_"`
+	DataCite struct {
+		Enabled  bool   `env:"ENABLED"`
+		URL      string `env:"URL"` // defaults to the DataCite REST API
+		Prefix   string `env:"PREFIX"`
+		Username string `env:"USERNAME"`
+		Password string `env:"PASSWORD"`
+	} `envPrefix:"DATACITE_"`
+	ExportJobs struct {
+		// run the export job worker in the server process
+		Worker bool `env:"WORKER" envDefault:"true"`
+		// how long finished exports can be downloaded
+		TTL time.Duration `env:"TTL" envDefault:"24h"`
+	} `envPrefix:"EXPORT_JOBS_"`
+	CandidateRecordBulkJobs struct {
+		// run the bulk action worker in the server process
+		Worker bool `env:"WORKER" envDefault:"true"`
+	} `envPrefix:"CANDIDATE_RECORD_BULK_JOBS_"`
+	Webhooks struct {
+		// endpoints that receive publication and dataset lifecycle events
+		URLs []string `env:"URLS"`
+		// payloads are signed with this secret
+		Secret string `env:"SECRET"`
+		// run the delivery worker in the server process
+		Worker bool `env:"WORKER" envDefault:"true"`
+		// give up on a delivery after this many attempts
+		MaxAttempts int `env:"MAX_ATTEMPTS" envDefault:"12"`
+	} `envPrefix:"WEBHOOKS_"`
+	SyncJobs struct {
+		// run the worker that pushes records to ORCID and DataCite in the server process
+		Worker bool `env:"WORKER" envDefault:"true"`
+	} `envPrefix:"SYNC_JOBS_"`
+	SMTP struct {
+		// mail is only logged if no relay is configured
+		Addr     string `env:"ADDR"`
+		Username string `env:"USERNAME"`
+		Password string `env:"PASSWORD"`
+		From     string `env:"FROM" envDefault:"biblio@ugent.be"`
+	} `envPrefix:"SMTP_"`
 	OAI struct {
 		APIURL string `env:"API_URL"`
 		APIKey string `env:"API_KEY"`
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportPublicationsCmd)
+	exportCmd.AddCommand(exportDatasetsCmd)
+	exportCmd.PersistentFlags().String("at", "", "export the records as they were at this date or RFC3339 timestamp")
+	exportCmd.PersistentFlags().String("format", "jsonl", "export format")
+	exportCmd.PersistentFlags().Bool("include-deleted", false, "also export deleted records")
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the repository as it was at a given date",
+	Long: `Export all publications or datasets as they were at a given date
+(midnight UTC) or RFC3339 timestamp to stdout. Records that didn't exist yet
+at that time and deleted records are left out.`,
+}
+
+var exportPublicationsCmd = &cobra.Command{
+	Use:   "publications",
+	Short: "Export publications",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		at, err := exportAt(cmd)
+		if err != nil {
+			return err
+		}
+		format, _ := cmd.Flags().GetString("format")
+		includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+
+		services := newServices()
+
+		factory, ok := services.PublicationListExporters[format]
+		if !ok {
+			return fmt.Errorf("unknown publication export format %s", format)
+		}
+		exporter := factory(os.Stdout)
+
+		err = services.Repo.EachPublicationAt(at, func(p *models.Publication) bool {
+			if p.Status == "deleted" && !includeDeleted {
+				return true
+			}
+			exporter.Add(p)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if err := exporter.Flush(); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+var exportDatasetsCmd = &cobra.Command{
+	Use:   "datasets",
+	Short: "Export datasets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		at, err := exportAt(cmd)
+		if err != nil {
+			return err
+		}
+		format, _ := cmd.Flags().GetString("format")
+		includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+
+		services := newServices()
+
+		factory, ok := services.DatasetListExporters[format]
+		if !ok {
+			return fmt.Errorf("unknown dataset export format %s", format)
+		}
+		exporter := factory(os.Stdout)
+
+		err = services.Repo.EachDatasetAt(at, func(d *models.Dataset) bool {
+			if d.Status == "deleted" && !includeDeleted {
+				return true
+			}
+			exporter.Add(d)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if err := exporter.Flush(); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func exportAt(cmd *cobra.Command) (time.Time, error) {
+	at, _ := cmd.Flags().GetString("at")
+	if at == "" {
+		return time.Time{}, errors.New("--at is required")
+	}
+	if t, err := time.Parse(time.DateOnly, at); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("--at should be a date or RFC3339 timestamp: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/personmatching"
+)
+
+func init() {
+	rootCmd.AddCommand(matchCandidateRecords)
+}
+
+var matchCandidateRecords = &cobra.Command{
+	Use:   "match-candidate-records",
+	Short: "Match contributors of new candidate records with people",
+	Long: `Propose people for the contributors of all new candidate records again. Run
+this after the person authority changed. Matches that curators confirmed or
+rejected are kept.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services := newServices()
+		matcher := personmatching.NewMatcher(services.PersonSearchService)
+
+		var n int
+		err := services.Repo.EachNewCandidateRecord(cmd.Context(), func(rec *models.CandidateRecord) error {
+			if err := matchCandidateRecord(cmd.Context(), services, matcher, rec.ID, rec.Publication); err != nil {
+				logger.Warn("error matching candidate record", "id", rec.ID, "error", err)
+				return nil
+			}
+			n++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Info("matched candidate records", "count", n)
+
+		return nil
+	},
+}
+
+func matchCandidateRecord(ctx context.Context, services *backends.Services, matcher *personmatching.Matcher, id string, p *models.Publication) error {
+	matches, err := matcher.Match(p)
+	if err != nil {
+		return err
+	}
+	return services.Repo.SetCandidateRecordPersonMatches(ctx, id, matches)
+}
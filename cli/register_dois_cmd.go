@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/biblio-backoffice/syncing"
+)
+
+func init() {
+	rootCmd.AddCommand(registerDOIs)
+}
+
+var registerDOIs = &cobra.Command{
+	Use:   "register-dois [dataset-id]...",
+	Short: "Mint or update DataCite DOIs for datasets",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services := newServices()
+
+		if services.DOIService == nil {
+			return errors.New("datacite doi registration is not enabled")
+		}
+
+		var n int
+
+		for _, id := range args {
+			d, err := services.Repo.GetDataset(id)
+			if err != nil {
+				return err
+			}
+
+			doi, err := syncing.RegisterDatasetDOI(services, config.Frontend.URL, d)
+			if err != nil {
+				return err
+			}
+
+			logger.Info("registered dataset doi", "id", d.ID, "doi", doi)
+
+			n++
+		}
+
+		logger.Info(fmt.Sprintf("registered %d dataset dois", n))
+
+		return nil
+	},
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/biblio-backoffice/digesting"
+)
+
+func init() {
+	rootCmd.AddCommand(sendSearchDigests)
+}
+
+var sendSearchDigests = &cobra.Command{
+	Use:   "send-search-digests",
+	Short: "Mail new matches for saved searches",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services := newServices()
+
+		d := digesting.NewDigester(digesting.Config{
+			Store:                  services.Repo,
+			PublicationSearchIndex: services.PublicationSearchIndex,
+			UserService:            services.UserService,
+			MailSender:             services.MailSender,
+			BaseURL:                config.BaseURL,
+			Logger:                 logger,
+		})
+
+		n, err := d.Run(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		logger.Info(fmt.Sprintf("sent %d saved search digests", n))
+
+		return nil
+	},
+}
@@ -17,7 +17,11 @@ import (
 	"github.com/ory/graceful"
 	"github.com/spf13/cobra"
 	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/bulkactions"
+	"github.com/ugent-library/biblio-backoffice/exporting"
 	"github.com/ugent-library/biblio-backoffice/routes"
+	"github.com/ugent-library/biblio-backoffice/syncing"
+	"github.com/ugent-library/biblio-backoffice/webhooks"
 	"github.com/ugent-library/bind"
 	"github.com/ugent-library/oidc"
 )
@@ -83,6 +87,28 @@ var serverStartCmd = &cobra.Command{
 			return err
 		}
 
+		// background list exports
+		workerCtx, stopWorker := context.WithCancel(context.Background())
+		defer stopWorker()
+		if config.ExportJobs.Worker {
+			go exporting.NewWorker(services, logger, config.ExportJobs.TTL).Start(workerCtx)
+		}
+
+		// candidate record bulk actions
+		if config.CandidateRecordBulkJobs.Worker {
+			go bulkactions.NewWorker(services, logger).Start(workerCtx)
+		}
+
+		// webhook deliveries
+		if config.Webhooks.Worker && len(config.Webhooks.URLs) > 0 {
+			go webhooks.NewWorker(services, logger, config.Webhooks.Secret, config.Webhooks.MaxAttempts).Start(workerCtx)
+		}
+
+		// pushes to external services
+		if config.SyncJobs.Worker {
+			go syncing.NewWorker(services, logger, config.Frontend.URL).Start(workerCtx)
+		}
+
 		// setup server
 		addr := fmt.Sprintf("%s:%d", config.Host, config.Port)
 		server := graceful.WithDefaults(&http.Server{
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func init() {
+	rootCmd.AddCommand(syncORCIDWorks)
+}
+
+var syncORCIDWorks = &cobra.Command{
+	Use:   "sync-orcid-works [person-id]...",
+	Short: "Add a researcher's public publications to their ORCID record",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services := newServices()
+
+		if services.ORCIDWorkService == nil {
+			return errors.New("orcid works sync is not enabled")
+		}
+
+		for _, personID := range args {
+			if err := syncPersonORCIDWorks(services, personID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func syncPersonORCIDWorks(services *backends.Services, personID string) error {
+	person, err := services.PersonService.GetPerson(personID)
+	if err != nil {
+		return err
+	}
+	if person.ORCID == "" || person.ORCIDToken == "" {
+		return fmt.Errorf("person %s has no orcid token", personID)
+	}
+
+	// works pushed before put codes were stored in biblio
+	putCodes, err := knownORCIDWorks(services, person.ORCID)
+	if err != nil {
+		return err
+	}
+
+	repo := services.Repo
+
+	var n int
+
+	err = repo.EachPublicationWithContributor(person.ID, func(p *models.Publication) bool {
+		if p.Status != "public" {
+			return true
+		}
+
+		if putCode, ok := putCodes[p.ID]; ok && !p.InORCIDWorks(person.ORCID) {
+			p.ORCIDWork = append(p.ORCIDWork, models.PublicationORCIDWork{
+				ORCID:   person.ORCID,
+				PutCode: putCode,
+			})
+		}
+
+		changed, e := services.ORCIDWorkService.SyncPublication(p, person.ORCID)
+		if e != nil {
+			logger.Error("error syncing orcid works", "id", p.ID, "orcid", person.ORCID, "error", e)
+		}
+		if changed {
+			if err = repo.UpdatePublicationInPlace(p); err != nil {
+				return false
+			}
+		}
+
+		n++
+
+		return true
+	})
+
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("synced %d publications to orcid record %s", n, person.ORCID))
+
+	return nil
+}
+
+// knownORCIDWorks maps biblio publication id's to the put codes of works
+// that were already added to the ORCID record by us
+func knownORCIDWorks(services *backends.Services, orcidID string) (map[string]int, error) {
+	works, _, err := services.ORCIDClient.Works(orcidID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get works for orcid record %s: %w", orcidID, err)
+	}
+
+	putCodes := make(map[string]int)
+	for _, g := range works.Group {
+		if g.ExternalIDs == nil {
+			continue
+		}
+		for _, ws := range g.WorkSummary {
+			if ws.Source == nil || ws.Source.ClientID == nil || ws.Source.ClientID.Path != config.ORCID.ClientID {
+				continue
+			}
+			for _, id := range g.ExternalIDs.ExternalID {
+				if id.Type == "source-work-id" {
+					putCodes[id.Value] = ws.PutCode
+				}
+			}
+		}
+	}
+
+	return putCodes, nil
+}
@@ -1,61 +1,191 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ugent-library/biblio-backoffice/backends"
 	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/personmatching"
 	"github.com/ugent-library/biblio-backoffice/recordsources"
+	_ "github.com/ugent-library/biblio-backoffice/recordsources/arxiv"
+	_ "github.com/ugent-library/biblio-backoffice/recordsources/crossref"
 	_ "github.com/ugent-library/biblio-backoffice/recordsources/plato"
+	_ "github.com/ugent-library/biblio-backoffice/recordsources/pubmed"
 )
 
+// harvests overlap a day because some sources only filter by date
+const candidateRecordsCheckpointOverlap = 24 * time.Hour
+
 func init() {
 	rootCmd.AddCommand(updateCandidateRecords)
+	updateCandidateRecords.Flags().Bool("dry-run", false, "only report what would be added or updated")
+	updateCandidateRecords.Flags().Bool("full", false, "ignore the last harvest point of incremental sources")
 }
 
 var updateCandidateRecords = &cobra.Command{
-	Use:   "update-candidate-records",
+	Use:   "update-candidate-records [source]...",
 	Short: "Update candidate records",
+	Long: `Harvest new candidate records from the given sources, or from all sources if
+none are given. Candidate records that are still new are updated if their
+source metadata changed. Sources that support it only return records that
+changed since the last harvest.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		services := newServices()
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		full, _ := cmd.Flags().GetBool("full")
 
-		src, err := recordsources.New("plato")
-		if err != nil {
-			return err
+		names := args
+		if len(names) == 0 {
+			names = recordsources.Names()
 		}
 
-		err = src.GetRecords(context.Background(), func(srcRec recordsources.Record) error {
-			oldCandidateRec, err := services.Repo.GetCandidateRecordBySource(context.TODO(), srcRec.SourceName(), srcRec.SourceID())
+		sources := make(map[string]recordsources.Source, len(names))
+		for _, name := range names {
+			src, err := recordsources.New(name)
 			if err != nil {
-				if !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+			sources[name] = src
+		}
+
+		services := newServices()
+
+		var orcids []string
+		for _, name := range names {
+			src, ok := sources[name].(recordsources.PeopleSource)
+			if !ok {
+				continue
+			}
+			if orcids == nil {
+				orcids = []string{}
+				err := services.PersonORCIDService.EachPersonORCID(cmd.Context(), func(orcid string) error {
+					orcids = append(orcids, orcid)
+					return nil
+				})
+				if err != nil {
 					return err
 				}
 			}
+			src.SetORCIDs(orcids)
+		}
 
-			if oldCandidateRec != nil {
-				logger.Warn(fmt.Sprintf("skipping duplicate candidate record from source %s/%s: already found in %s", srcRec.SourceName(), srcRec.SourceID(), oldCandidateRec.ID))
-				return nil
+		for _, name := range names {
+			stats, err := updateCandidateRecordsFrom(cmd.Context(), services, name, sources[name], dryRun, full)
+			if errors.Is(err, recordsources.ErrNothingToHarvest) {
+				logger.Warn("skipping source", "source", name, "error", err)
+				continue
 			}
-
-			candidateRec, err := srcRec.ToCandidateRecord(services)
 			if err != nil {
 				return err
 			}
 
-			if err := services.Repo.AddCandidateRecord(context.TODO(), candidateRec); err != nil {
-				return err
+			msg := "updated candidate records"
+			if dryRun {
+				msg = "would update candidate records"
 			}
+			logger.Info(msg, "source", name, "new", stats.new, "updated", stats.updated, "skipped", stats.skipped)
+		}
+
+		return nil
+	},
+}
 
+type candidateRecordStats struct {
+	new     int
+	updated int
+	skipped int
+}
+
+func updateCandidateRecordsFrom(ctx context.Context, services *backends.Services, name string, src recordsources.Source, dryRun, full bool) (candidateRecordStats, error) {
+	stats := candidateRecordStats{}
+	checkpoint := "candidate_records:" + name
+
+	// records that change during this run are picked up by the next one
+	until := time.Now()
+
+	matcher := personmatching.NewMatcher(services.PersonSearchService)
+	match := func(id string, rec *models.CandidateRecord) {
+		p := &models.Publication{}
+		err := json.Unmarshal(rec.Metadata, p)
+		if err == nil {
+			err = matchCandidateRecord(ctx, services, matcher, id, p)
+		}
+		if err != nil {
+			logger.Warn("error matching candidate record", "id", id, "error", err)
+		}
+	}
+
+	cb := func(srcRec recordsources.Record) error {
+		oldCandidateRec, err := services.Repo.GetCandidateRecordBySource(ctx, srcRec.SourceName(), srcRec.SourceID())
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			return err
+		}
+
+		if oldCandidateRec != nil && (oldCandidateRec.Status != "new" || bytes.Equal(oldCandidateRec.SourceMetadata, srcRec.SourceMetadata())) {
+			stats.skipped++
 			return nil
-		})
+		}
 
+		if dryRun {
+			if oldCandidateRec != nil {
+				stats.updated++
+			} else {
+				stats.new++
+			}
+			return nil
+		}
+
+		candidateRec, err := srcRec.ToCandidateRecord(services)
 		if err != nil {
 			return err
 		}
 
+		if oldCandidateRec != nil {
+			if err := services.Repo.UpdateCandidateRecordSource(ctx, oldCandidateRec.ID, candidateRec); err != nil {
+				return err
+			}
+			logger.Info(fmt.Sprintf("updated candidate record %s from source %s/%s", oldCandidateRec.ID, srcRec.SourceName(), srcRec.SourceID()))
+			match(oldCandidateRec.ID, candidateRec)
+			stats.updated++
+			return nil
+		}
+
+		if err := services.Repo.AddCandidateRecord(ctx, candidateRec); err != nil {
+			return err
+		}
+		match(candidateRec.ID, candidateRec)
+		stats.new++
+
 		return nil
-	},
+	}
+
+	var since time.Time
+	var err error
+	incrementalSrc, incremental := src.(recordsources.IncrementalSource)
+	if incremental && !full {
+		if since, err = services.Repo.GetCheckpoint(ctx, checkpoint); err != nil {
+			return stats, err
+		}
+	}
+
+	if since.IsZero() {
+		err = src.GetRecords(ctx, cb)
+	} else {
+		err = incrementalSrc.GetRecordsSince(ctx, since.Add(-candidateRecordsCheckpointOverlap), cb)
+	}
+	if err != nil {
+		return stats, err
+	}
+
+	if dryRun {
+		return stats, nil
+	}
+
+	return stats, services.Repo.SetCheckpoint(ctx, checkpoint, until)
 }
@@ -2,19 +2,30 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"regexp"
 	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/ugent-library/oai-service/api/v1"
 
+	"github.com/ugent-library/biblio-backoffice/backends/datacite"
 	"github.com/ugent-library/biblio-backoffice/backends/mods36"
 	"github.com/ugent-library/biblio-backoffice/backends/oaidc"
 	"github.com/ugent-library/biblio-backoffice/models"
 )
 
+const (
+	oaiCheckpoint        = "oai"
+	oaiCheckpointOverlap = time.Minute
+)
+
+var reFP = regexp.MustCompile(`^FP[0-9]+$`)
+
 func init() {
 	rootCmd.AddCommand(updateOai)
+	updateOai.Flags().Bool("incremental", false, "only push records that changed since the last successful run")
 }
 
 type securitySource struct {
@@ -31,17 +42,18 @@ var updateOai = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		services := newServices()
 
-		reFP := regexp.MustCompile(`^FP[0-9]+$`)
+		ctx := context.TODO()
 
 		oaiEncoder := oaidc.New(services.Repo, config.Frontend.URL)
 		modsEncoder := mods36.New(services.Repo, config.Frontend.URL)
+		dataciteEncoder := datacite.NewEncoder(services.Repo, config.Frontend.URL)
 
 		client, err := api.NewClient(config.OAI.APIURL, &securitySource{config.OAI.APIKey})
 		if err != nil {
 			return err
 		}
 
-		err = client.AddMetadataFormat(context.TODO(), &api.AddMetadataFormatRequest{
+		err = client.AddMetadataFormat(ctx, &api.AddMetadataFormatRequest{
 			MetadataPrefix:    "oai_dc",
 			MetadataNamespace: "http://www.openarchives.org/OAI/2.0/oai_dc/",
 			Schema:            "http://www.openarchives.org/OAI/2.0/oai_dc.xsd",
@@ -49,7 +61,7 @@ var updateOai = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		err = client.AddMetadataFormat(context.TODO(), &api.AddMetadataFormatRequest{
+		err = client.AddMetadataFormat(ctx, &api.AddMetadataFormatRequest{
 			MetadataPrefix:    "mods_36",
 			MetadataNamespace: "http://www.loc.gov/mods/v3",
 			Schema:            "http://www.loc.gov/standards/mods/v3/mods-3-6.xsd",
@@ -57,8 +69,16 @@ var updateOai = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		err = client.AddMetadataFormat(ctx, &api.AddMetadataFormatRequest{
+			MetadataPrefix:    "datacite",
+			MetadataNamespace: datacite.Namespace,
+			Schema:            datacite.SchemaLocation,
+		})
+		if err != nil {
+			return err
+		}
 
-		err = client.AddSet(context.TODO(), &api.AddSetRequest{
+		err = client.AddSet(ctx, &api.AddSetRequest{
 			SetSpec: "fulltext",
 			SetName: "Biblio records with a fulltext file",
 		})
@@ -66,7 +86,7 @@ var updateOai = &cobra.Command{
 			return err
 		}
 
-		err = client.AddSet(context.TODO(), &api.AddSetRequest{
+		err = client.AddSet(ctx, &api.AddSetRequest{
 			SetSpec: "open_access",
 			SetName: "Biblio records with an open access fulltext file",
 		})
@@ -74,7 +94,7 @@ var updateOai = &cobra.Command{
 			return err
 		}
 
-		err = client.AddSet(context.TODO(), &api.AddSetRequest{
+		err = client.AddSet(ctx, &api.AddSetRequest{
 			SetSpec: "ec_fundedresources",
 			SetName: "OpenAire 2.0",
 		})
@@ -82,7 +102,7 @@ var updateOai = &cobra.Command{
 			return err
 		}
 
-		err = client.AddSet(context.TODO(), &api.AddSetRequest{
+		err = client.AddSet(ctx, &api.AddSetRequest{
 			SetSpec: "openaire",
 			SetName: "OpenAire 3.0",
 		})
@@ -90,7 +110,7 @@ var updateOai = &cobra.Command{
 			return err
 		}
 
-		err = client.AddSet(context.TODO(), &api.AddSetRequest{
+		err = client.AddSet(ctx, &api.AddSetRequest{
 			SetSpec: "driver",
 			SetName: "Driver",
 		})
@@ -98,7 +118,7 @@ var updateOai = &cobra.Command{
 			return err
 		}
 
-		err = client.AddSet(context.TODO(), &api.AddSetRequest{
+		err = client.AddSet(ctx, &api.AddSetRequest{
 			SetSpec: "iminds",
 			SetName: "All iMinds publications",
 		})
@@ -106,172 +126,281 @@ var updateOai = &cobra.Command{
 			return err
 		}
 
-		repo := newServices().Repo
-
-		// add all publications
-		repo.EachPublication(func(p *models.Publication) bool {
-			oaiID := "oai:archive.ugent.be:" + p.ID
-
-			if p.HasBeenPublic && p.Status != "public" {
-				for _, metadataPrefix := range []string{"oai_dc", "mods_36"} {
-					err = client.DeleteRecord(context.TODO(), &api.DeleteRecordRequest{
-						Identifier:     oaiID,
-						MetadataPrefix: metadataPrefix,
-					})
-					if err != nil {
-						logger.Error("cannot delete oai publication", "identifier", oaiID, "metadataPrefix", metadataPrefix)
-					}
-				}
-				return true
-			}
+		err = client.AddSet(ctx, &api.AddSetRequest{
+			SetSpec: "datasets",
+			SetName: "Biblio datasets",
+		})
+		if err != nil {
+			return err
+		}
 
-			if p.Status != "public" {
-				return true
-			}
+		repo := services.Repo
 
-			metadata, err := oaiEncoder.EncodePublication(p)
-			if err != nil {
-				logger.Error("cannot encode oai publication", "identifier", oaiID, "metadataPrefix", "oai_dc")
-				return true
-			}
+		incremental, _ := cmd.Flags().GetBool("incremental")
 
-			err = client.AddRecord(context.TODO(), &api.AddRecordRequest{
-				Identifier:     oaiID,
-				MetadataPrefix: "oai_dc",
-				Content:        string(metadata),
-			})
-			if err != nil {
-				logger.Error("cannot add oai publication", "identifier", oaiID, "metadataPrefix", "oai_dc")
-				return true
-			}
+		// everything up to now will be pushed, changes made during this run
+		// are picked up by the next one
+		until := time.Now()
 
-			metadata, err = modsEncoder.EncodePublication(p)
-			if err != nil {
-				logger.Error("cannot encode oai publication", "identifier", oaiID, "metadataPrefix", "mods_36")
-				return true
+		var since time.Time
+		if incremental {
+			if since, err = repo.GetCheckpoint(ctx, oaiCheckpoint); err != nil {
+				return err
 			}
-
-			err = client.AddRecord(context.TODO(), &api.AddRecordRequest{
-				Identifier:     oaiID,
-				MetadataPrefix: "mods_36",
-				Content:        string(metadata),
-			})
-			if err != nil {
-				logger.Error("cannot add oai publication", "identifier", oaiID, "metadataPrefix", "mods_36")
-				return true
+			if since.IsZero() {
+				logger.Info("no oai checkpoint found, pushing all records")
+			} else {
+				// snapshots committed just before the previous run ended can
+				// carry an earlier timestamp, adding a record twice is harmless
+				since = since.Add(-oaiCheckpointOverlap)
 			}
+		}
 
-			setSpecs := []string{}
+		pubStats := oaiStats{}
+		pubFn := func(p *models.Publication) bool {
+			pubStats.count(updateOAIPublication(ctx, client, oaiEncoder, modsEncoder, p))
+			return true
+		}
+		if since.IsZero() {
+			err = repo.EachPublication(pubFn)
+		} else {
+			err = repo.PublicationsBetween(since, until, pubFn)
+		}
+		if err != nil {
+			return err
+		}
 
-			for _, f := range p.File {
-				if f.Relation == "main_file" {
-					setSpecs = append(setSpecs, "fulltext")
-					break
-				}
-			}
-			for _, f := range p.File {
-				if f.Relation == "main_file" && f.AccessLevel == "info:eu-repo/semantics/openAccess" {
-					setSpecs = append(setSpecs, "open_access", "driver")
-					break
-				}
-			}
+		datasetStats := oaiStats{}
+		datasetFn := func(d *models.Dataset) bool {
+			datasetStats.count(updateOAIDataset(ctx, client, oaiEncoder, modsEncoder, dataciteEncoder, d))
+			return true
+		}
+		if since.IsZero() {
+			err = repo.EachDataset(datasetFn)
+		} else {
+			err = repo.DatasetsBetween(since, until, datasetFn)
+		}
+		if err != nil {
+			return err
+		}
 
-			for _, rp := range p.RelatedProjects {
-				if rp.Project.EUProject != nil && (rp.Project.EUProject.FrameworkProgramme == "H2020" || reFP.MatchString(rp.Project.EUProject.FrameworkProgramme)) {
-					setSpecs = append(setSpecs, "ec_fundedresources")
-					break
-				}
-			}
+		logger.Info("updated oai publications",
+			"added", pubStats.added, "deleted", pubStats.deleted, "skipped", pubStats.skipped, "failed", pubStats.failed)
+		logger.Info("updated oai datasets",
+			"added", datasetStats.added, "deleted", datasetStats.deleted, "skipped", datasetStats.skipped, "failed", datasetStats.failed)
 
-			if slices.Contains(setSpecs, "open_access") || slices.Contains(setSpecs, "ec_fundedresources") {
-				setSpecs = append(setSpecs, "openaire")
-			}
+		// failed records are retried on the next run
+		if pubStats.failed > 0 || datasetStats.failed > 0 {
+			logger.Warn("oai checkpoint not updated because of failed records")
+			return nil
+		}
 
-			for _, relOrg := range p.RelatedOrganizations {
-				if relOrg.OrganizationID == "IBBT" {
-					setSpecs = append(setSpecs, "iminds")
-					break
-				}
-			}
+		return repo.SetCheckpoint(ctx, oaiCheckpoint, until)
+	},
+}
 
-			err = client.AddItem(context.TODO(), &api.AddItemRequest{
-				Identifier: oaiID,
-				SetSpecs:   setSpecs,
-			})
-			if err != nil {
-				logger.Error("cannot add oai dataset item", "identifier", oaiID)
-				return true
-			}
+type oaiResult int
 
-			return true
-		})
+const (
+	oaiSkipped oaiResult = iota
+	oaiAdded
+	oaiDeleted
+	oaiFailed
+)
 
-		repo.EachDataset(func(d *models.Dataset) bool {
-			oaiID := "oai:archive.ugent.be:" + d.ID
-
-			// any record that has been part of the oai set but is now not visible anymore
-			// because it is returned or deleted is marked as deleted in oai
-			if d.HasBeenPublic && d.Status != "public" {
-				for _, metadataPrefix := range []string{"oai_dc", "mods_36"} {
-					err = client.DeleteRecord(context.TODO(), &api.DeleteRecordRequest{
-						Identifier:     oaiID,
-						MetadataPrefix: metadataPrefix,
-					})
-					if err != nil {
-						logger.Error("cannot delete oai dataset", "identifier", oaiID, "metadataPrefix", metadataPrefix)
-					}
-				}
-				return true
-			}
+type oaiStats struct {
+	added, deleted, skipped, failed int
+}
 
-			if d.Status != "public" {
-				return true
-			}
+func (s *oaiStats) count(r oaiResult) {
+	switch r {
+	case oaiAdded:
+		s.added++
+	case oaiDeleted:
+		s.deleted++
+	case oaiFailed:
+		s.failed++
+	default:
+		s.skipped++
+	}
+}
 
-			metadata, err := oaiEncoder.EncodeDataset(d)
-			if err != nil {
-				logger.Error("cannot encode oai dataset", "identifier", oaiID, "metadataPrefix", "oai_dc")
-				return true
-			}
+func deleteOAIRecord(ctx context.Context, client *api.Client, oaiID string, metadataPrefixes ...string) oaiResult {
+	res := oaiDeleted
+	for _, metadataPrefix := range metadataPrefixes {
+		err := client.DeleteRecord(ctx, &api.DeleteRecordRequest{
+			Identifier:     oaiID,
+			MetadataPrefix: metadataPrefix,
+		})
+		if err != nil {
+			logger.Error("cannot delete oai record", "identifier", oaiID, "metadataPrefix", metadataPrefix, "error", err)
+			res = oaiFailed
+		}
+	}
+	return res
+}
 
-			err = client.AddRecord(context.TODO(), &api.AddRecordRequest{
-				Identifier:     oaiID,
-				MetadataPrefix: "oai_dc",
-				Content:        string(metadata),
-			})
-			if err != nil {
-				logger.Error("cannot add oai dataset", "identifier", oaiID, "metadataPrefix", "oai_dc")
-				return true
-			}
+func updateOAIPublication(ctx context.Context, client *api.Client, oaiEncoder *oaidc.Encoder, modsEncoder *mods36.Encoder, p *models.Publication) oaiResult {
+	oaiID := "oai:archive.ugent.be:" + p.ID
+
+	if p.HasBeenPublic && p.Status != "public" {
+		return deleteOAIRecord(ctx, client, oaiID, "oai_dc", "mods_36")
+	}
+
+	if p.Status != "public" {
+		return oaiSkipped
+	}
+
+	metadata, err := oaiEncoder.EncodePublication(p)
+	if err != nil {
+		logger.Error("cannot encode oai publication", "identifier", oaiID, "metadataPrefix", "oai_dc")
+		return oaiFailed
+	}
+
+	err = client.AddRecord(ctx, &api.AddRecordRequest{
+		Identifier:     oaiID,
+		MetadataPrefix: "oai_dc",
+		Content:        string(metadata),
+	})
+	if err != nil {
+		logger.Error("cannot add oai publication", "identifier", oaiID, "metadataPrefix", "oai_dc")
+		return oaiFailed
+	}
+
+	metadata, err = modsEncoder.EncodePublication(p)
+	if err != nil {
+		logger.Error("cannot encode oai publication", "identifier", oaiID, "metadataPrefix", "mods_36")
+		return oaiFailed
+	}
+
+	err = client.AddRecord(ctx, &api.AddRecordRequest{
+		Identifier:     oaiID,
+		MetadataPrefix: "mods_36",
+		Content:        string(metadata),
+	})
+	if err != nil {
+		logger.Error("cannot add oai publication", "identifier", oaiID, "metadataPrefix", "mods_36")
+		return oaiFailed
+	}
+
+	setSpecs := []string{}
+
+	for _, f := range p.File {
+		if f.Relation == "main_file" {
+			setSpecs = append(setSpecs, "fulltext")
+			break
+		}
+	}
+	for _, f := range p.File {
+		if f.Relation == "main_file" && f.AccessLevel == "info:eu-repo/semantics/openAccess" {
+			setSpecs = append(setSpecs, "open_access", "driver")
+			break
+		}
+	}
 
-			metadata, err = modsEncoder.EncodeDataset(d)
-			if err != nil {
-				logger.Error("cannot encode oai dataset", "identifier", oaiID, "metadataPrefix", "mods_36")
-				return true
-			}
+	for _, rp := range p.RelatedProjects {
+		if rp.Project.EUProject != nil && (rp.Project.EUProject.FrameworkProgramme == "H2020" || reFP.MatchString(rp.Project.EUProject.FrameworkProgramme)) {
+			setSpecs = append(setSpecs, "ec_fundedresources")
+			break
+		}
+	}
 
-			err = client.AddRecord(context.TODO(), &api.AddRecordRequest{
-				Identifier:     oaiID,
-				MetadataPrefix: "mods_36",
-				Content:        string(metadata),
-			})
-			if err != nil {
-				logger.Error("cannot add oai dataset", "identifier", oaiID, "metadataPrefix", "mods_36")
-				return true
-			}
+	if slices.Contains(setSpecs, "open_access") || slices.Contains(setSpecs, "ec_fundedresources") {
+		setSpecs = append(setSpecs, "openaire")
+	}
 
-			err = client.AddItem(context.TODO(), &api.AddItemRequest{
-				Identifier: oaiID,
-				SetSpecs:   []string{},
-			})
-			if err != nil {
-				logger.Error("cannot add oai dataset item", "identifier", oaiID)
-				return true
-			}
+	for _, relOrg := range p.RelatedOrganizations {
+		if relOrg.OrganizationID == "IBBT" {
+			setSpecs = append(setSpecs, "iminds")
+			break
+		}
+	}
+
+	err = client.AddItem(ctx, &api.AddItemRequest{
+		Identifier: oaiID,
+		SetSpecs:   setSpecs,
+	})
+	if err != nil {
+		logger.Error("cannot add oai publication item", "identifier", oaiID)
+		return oaiFailed
+	}
+
+	return oaiAdded
+}
 
-			return true
+func updateOAIDataset(ctx context.Context, client *api.Client, oaiEncoder *oaidc.Encoder, modsEncoder *mods36.Encoder, dataciteEncoder *datacite.Encoder, d *models.Dataset) oaiResult {
+	oaiID := "oai:archive.ugent.be:" + d.ID
+
+	// any record that has been part of the oai set but is now not visible anymore
+	// because it is returned or deleted is marked as deleted in oai
+	if d.HasBeenPublic && d.Status != "public" {
+		return deleteOAIRecord(ctx, client, oaiID, "oai_dc", "mods_36", "datacite")
+	}
+
+	if d.Status != "public" {
+		return oaiSkipped
+	}
+
+	metadata, err := oaiEncoder.EncodeDataset(d)
+	if err != nil {
+		logger.Error("cannot encode oai dataset", "identifier", oaiID, "metadataPrefix", "oai_dc")
+		return oaiFailed
+	}
+
+	err = client.AddRecord(ctx, &api.AddRecordRequest{
+		Identifier:     oaiID,
+		MetadataPrefix: "oai_dc",
+		Content:        string(metadata),
+	})
+	if err != nil {
+		logger.Error("cannot add oai dataset", "identifier", oaiID, "metadataPrefix", "oai_dc")
+		return oaiFailed
+	}
+
+	metadata, err = modsEncoder.EncodeDataset(d)
+	if err != nil {
+		logger.Error("cannot encode oai dataset", "identifier", oaiID, "metadataPrefix", "mods_36")
+		return oaiFailed
+	}
+
+	err = client.AddRecord(ctx, &api.AddRecordRequest{
+		Identifier:     oaiID,
+		MetadataPrefix: "mods_36",
+		Content:        string(metadata),
+	})
+	if err != nil {
+		logger.Error("cannot add oai dataset", "identifier", oaiID, "metadataPrefix", "mods_36")
+		return oaiFailed
+	}
+
+	// datacite records need a doi, datasets without one are only available
+	// in the other formats
+	metadata, err = dataciteEncoder.EncodeDataset(d)
+	if errors.Is(err, datacite.ErrNoDOI) {
+		logger.Debug("skipping oai dataset without doi", "identifier", oaiID, "metadataPrefix", "datacite")
+	} else if err != nil {
+		logger.Error("cannot encode oai dataset", "identifier", oaiID, "metadataPrefix", "datacite")
+		return oaiFailed
+	} else {
+		err = client.AddRecord(ctx, &api.AddRecordRequest{
+			Identifier:     oaiID,
+			MetadataPrefix: "datacite",
+			Content:        string(metadata),
 		})
-
-		return nil
-	},
+		if err != nil {
+			logger.Error("cannot add oai dataset", "identifier", oaiID, "metadataPrefix", "datacite")
+			return oaiFailed
+		}
+	}
+
+	err = client.AddItem(ctx, &api.AddItemRequest{
+		Identifier: oaiID,
+		SetSpecs:   []string{"datasets"},
+	})
+	if err != nil {
+		logger.Error("cannot add oai dataset item", "identifier", oaiID)
+		return oaiFailed
+	}
+
+	return oaiAdded
 }
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/ugent-library/biblio-backoffice/webhooks"
+)
+
+func init() {
+	rootCmd.AddCommand(webhooksCmd)
+	webhooksCmd.AddCommand(webhookDeliveriesCmd)
+	webhooksCmd.AddCommand(webhookRedeliverCmd)
+	webhooksCmd.AddCommand(webhookReceiveCmd)
+	webhookDeliveriesCmd.Flags().String("status", "", "only show deliveries with this status (pending, running, delivered or failed)")
+	webhookDeliveriesCmd.Flags().Int("limit", 100, "number of deliveries to show")
+	webhookReceiveCmd.Flags().String("addr", "localhost:3999", "address to listen on")
+}
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Webhook commands",
+}
+
+var webhookDeliveriesCmd = &cobra.Command{
+	Use:   "deliveries",
+	Short: "Show the webhook delivery log",
+	Long: `
+	Outputs the most recent webhook deliveries as JSONL formatted records,
+	newest first, with the number of attempts and the last response or error.
+
+		$ ./biblio-backoffice webhooks deliveries --status failed > failed.jsonl
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status, _ := cmd.Flags().GetString("status")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		services := newServices()
+
+		deliveries, err := services.Repo.GetWebhookDeliveries(cmd.Context(), status, limit)
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		for _, d := range deliveries {
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+var webhookRedeliverCmd = &cobra.Command{
+	Use:   "redeliver [id]...",
+	Short: "Send webhook deliveries again",
+	Long: `
+	Queues deliveries again with a fresh number of attempts, for example after
+	a downstream system was down for longer than the retries last.
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services := newServices()
+
+		for _, id := range args {
+			if err := services.Repo.RequeueWebhookDelivery(cmd.Context(), id); err != nil {
+				return err
+			}
+			logger.Info(fmt.Sprintf("queued webhook delivery %s", id))
+		}
+
+		return nil
+	},
+}
+
+var webhookReceiveCmd = &cobra.Command{
+	Use:   "receive",
+	Short: "Run a local webhook receiver",
+	Long: `
+	Starts an HTTP server that prints every webhook it receives to stdout and
+	checks the signature against BIBLIO_BACKOFFICE_WEBHOOKS_SECRET. Point
+	BIBLIO_BACKOFFICE_WEBHOOKS_URLS to it to try out webhooks locally.
+
+		$ ./biblio-backoffice webhooks receive --addr localhost:3999
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr, _ := cmd.Flags().GetString("addr")
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if config.Webhooks.Secret != "" && !webhooks.Verify(config.Webhooks.Secret, body, r.Header.Get(webhooks.SignatureHeader)) {
+				logger.Warn("invalid webhook signature", "event", r.Header.Get(webhooks.EventHeader), "id", r.Header.Get(webhooks.EventIDHeader))
+				http.Error(w, "invalid signature", http.StatusUnauthorized)
+				return
+			}
+			logger.Info("received webhook", "event", r.Header.Get(webhooks.EventHeader), "id", r.Header.Get(webhooks.EventIDHeader))
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", body)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		logger.Info(fmt.Sprintf("receiving webhooks at http://%s", addr))
+		return http.ListenAndServe(addr, handler)
+	},
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	api "github.com/ugent-library/biblio-backoffice/api/v1"
+	cnx "github.com/ugent-library/biblio-backoffice/client/connection"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	AddPublicationCommentCmd.Flags().String("user-id", "", "id of the person writing the comment")
+	AddPublicationCommentCmd.Flags().String("body", "", "text of the comment")
+	AddPublicationCommentCmd.Flags().String("visibility", "shared", "shared or curators")
+	AddPublicationCommentCmd.Flags().String("parent-id", "", "id of the comment to reply to")
+	PublicationCmd.AddCommand(AddPublicationCommentCmd)
+}
+
+var AddPublicationCommentCmd = &cobra.Command{
+	Use:   "add-comment [id]",
+	Short: "Add a comment to a publication",
+	Long: `
+	Add a comment to a publication or reply to an existing comment.
+
+	Comments with visibility shared are visible to everyone who can see the
+	publication, comments with visibility curators only to curators. Replies to a
+	curator comment are always only visible to curators.
+
+	The comment is written in the name of the given user id. Only API clients
+	with the admin role can add comments.
+
+	Outputs the comment as a JSONL formatted record or an error message.
+
+		$ ./biblio-backoffice publication add-comment [ID] --user-id [USER ID] --body "[BODY]" > comment.jsonl
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: AddPublicationComment,
+}
+
+func AddPublicationComment(cmd *cobra.Command, args []string) error {
+	userID, _ := cmd.Flags().GetString("user-id")
+	body, _ := cmd.Flags().GetString("body")
+	visibility, _ := cmd.Flags().GetString("visibility")
+	parentID, _ := cmd.Flags().GetString("parent-id")
+
+	return cnx.Handle(config, func(c api.BiblioClient) error {
+		req := &api.AddPublicationCommentRequest{
+			Id:         args[0],
+			ParentId:   parentID,
+			UserId:     userID,
+			Body:       body,
+			Visibility: visibility,
+		}
+		res, err := c.AddPublicationComment(context.Background(), req)
+
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				return errors.New(st.Message())
+			}
+
+			return err
+		}
+
+		if ge := res.GetError(); ge != nil {
+			sre := status.FromProto(ge)
+			cmd.Printf("%s", sre.Message())
+		} else {
+			cmd.Printf("%s", res.GetComment().GetPayload())
+		}
+
+		return nil
+	})
+}
@@ -14,6 +14,7 @@ import (
 )
 
 func init() {
+	AddPublicationsCmd.Flags().Bool("check-duplicates", false, "skip publications that look like existing publications")
 	PublicationCmd.AddCommand(AddPublicationsCmd)
 }
 
@@ -30,11 +31,19 @@ var AddPublicationsCmd = &cobra.Command{
 		$ ./biblio-backoffice publication add < file.jsonl
 		stored and indexed publication [ID] at line [LINENO]
 		failed to validate publication [ID] at line [LINENO]: [MSG]
+
+	With --check-duplicates, publications with the same DOI, PubMed, arXiv or
+	Web of Science id or a near identical title as an existing publication are
+	skipped:
+
+		publication [ID] at line [LINENO] has possible duplicates: [ID] ([REASONS])
 	`,
 	RunE: AddPublications,
 }
 
 func AddPublications(cmd *cobra.Command, args []string) error {
+	checkDuplicates, _ := cmd.Flags().GetBool("check-duplicates")
+
 	return cnx.Handle(config, func(c api.BiblioClient) error {
 		stream, err := c.AddPublications(context.Background())
 		if err != nil {
@@ -88,7 +97,7 @@ func AddPublications(cmd *cobra.Command, args []string) error {
 				Payload: line,
 			}
 
-			req := &api.AddPublicationsRequest{Publication: p}
+			req := &api.AddPublicationsRequest{Publication: p, CheckDuplicates: checkDuplicates}
 			if err := stream.Send(req); err != nil {
 				return fmt.Errorf("could not send publication to the server: %w", err)
 			}
@@ -13,6 +13,7 @@ import (
 )
 
 func init() {
+	GetAllDatasetsCmd.Flags().String("at", "", "get the datasets as they were at this date or RFC3339 timestamp")
 	DatasetCmd.AddCommand(GetAllDatasetsCmd)
 }
 
@@ -24,13 +25,23 @@ var GetAllDatasetsCmd = &cobra.Command{
 	The stream will be outputted to stdout.
 
 		$ ./biblio-backoffice dataset get-all > datasets.jsonl
+
+	Use --at to retrieve the datasets as they were at a given date (midnight UTC)
+	or RFC3339 timestamp. Records that didn't exist yet are left out.
+
+		$ ./biblio-backoffice dataset get-all --at 2023-12-31T23:59:59Z > datasets.jsonl
 	`,
 	RunE: GetAllDatasets,
 }
 
 func GetAllDatasets(cmd *cobra.Command, args []string) error {
+	at, err := parseAt(cmd)
+	if err != nil {
+		return err
+	}
+
 	return cnx.Handle(config, func(c api.BiblioClient) error {
-		req := &api.GetAllDatasetsRequest{}
+		req := &api.GetAllDatasetsRequest{At: at}
 		stream, err := c.GetAllDatasets(context.Background(), req)
 		if err != nil {
 			return fmt.Errorf("error while reading stream: %v", err)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/spf13/cobra"
 	api "github.com/ugent-library/biblio-backoffice/api/v1"
@@ -13,6 +14,7 @@ import (
 )
 
 func init() {
+	GetAllPublicationsCmd.Flags().String("at", "", "get the publications as they were at this date or RFC3339 timestamp")
 	PublicationCmd.AddCommand(GetAllPublicationsCmd)
 }
 
@@ -24,13 +26,23 @@ var GetAllPublicationsCmd = &cobra.Command{
 	The stream will be outputted to stdout.
 
 		$ ./biblio-backoffice publication get-all > publications.jsonl
+
+	Use --at to retrieve the publications as they were at a given date (midnight UTC)
+	or RFC3339 timestamp. Records that didn't exist yet are left out.
+
+		$ ./biblio-backoffice publication get-all --at 2023-12-31T23:59:59Z > publications.jsonl
 	`,
 	RunE: GetAllPublications,
 }
 
 func GetAllPublications(cmd *cobra.Command, args []string) error {
+	at, err := parseAt(cmd)
+	if err != nil {
+		return err
+	}
+
 	return cnx.Handle(config, func(c api.BiblioClient) error {
-		req := &api.GetAllPublicationsRequest{}
+		req := &api.GetAllPublicationsRequest{At: at}
 		stream, err := c.GetAllPublications(context.Background(), req)
 		if err != nil {
 			return fmt.Errorf("error while reading stream: %v", err)
@@ -63,3 +75,20 @@ func GetAllPublications(cmd *cobra.Command, args []string) error {
 		return nil
 	})
 }
+
+// parseAt reads the --at flag as a date or RFC3339 timestamp and returns it as
+// an RFC3339 timestamp
+func parseAt(cmd *cobra.Command) (string, error) {
+	at, _ := cmd.Flags().GetString("at")
+	if at == "" {
+		return "", nil
+	}
+	if t, err := time.Parse(time.DateOnly, at); err == nil {
+		return t.Format(time.RFC3339), nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return "", fmt.Errorf("--at should be a date or RFC3339 timestamp: %w", err)
+	}
+	return t.Format(time.RFC3339), nil
+}
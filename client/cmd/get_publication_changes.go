@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+	api "github.com/ugent-library/biblio-backoffice/api/v1"
+	cnx "github.com/ugent-library/biblio-backoffice/client/connection"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	PublicationCmd.AddCommand(GetPublicationChangesCmd)
+}
+
+var GetPublicationChangesCmd = &cobra.Command{
+	Use:   "get-changes [id]",
+	Short: "Get publication changes",
+	Long: `
+	Retrieve the changes made by each snapshot of a single publication as a stream of
+	JSONL formatted records, newest first.
+	The stream will be outputted to stdout.
+
+		$ ./biblio-backoffice publication get-changes [ID] > changes.jsonl
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: GetPublicationChanges,
+}
+
+func GetPublicationChanges(cmd *cobra.Command, args []string) error {
+	return cnx.Handle(config, func(c api.BiblioClient) error {
+		req := &api.GetPublicationChangesRequest{Id: args[0]}
+		stream, err := c.GetPublicationChanges(context.Background(), req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+
+			// return gRPC level error
+			if err != nil {
+				if st, ok := status.FromError(err); ok {
+					return errors.New(st.Message())
+				}
+
+				return err
+			}
+
+			// Application level error
+			if ge := res.GetError(); ge != nil {
+				sre := status.FromProto(ge)
+				cmd.Printf("%s\n", sre.Message())
+			}
+
+			if rr := res.GetEntry(); rr != nil {
+				cmd.Printf("%s\n", rr.GetPayload())
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+	api "github.com/ugent-library/biblio-backoffice/api/v1"
+	cnx "github.com/ugent-library/biblio-backoffice/client/connection"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	PublicationCmd.AddCommand(GetPublicationCommentsCmd)
+}
+
+var GetPublicationCommentsCmd = &cobra.Command{
+	Use:   "get-comments [id]",
+	Short: "Get publication comments",
+	Long: `
+	Retrieve all comments on a single publication, including comments only visible to
+	curators, as a stream of JSONL formatted records, oldest first.
+	The stream will be outputted to stdout.
+
+		$ ./biblio-backoffice publication get-comments [ID] > comments.jsonl
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: GetPublicationComments,
+}
+
+func GetPublicationComments(cmd *cobra.Command, args []string) error {
+	return cnx.Handle(config, func(c api.BiblioClient) error {
+		req := &api.GetPublicationCommentsRequest{Id: args[0]}
+		stream, err := c.GetPublicationComments(context.Background(), req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+
+			// return gRPC level error
+			if err != nil {
+				if st, ok := status.FromError(err); ok {
+					return errors.New(st.Message())
+				}
+
+				return err
+			}
+
+			// Application level error
+			if ge := res.GetError(); ge != nil {
+				sre := status.FromProto(ge)
+				cmd.Printf("%s\n", sre.Message())
+			}
+
+			if rr := res.GetComment(); rr != nil {
+				cmd.Printf("%s\n", rr.GetPayload())
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	api "github.com/ugent-library/biblio-backoffice/api/v1"
+	cnx "github.com/ugent-library/biblio-backoffice/client/connection"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	PublicationCmd.AddCommand(MergePublicationsCmd)
+}
+
+var MergePublicationsCmd = &cobra.Command{
+	Use:   "merge [survivor id] [merged id]",
+	Short: "Merge two publications",
+	Long: `
+	Merge a publication into another publication.
+
+	The contributors, files, identifiers, links, projects, departments and
+	datasets of the merged publication are added to the survivor. The merged
+	publication is deleted and redirects to the survivor. The history of both
+	publications is kept.
+
+	Outputs the merged survivor as a JSONL formatted record or an error message.
+
+		$ ./biblio-backoffice publication merge [SURVIVOR ID] [MERGED ID] > publication.jsonl
+	`,
+	Args: cobra.ExactArgs(2),
+	RunE: MergePublications,
+}
+
+func MergePublications(cmd *cobra.Command, args []string) error {
+	return cnx.Handle(config, func(c api.BiblioClient) error {
+		req := &api.MergePublicationsRequest{SurvivorId: args[0], MergedId: args[1]}
+		res, err := c.MergePublications(context.Background(), req)
+
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				return errors.New(st.Message())
+			}
+
+			return err
+		}
+
+		if ge := res.GetError(); ge != nil {
+			sre := status.FromProto(ge)
+			cmd.Printf("%s", sre.Message())
+		} else {
+			cmd.Printf("%s", res.GetPublication().GetPayload())
+		}
+
+		return nil
+	})
+}
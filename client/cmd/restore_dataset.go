@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	api "github.com/ugent-library/biblio-backoffice/api/v1"
+	cnx "github.com/ugent-library/biblio-backoffice/client/connection"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	RestoreDatasetCmd.Flags().StringP("reason", "r", "", "why the earlier version is restored")
+	DatasetCmd.AddCommand(RestoreDatasetCmd)
+}
+
+var RestoreDatasetCmd = &cobra.Command{
+	Use:   "restore [id] [snapshot-id]",
+	Short: "Restore a dataset to an earlier version",
+	Long: `
+	Restore a dataset to an earlier snapshot.
+
+	A new snapshot is created with the content of the earlier snapshot. The
+	status and lock of the current version are kept. A reason is required and
+	is shown in the history of the dataset.
+
+	Outputs the restored dataset as a JSONL formatted record or an error message.
+
+		$ ./biblio-backoffice dataset restore [ID] [SNAPSHOT ID] --reason "[REASON]" > dataset.jsonl
+	`,
+	Args: cobra.ExactArgs(2),
+	RunE: RestoreDataset,
+}
+
+func RestoreDataset(cmd *cobra.Command, args []string) error {
+	reason, _ := cmd.Flags().GetString("reason")
+
+	return cnx.Handle(config, func(c api.BiblioClient) error {
+		req := &api.RestoreDatasetRequest{Id: args[0], SnapshotId: args[1], Reason: reason}
+		res, err := c.RestoreDataset(context.Background(), req)
+
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				return errors.New(st.Message())
+			}
+
+			return err
+		}
+
+		if ge := res.GetError(); ge != nil {
+			sre := status.FromProto(ge)
+			cmd.Printf("%s", sre.Message())
+		} else {
+			cmd.Printf("%s", res.GetDataset().GetPayload())
+		}
+
+		return nil
+	})
+}
@@ -40,7 +40,7 @@ func RequireViewCandidateRecord(repo *repositories.Repo) func(http.Handler) http
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			c := Get(r)
 
-			if !repo.CanViewPublication(c.User, GetCandidateRecord(r).Publication) {
+			if !repo.CanViewCandidateRecord(c.User, GetCandidateRecord(r)) {
 				c.HandleError(w, r, httperror.Forbidden)
 				return
 			}
@@ -55,7 +55,7 @@ func RequireEditCandidateRecord(repo *repositories.Repo) func(http.Handler) http
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			c := Get(r)
 
-			if !repo.CanEditPublication(c.User, GetCandidateRecord(r).Publication) {
+			if !repo.CanEditCandidateRecord(c.User, GetCandidateRecord(r)) {
 				c.HandleError(w, r, httperror.Forbidden)
 				return
 			}
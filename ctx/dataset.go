@@ -2,6 +2,7 @@ package ctx
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -10,12 +11,23 @@ import (
 	"github.com/ugent-library/httperror"
 )
 
-const DatasetKey = contextKey("dataset")
+const (
+	DatasetKey      = contextKey("dataset")
+	DatasetDraftKey = contextKey("dataset_draft")
+)
 
+// GetDataset returns the working copy of the dataset, this includes the
+// changes in its pending draft if there is one
 func GetDataset(r *http.Request) *models.Dataset {
 	return r.Context().Value(DatasetKey).(*models.Dataset)
 }
 
+// HasDatasetDraft reports whether the dataset has unpublished changes
+func HasDatasetDraft(r *http.Request) bool {
+	hasDraft, _ := r.Context().Value(DatasetDraftKey).(bool)
+	return hasDraft
+}
+
 func SetDataset(repo *repositories.Repo) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -29,7 +41,22 @@ func SetDataset(repo *repositories.Repo) func(http.Handler) http.Handler {
 				return
 			}
 
+			// edits to public datasets are kept in a draft
+			hasDraft := false
+			if dataset.Status == "public" {
+				draft, err := repo.GetDatasetDraft(datasetId)
+				if err != nil && !errors.Is(err, models.ErrNotFound) {
+					c.HandleError(w, r, err)
+					return
+				}
+				if draft != nil {
+					dataset = draft
+					hasDraft = true
+				}
+			}
+
 			ctx := context.WithValue(r.Context(), DatasetKey, dataset)
+			ctx = context.WithValue(ctx, DatasetDraftKey, hasDraft)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
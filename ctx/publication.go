@@ -2,7 +2,9 @@ package ctx
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/ugent-library/biblio-backoffice/models"
@@ -10,12 +12,23 @@ import (
 	"github.com/ugent-library/httperror"
 )
 
-const PublicationKey = contextKey("publication")
+const (
+	PublicationKey      = contextKey("publication")
+	PublicationDraftKey = contextKey("publication_draft")
+)
 
+// GetPublication returns the working copy of the publication, this includes
+// the changes in its pending draft if there is one
 func GetPublication(r *http.Request) *models.Publication {
 	return r.Context().Value(PublicationKey).(*models.Publication)
 }
 
+// HasPublicationDraft reports whether the publication has unpublished changes
+func HasPublicationDraft(r *http.Request) bool {
+	hasDraft, _ := r.Context().Value(PublicationDraftKey).(bool)
+	return hasDraft
+}
+
 func SetPublication(repo *repositories.Repo) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -29,7 +42,37 @@ func SetPublication(repo *repositories.Repo) func(http.Handler) http.Handler {
 				return
 			}
 
+			// merged publications redirect to the publication they were merged into
+			if publication.Status == "deleted" && r.Method == http.MethodGet {
+				targetID, err := repo.GetPublicationRedirect(r.Context(), publicationId)
+				if err != nil && !errors.Is(err, models.ErrNotFound) {
+					c.HandleError(w, r, err)
+					return
+				}
+				if targetID != "" {
+					u := *r.URL
+					u.Path = strings.Replace(u.Path, "/publication/"+publicationId, "/publication/"+targetID, 1)
+					http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+					return
+				}
+			}
+
+			// edits to public publications are kept in a draft
+			hasDraft := false
+			if publication.Status == "public" {
+				draft, err := repo.GetPublicationDraft(publicationId)
+				if err != nil && !errors.Is(err, models.ErrNotFound) {
+					c.HandleError(w, r, err)
+					return
+				}
+				if draft != nil {
+					publication = draft
+					hasDraft = true
+				}
+			}
+
 			ctx := context.WithValue(r.Context(), PublicationKey, publication)
+			ctx = context.WithValue(ctx, PublicationDraftKey, hasDraft)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
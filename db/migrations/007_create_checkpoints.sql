@@ -0,0 +1,9 @@
+create table checkpoints (
+    name text primary key check (name <> ''),
+    value timestamptz not null,
+    date_updated timestamptz not null default now()
+);
+
+---- create above / drop below ----
+
+drop table checkpoints cascade;
@@ -0,0 +1,24 @@
+create table export_jobs (
+    id text primary key,
+    user_id text not null,
+    entity text not null check (entity in ('publication', 'dataset')),
+    format text not null,
+    search_args jsonb not null,
+    status text not null default 'pending' check (status in ('pending', 'running', 'done', 'failed')),
+    processed int not null default 0,
+    total int not null default 0,
+    file_id text,
+    file_name text,
+    content_type text,
+    error text,
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now(),
+    date_expires timestamptz
+);
+
+create index export_jobs_user_id_key on export_jobs (user_id, date_created);
+create index export_jobs_status_key on export_jobs (status, date_created);
+
+---- create above / drop below ----
+
+drop table export_jobs cascade;
@@ -0,0 +1,17 @@
+create table saved_searches (
+    id text primary key,
+    user_id text not null,
+    name text not null check (name <> ''),
+    search_args jsonb not null,
+    digest boolean not null default false,
+    last_digest_at timestamptz,
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now()
+);
+
+create index saved_searches_user_id_key on saved_searches (user_id, name);
+create index saved_searches_digest_key on saved_searches (digest) where digest;
+
+---- create above / drop below ----
+
+drop table saved_searches cascade;
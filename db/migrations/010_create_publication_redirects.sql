@@ -0,0 +1,12 @@
+create table publication_redirects (
+    id text primary key,
+    target_id text not null,
+    user_id text,
+    date_created timestamptz not null default now()
+);
+
+create index publication_redirects_target_id_key on publication_redirects (target_id);
+
+---- create above / drop below ----
+
+drop table publication_redirects cascade;
@@ -0,0 +1,15 @@
+create table snapshot_restores (
+    snapshot_id text primary key,
+    record_type text not null,
+    record_id text not null,
+    restored_snapshot_id text not null,
+    reason text not null check (reason <> ''),
+    user_id text,
+    date_created timestamptz not null default now()
+);
+
+create index snapshot_restores_record_key on snapshot_restores (record_type, record_id);
+
+---- create above / drop below ----
+
+drop table snapshot_restores cascade;
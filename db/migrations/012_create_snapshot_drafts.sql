@@ -0,0 +1,13 @@
+create table snapshot_drafts (
+    store text not null,
+    id text not null,
+    base_snapshot_id text not null,
+    data jsonb not null,
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now(),
+    primary key (store, id)
+);
+
+---- create above / drop below ----
+
+drop table snapshot_drafts cascade;
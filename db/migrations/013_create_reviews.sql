@@ -0,0 +1,23 @@
+create table reviews (
+    id text primary key,
+    record_type text not null check (record_type in ('publication', 'dataset')),
+    record_id text not null,
+    status text not null check (status in ('pending', 'approved', 'changes_requested')),
+    reason text not null check (reason in ('published', 'edited')),
+    faculty_ids text[] not null default '{}',
+    user_id text,
+    assignee_id text,
+    reviewer_id text,
+    comment text,
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now()
+);
+
+-- a record has at most one open review
+create unique index reviews_open_record_key on reviews (record_type, record_id) where status <> 'approved';
+create index reviews_status_idx on reviews (status);
+create index reviews_assignee_id_idx on reviews (assignee_id);
+
+---- create above / drop below ----
+
+drop table reviews cascade;
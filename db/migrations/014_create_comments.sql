@@ -0,0 +1,17 @@
+create table comments (
+    id text primary key,
+    record_type text not null check (record_type in ('publication', 'dataset')),
+    record_id text not null,
+    parent_id text references comments (id) on delete cascade,
+    user_id text not null,
+    body text not null,
+    visibility text not null check (visibility in ('shared', 'curators')),
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now()
+);
+
+create index comments_record_idx on comments (record_type, record_id, date_created);
+
+---- create above / drop below ----
+
+drop table comments cascade;
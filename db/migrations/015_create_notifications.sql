@@ -0,0 +1,21 @@
+create table notifications (
+    id text primary key,
+    person_id text not null,
+    kind text not null check (kind in ('locked', 'withdrawn', 'published', 'candidate_record', 'proxy_added')),
+    record_type text,
+    record_id text,
+    snapshot_id text,
+    title text not null default '',
+    actor_id text,
+    date_created timestamptz not null default now(),
+    date_read timestamptz
+);
+
+create index notifications_person_id_idx on notifications (person_id, date_created desc);
+create index notifications_unread_idx on notifications (person_id) where date_read is null;
+-- listeners can see the same snapshot more than once
+create unique index notifications_snapshot_key on notifications (person_id, kind, snapshot_id) where snapshot_id is not null;
+
+---- create above / drop below ----
+
+drop table notifications cascade;
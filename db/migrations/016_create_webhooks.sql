@@ -0,0 +1,33 @@
+create table webhook_deliveries (
+    id text primary key,
+    event_id text not null,
+    event text not null,
+    record_type text not null check (record_type in ('publication', 'dataset')),
+    record_id text not null,
+    url text not null,
+    payload jsonb not null,
+    status text not null default 'pending' check (status in ('pending', 'running', 'delivered', 'failed')),
+    attempts int not null default 0,
+    response_status int,
+    error text,
+    next_attempt timestamptz not null default now(),
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now()
+);
+
+create index webhook_deliveries_queue_idx on webhook_deliveries (status, next_attempt);
+create index webhook_deliveries_record_idx on webhook_deliveries (record_type, record_id, date_created);
+
+-- the last status sent for each record, to tell publish, withdraw and delete
+-- events apart from updates
+create table webhook_record_states (
+    record_type text not null,
+    record_id text not null,
+    status text not null,
+    primary key (record_type, record_id)
+);
+
+---- create above / drop below ----
+
+drop table webhook_record_states cascade;
+drop table webhook_deliveries cascade;
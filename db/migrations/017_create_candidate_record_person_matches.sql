@@ -0,0 +1,20 @@
+create table candidate_record_person_matches (
+    id text primary key,
+    candidate_record_id text not null references candidate_records (id) on delete cascade,
+    role text not null check (role in ('author', 'editor', 'supervisor')),
+    position int not null,
+    person_id text not null,
+    score double precision not null,
+    reasons text[] not null default '{}',
+    status text not null default 'proposed' check (status in ('proposed', 'confirmed', 'rejected')),
+    status_person_id text,
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now(),
+    unique (candidate_record_id, role, position, person_id)
+);
+
+create index candidate_record_person_matches_person_id_idx on candidate_record_person_matches (person_id);
+
+---- create above / drop below ----
+
+drop table candidate_record_person_matches cascade;
@@ -0,0 +1,34 @@
+alter table candidate_records
+    add column status_reason text null,
+    add column assigned_person_id text null;
+
+create index candidate_records_assigned_person_id_idx on candidate_records (assigned_person_id);
+
+create table candidate_record_bulk_jobs (
+    id text primary key,
+    user_id text not null,
+    action text not null check (action in ('import', 'reject', 'assign')),
+    candidate_record_ids text[] not null,
+    reason text,
+    person_id text,
+    status text not null default 'pending' check (status in ('pending', 'running', 'done', 'failed')),
+    processed int not null default 0,
+    skipped int not null default 0,
+    total int not null default 0,
+    error text,
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now()
+);
+
+create index candidate_record_bulk_jobs_user_id_key on candidate_record_bulk_jobs (user_id, date_created);
+create index candidate_record_bulk_jobs_status_key on candidate_record_bulk_jobs (status, date_created);
+
+---- create above / drop below ----
+
+drop table candidate_record_bulk_jobs cascade;
+
+drop index candidate_records_assigned_person_id_idx;
+
+alter table candidate_records
+    drop column status_reason,
+    drop column assigned_person_id;
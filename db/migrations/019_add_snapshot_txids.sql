@@ -0,0 +1,18 @@
+-- the transaction that added a snapshot, the change feed only moves past a
+-- transaction once it is no longer running. existing snapshots get 0.
+alter table publications add column txid bigint not null default 0;
+alter table publications alter column txid set default txid_current();
+
+alter table datasets add column txid bigint not null default 0;
+alter table datasets alter column txid set default txid_current();
+
+create index publications_txid_idx on publications (txid, snapshot_id) where date_until is null;
+create index datasets_txid_idx on datasets (txid, snapshot_id) where date_until is null;
+
+---- create above / drop below ----
+
+drop index publications_txid_idx;
+drop index datasets_txid_idx;
+
+alter table publications drop column txid;
+alter table datasets drop column txid;
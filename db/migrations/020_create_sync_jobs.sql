@@ -0,0 +1,21 @@
+-- records that need to be pushed to an external service, at most one job per
+-- record and kind. a record that changes while its job is running is synced
+-- again afterwards.
+create table sync_jobs (
+    kind text not null,
+    record_id text not null,
+    status text not null default 'pending' check (status in ('pending', 'running', 'failed')),
+    requeue boolean not null default false,
+    attempts int not null default 0,
+    error text,
+    next_attempt timestamptz not null default now(),
+    date_created timestamptz not null default now(),
+    date_updated timestamptz not null default now(),
+    primary key (kind, record_id)
+);
+
+create index sync_jobs_queue_idx on sync_jobs (status, next_attempt);
+
+---- create above / drop below ----
+
+drop table sync_jobs cascade;
@@ -9,17 +9,41 @@ import (
 )
 
 type CandidateRecord struct {
-	ID             string
-	SourceName     string
-	SourceID       string
-	SourceMetadata []byte
-	Type           string
-	Status         string
-	Metadata       []byte
-	DateCreated    pgtype.Timestamptz
-	StatusDate     pgtype.Timestamptz
-	StatusPersonID *string
-	ImportedID     *string
+	ID               string
+	SourceName       string
+	SourceID         string
+	SourceMetadata   []byte
+	Type             string
+	Status           string
+	Metadata         []byte
+	DateCreated      pgtype.Timestamptz
+	StatusDate       pgtype.Timestamptz
+	StatusPersonID   *string
+	ImportedID       *string
+	StatusReason     *string
+	AssignedPersonID *string
+}
+
+type CandidateRecordBulkJob struct {
+	ID                 string
+	UserID             string
+	Action             string
+	CandidateRecordIds []string
+	Reason             *string
+	PersonID           *string
+	Status             string
+	Processed          int32
+	Skipped            int32
+	Total              int32
+	Error              *string
+	DateCreated        pgtype.Timestamptz
+	DateUpdated        pgtype.Timestamptz
+}
+
+type Checkpoint struct {
+	Name        string
+	Value       pgtype.Timestamptz
+	DateUpdated pgtype.Timestamptz
 }
 
 type Dataset struct {
@@ -30,6 +54,24 @@ type Dataset struct {
 	DateUntil  pgtype.Timestamptz
 }
 
+type ExportJob struct {
+	ID          string
+	UserID      string
+	Entity      string
+	Format      string
+	SearchArgs  []byte
+	Status      string
+	Processed   int32
+	Total       int32
+	FileID      *string
+	FileName    *string
+	ContentType *string
+	Error       *string
+	DateCreated pgtype.Timestamptz
+	DateUpdated pgtype.Timestamptz
+	DateExpires pgtype.Timestamptz
+}
+
 type Proxy struct {
 	ProxyPersonID string
 	PersonID      string
@@ -43,3 +85,14 @@ type Publication struct {
 	DateFrom   pgtype.Timestamptz
 	DateUntil  pgtype.Timestamptz
 }
+
+type SavedSearch struct {
+	ID           string
+	UserID       string
+	Name         string
+	SearchArgs   []byte
+	Digest       bool
+	LastDigestAt pgtype.Timestamptz
+	DateCreated  pgtype.Timestamptz
+	DateUpdated  pgtype.Timestamptz
+}
@@ -13,10 +13,10 @@ RETURNING id;
 SELECT EXISTS(SELECT 1 FROM candidate_records WHERE status = 'new');
 
 -- name: PersonHasCandidateRecords :one
-SELECT EXISTS(SELECT 1 FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> sqlc.arg(query)::jsonb OR metadata->'supervisor' @> sqlc.arg(query)::jsonb));
+SELECT EXISTS(SELECT 1 FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> sqlc.arg(query)::jsonb OR metadata->'supervisor' @> sqlc.arg(query)::jsonb OR assigned_person_id = sqlc.arg(person_id)));
 
 -- name: CountPersonCandidateRecords :one
-SELECT COUNT(*) FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> sqlc.arg(query)::jsonb OR metadata->'supervisor' @> sqlc.arg(query)::jsonb);
+SELECT COUNT(*) FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> sqlc.arg(query)::jsonb OR metadata->'supervisor' @> sqlc.arg(query)::jsonb OR assigned_person_id = sqlc.arg(person_id));
 
 -- name: GetCandidateRecord :one
 SELECT * FROM candidate_records WHERE id = $1 LIMIT 1;
@@ -26,6 +26,7 @@ UPDATE candidate_records
 SET status = sqlc.arg('status'),
     status_date = now(),
     status_person_id = sqlc.arg('status_person_id'),
+    status_reason = sqlc.narg('status_reason'),
     imported_id = sqlc.arg('imported_id')
 WHERE id = sqlc.arg('id') RETURNING id;
 
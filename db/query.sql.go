@@ -47,18 +47,23 @@ func (q *Queries) AddCandidateRecord(ctx context.Context, arg AddCandidateRecord
 }
 
 const countPersonCandidateRecords = `-- name: CountPersonCandidateRecords :one
-SELECT COUNT(*) FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> $1::jsonb OR metadata->'supervisor' @> $1::jsonb)
+SELECT COUNT(*) FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> $1::jsonb OR metadata->'supervisor' @> $1::jsonb OR assigned_person_id = $2)
 `
 
-func (q *Queries) CountPersonCandidateRecords(ctx context.Context, query []byte) (int64, error) {
-	row := q.db.QueryRow(ctx, countPersonCandidateRecords, query)
+type CountPersonCandidateRecordsParams struct {
+	Query    []byte
+	PersonID *string
+}
+
+func (q *Queries) CountPersonCandidateRecords(ctx context.Context, arg CountPersonCandidateRecordsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPersonCandidateRecords, arg.Query, arg.PersonID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const getCandidateRecord = `-- name: GetCandidateRecord :one
-SELECT id, source_name, source_id, source_metadata, type, status, metadata, date_created, status_date, status_person_id, imported_id FROM candidate_records WHERE id = $1 LIMIT 1
+SELECT id, source_name, source_id, source_metadata, type, status, metadata, date_created, status_date, status_person_id, imported_id, status_reason, assigned_person_id FROM candidate_records WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetCandidateRecord(ctx context.Context, id string) (CandidateRecord, error) {
@@ -76,12 +81,14 @@ func (q *Queries) GetCandidateRecord(ctx context.Context, id string) (CandidateR
 		&i.StatusDate,
 		&i.StatusPersonID,
 		&i.ImportedID,
+		&i.StatusReason,
+		&i.AssignedPersonID,
 	)
 	return i, err
 }
 
 const getCandidateRecordBySource = `-- name: GetCandidateRecordBySource :one
-SELECT id, source_name, source_id, source_metadata, type, status, metadata, date_created, status_date, status_person_id, imported_id FROM candidate_records WHERE source_name = $1 AND source_id = $2 LIMIT 1
+SELECT id, source_name, source_id, source_metadata, type, status, metadata, date_created, status_date, status_person_id, imported_id, status_reason, assigned_person_id FROM candidate_records WHERE source_name = $1 AND source_id = $2 LIMIT 1
 `
 
 type GetCandidateRecordBySourceParams struct {
@@ -104,6 +111,8 @@ func (q *Queries) GetCandidateRecordBySource(ctx context.Context, arg GetCandida
 		&i.StatusDate,
 		&i.StatusPersonID,
 		&i.ImportedID,
+		&i.StatusReason,
+		&i.AssignedPersonID,
 	)
 	return i, err
 }
@@ -120,11 +129,16 @@ func (q *Queries) HasCandidateRecords(ctx context.Context) (bool, error) {
 }
 
 const personHasCandidateRecords = `-- name: PersonHasCandidateRecords :one
-SELECT EXISTS(SELECT 1 FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> $1::jsonb OR metadata->'supervisor' @> $1::jsonb))
+SELECT EXISTS(SELECT 1 FROM candidate_records WHERE status = 'new' AND (metadata->'author' @> $1::jsonb OR metadata->'supervisor' @> $1::jsonb OR assigned_person_id = $2))
 `
 
-func (q *Queries) PersonHasCandidateRecords(ctx context.Context, query []byte) (bool, error) {
-	row := q.db.QueryRow(ctx, personHasCandidateRecords, query)
+type PersonHasCandidateRecordsParams struct {
+	Query    []byte
+	PersonID *string
+}
+
+func (q *Queries) PersonHasCandidateRecords(ctx context.Context, arg PersonHasCandidateRecordsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, personHasCandidateRecords, arg.Query, arg.PersonID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -150,13 +164,15 @@ UPDATE candidate_records
 SET status = $1,
     status_date = now(),
     status_person_id = $2,
-    imported_id = $3
-WHERE id = $4 RETURNING id
+    status_reason = $3,
+    imported_id = $4
+WHERE id = $5 RETURNING id
 `
 
 type SetCandidateRecordStatusParams struct {
 	Status         string
 	StatusPersonID *string
+	StatusReason   *string
 	ImportedID     *string
 	ID             string
 }
@@ -165,6 +181,7 @@ func (q *Queries) SetCandidateRecordStatus(ctx context.Context, arg SetCandidate
 	row := q.db.QueryRow(ctx, setCandidateRecordStatus,
 		arg.Status,
 		arg.StatusPersonID,
+		arg.StatusReason,
 		arg.ImportedID,
 		arg.ID,
 	)
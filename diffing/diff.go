@@ -0,0 +1,321 @@
+// Package diffing compares consecutive snapshots of publications and datasets
+// and reports what changed field by field.
+package diffing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	OpAdded   = "added"
+	OpRemoved = "removed"
+	OpChanged = "changed"
+	OpMoved   = "moved"
+)
+
+// Change is a single difference between two snapshots. Key identifies the
+// contributor or file within Field that was changed.
+type Change struct {
+	Field string `json:"field"`
+	Key   string `json:"key,omitempty"`
+	Op    string `json:"op"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// Entry lists the changes a snapshot made to the previous one. The first
+// snapshot of a record has no changes and Created set.
+type Entry struct {
+	SnapshotID string         `json:"snapshot_id"`
+	DateFrom   *time.Time     `json:"date_from,omitempty"`
+	UserID     string         `json:"user_id,omitempty"`
+	User       *models.Person `json:"-"`
+	Created    bool           `json:"created,omitempty"`
+	// RestoredFrom is set if the snapshot restored an earlier snapshot
+	RestoredFrom string   `json:"restored_from,omitempty"`
+	Reason       string   `json:"reason,omitempty"`
+	Changes      []Change `json:"changes,omitempty"`
+}
+
+// bookkeeping fields change with every snapshot
+var ignoredFields = []string{
+	"date_created",
+	"date_from",
+	"date_until",
+	"date_updated",
+	"has_been_public",
+	"last_user_id",
+	"snapshot_id",
+	"user_id",
+}
+
+var publicationContributorFields = []string{"author", "editor", "supervisor"}
+
+var datasetContributorFields = []string{"author", "contributor"}
+
+// Publications compares two snapshots of a publication
+func Publications(old, new *models.Publication) ([]Change, error) {
+	var changes []Change
+
+	skip := append(slices.Clone(publicationContributorFields), "file")
+	fieldChanges, err := diffFields("", old, new, skip)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, fieldChanges...)
+
+	for _, role := range publicationContributorFields {
+		changes = append(changes, diffContributors(role, old.Contributors(role), new.Contributors(role))...)
+	}
+
+	fileChanges, err := diffFiles(old.File, new.File)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, fileChanges...)
+
+	return changes, nil
+}
+
+// Datasets compares two snapshots of a dataset
+func Datasets(old, new *models.Dataset) ([]Change, error) {
+	var changes []Change
+
+	fieldChanges, err := diffFields("", old, new, datasetContributorFields)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, fieldChanges...)
+
+	for _, role := range datasetContributorFields {
+		changes = append(changes, diffContributors(role, old.Contributors(role), new.Contributors(role))...)
+	}
+
+	return changes, nil
+}
+
+// PublicationHistory turns the history of a publication, newest snapshot
+// first, into entries in the same order
+func PublicationHistory(snapshots []*models.Publication) ([]*Entry, error) {
+	entries := make([]*Entry, 0, len(snapshots))
+	for i, p := range snapshots {
+		e := &Entry{
+			SnapshotID: p.SnapshotID,
+			DateFrom:   p.DateFrom,
+			UserID:     p.UserID,
+			User:       p.User,
+		}
+		if i == len(snapshots)-1 {
+			e.Created = true
+		} else {
+			changes, err := Publications(snapshots[i+1], p)
+			if err != nil {
+				return nil, fmt.Errorf("diffing.PublicationHistory %s: %w", p.SnapshotID, err)
+			}
+			e.Changes = changes
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// DatasetHistory turns the history of a dataset, newest snapshot first, into
+// entries in the same order
+func DatasetHistory(snapshots []*models.Dataset) ([]*Entry, error) {
+	entries := make([]*Entry, 0, len(snapshots))
+	for i, d := range snapshots {
+		e := &Entry{
+			SnapshotID: d.SnapshotID,
+			DateFrom:   d.DateFrom,
+			UserID:     d.UserID,
+			User:       d.User,
+		}
+		if i == len(snapshots)-1 {
+			e.Created = true
+		} else {
+			changes, err := Datasets(snapshots[i+1], d)
+			if err != nil {
+				return nil, fmt.Errorf("diffing.DatasetHistory %s: %w", d.SnapshotID, err)
+			}
+			e.Changes = changes
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// diffFields compares the json representation of old and new key by key
+func diffFields(prefix string, old, new any, skip []string) ([]Change, error) {
+	oldFields, err := toFields(old)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := toFields(new)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(oldFields)+len(newFields))
+	for k := range oldFields {
+		keys = append(keys, k)
+	}
+	for k := range newFields {
+		if _, ok := oldFields[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []Change
+	for _, k := range keys {
+		if slices.Contains(ignoredFields, k) || slices.Contains(skip, k) {
+			continue
+		}
+		oldVal, inOld := oldFields[k]
+		newVal, inNew := newFields[k]
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Field: prefix + k, Op: OpAdded, New: formatValue(newVal)})
+		case !inNew:
+			changes = append(changes, Change{Field: prefix + k, Op: OpRemoved, Old: formatValue(oldVal)})
+		case !bytes.Equal(oldVal, newVal):
+			changes = append(changes, Change{Field: prefix + k, Op: OpChanged, Old: formatValue(oldVal), New: formatValue(newVal)})
+		}
+	}
+	return changes, nil
+}
+
+// toFields returns the non empty json fields of v
+func toFields(v any) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		if isEmpty(v) {
+			delete(fields, k)
+		}
+	}
+	return fields, nil
+}
+
+func isEmpty(v json.RawMessage) bool {
+	switch string(v) {
+	case "null", `""`, "false", "[]", "{}":
+		return true
+	}
+	return false
+}
+
+// formatValue renders strings and lists of strings as text and all other
+// values as json
+func formatValue(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	var strs []string
+	if err := json.Unmarshal(v, &strs); err == nil {
+		return strings.Join(strs, ", ")
+	}
+	return string(v)
+}
+
+func contributorKey(c *models.Contributor) string {
+	if c.PersonID != "" {
+		return c.PersonID
+	}
+	return "name:" + c.Name()
+}
+
+// diffContributors reports added and removed contributors, changed credit
+// roles and a changed order of the contributors that are in both lists
+func diffContributors(field string, old, new []*models.Contributor) []Change {
+	var changes []Change
+
+	oldByKey := make(map[string]*models.Contributor, len(old))
+	for _, c := range old {
+		oldByKey[contributorKey(c)] = c
+	}
+	newByKey := make(map[string]*models.Contributor, len(new))
+	for _, c := range new {
+		newByKey[contributorKey(c)] = c
+	}
+
+	var oldOrder, newOrder []string
+	for _, c := range old {
+		if _, ok := newByKey[contributorKey(c)]; !ok {
+			changes = append(changes, Change{Field: field, Key: c.Name(), Op: OpRemoved, Old: c.Name()})
+		} else {
+			oldOrder = append(oldOrder, c.Name())
+		}
+	}
+	for _, c := range new {
+		oldC, ok := oldByKey[contributorKey(c)]
+		if !ok {
+			changes = append(changes, Change{Field: field, Key: c.Name(), Op: OpAdded, New: c.Name()})
+			continue
+		}
+		newOrder = append(newOrder, c.Name())
+		if !slices.Equal(oldC.CreditRole, c.CreditRole) {
+			changes = append(changes, Change{
+				Field: field + ".credit_role",
+				Key:   c.Name(),
+				Op:    OpChanged,
+				Old:   strings.Join(oldC.CreditRole, ", "),
+				New:   strings.Join(c.CreditRole, ", "),
+			})
+		}
+	}
+
+	if !slices.Equal(oldOrder, newOrder) {
+		changes = append(changes, Change{
+			Field: field,
+			Op:    OpMoved,
+			Old:   strings.Join(oldOrder, "; "),
+			New:   strings.Join(newOrder, "; "),
+		})
+	}
+
+	return changes
+}
+
+// diffFiles reports added and removed files and the changed attributes of
+// files that are in both lists
+func diffFiles(old, new []*models.PublicationFile) ([]Change, error) {
+	var changes []Change
+
+	for _, f := range old {
+		if !slices.ContainsFunc(new, func(file *models.PublicationFile) bool { return file.ID == f.ID }) {
+			changes = append(changes, Change{Field: "file", Key: f.Name, Op: OpRemoved, Old: f.Name})
+		}
+	}
+	for _, f := range new {
+		i := slices.IndexFunc(old, func(file *models.PublicationFile) bool { return file.ID == f.ID })
+		if i == -1 {
+			changes = append(changes, Change{Field: "file", Key: f.Name, Op: OpAdded, New: f.Name})
+			continue
+		}
+		fileChanges, err := diffFields("file.", old[i], f, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range fileChanges {
+			c.Key = f.Name
+			changes = append(changes, c)
+		}
+	}
+
+	return changes, nil
+}
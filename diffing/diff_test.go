@@ -0,0 +1,56 @@
+package diffing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestPublications(t *testing.T) {
+	jane := models.ContributorFromFirstLastName("Jane", "Doe")
+	john := models.ContributorFromFirstLastName("John", "Smith")
+	ann := models.ContributorFromFirstLastName("Ann", "Lee")
+
+	old := &models.Publication{
+		SnapshotID: "s1",
+		Title:      "Old title",
+		Keyword:    []string{"a"},
+		Author:     []*models.Contributor{jane, john},
+		File:       []*models.PublicationFile{{ID: "f1", Name: "paper.pdf", AccessLevel: "closed"}},
+	}
+	new := &models.Publication{
+		SnapshotID: "s2",
+		Title:      "New title",
+		DOI:        "10.1000/abc",
+		Author:     []*models.Contributor{john, jane, ann},
+		File:       []*models.PublicationFile{{ID: "f1", Name: "paper.pdf", AccessLevel: "open"}, {ID: "f2", Name: "data.csv"}},
+	}
+
+	changes, err := Publications(old, new)
+	require.NoError(t, err)
+	require.Equal(t, []Change{
+		{Field: "doi", Op: OpAdded, New: "10.1000/abc"},
+		{Field: "keyword", Op: OpRemoved, Old: "a"},
+		{Field: "title", Op: OpChanged, Old: "Old title", New: "New title"},
+		{Field: "author", Key: "Ann Lee", Op: OpAdded, New: "Ann Lee"},
+		{Field: "author", Op: OpMoved, Old: "Jane Doe; John Smith", New: "John Smith; Jane Doe"},
+		{Field: "file.access_level", Key: "paper.pdf", Op: OpChanged, Old: "closed", New: "open"},
+		{Field: "file", Key: "data.csv", Op: OpAdded, New: "data.csv"},
+	}, changes)
+}
+
+func TestPublicationHistory(t *testing.T) {
+	snapshots := []*models.Publication{
+		{SnapshotID: "s2", UserID: "u2", Title: "New"},
+		{SnapshotID: "s1", UserID: "u1", Title: "Old"},
+	}
+
+	entries, err := PublicationHistory(snapshots)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "s2", entries[0].SnapshotID)
+	require.Equal(t, []Change{{Field: "title", Op: OpChanged, Old: "Old", New: "New"}}, entries[0].Changes)
+	require.True(t, entries[1].Created)
+	require.Empty(t, entries[1].Changes)
+}
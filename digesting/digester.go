@@ -0,0 +1,145 @@
+// Package digesting mails curators the publications that newly match their
+// saved searches.
+package digesting
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/bind"
+)
+
+// maxHits is the number of new publications listed in a digest, the
+// remaining matches can be seen by following the link to the search
+const maxHits = 50
+
+// Store persists saved searches, it is implemented by *repositories.Repo
+type Store interface {
+	GetDigestSavedSearches(context.Context) ([]*models.SavedSearch, error)
+	SetSavedSearchLastDigestAt(context.Context, string, time.Time) error
+}
+
+type Config struct {
+	Store                  Store
+	PublicationSearchIndex backends.PublicationIndex
+	UserService            backends.UserService
+	MailSender             backends.MailSender
+	BaseURL                string
+	Logger                 *slog.Logger
+}
+
+type Digester struct {
+	config Config
+}
+
+func NewDigester(c Config) *Digester {
+	return &Digester{config: c}
+}
+
+// Run sends a digest for every saved search that has digests turned on and
+// new matches since the previous run. It returns the number of mails sent.
+// A failing search doesn't stop the other digests.
+func (d *Digester) Run(ctx context.Context) (int, error) {
+	searches, err := d.config.Store.GetDigestSavedSearches(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("digesting.Run: %w", err)
+	}
+
+	n := 0
+	for _, s := range searches {
+		sent, err := d.digest(ctx, s)
+		if err != nil {
+			d.config.Logger.Error("saved search digest failed", "id", s.ID, "user", s.UserID, "error", err)
+			continue
+		}
+		if sent {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (d *Digester) digest(ctx context.Context, s *models.SavedSearch) (bool, error) {
+	now := time.Now()
+
+	since := s.DateCreated
+	if s.LastDigestAt != nil {
+		since = *s.LastDigestAt
+	}
+
+	args := s.SearchArgs.Clone().
+		WithFilter("created_since", since.UTC().Format("2006-01-02T15:04:05Z")).
+		WithSort("date-created-desc").
+		WithPage(1).
+		WithPageSize(maxHits)
+
+	hits, err := d.config.PublicationSearchIndex.
+		WithScope("status", "private", "public", "returned").
+		Search(args)
+	if err != nil {
+		return false, err
+	}
+
+	if hits.Total > 0 {
+		user, err := d.config.UserService.GetUser(s.UserID)
+		if err != nil {
+			return false, err
+		}
+		if user.Email == "" {
+			return false, fmt.Errorf("user %s has no email address", user.ID)
+		}
+		if err := d.config.MailSender.SendMail(ctx, d.message(user, s, hits)); err != nil {
+			return false, err
+		}
+	}
+
+	if err := d.config.Store.SetSavedSearchLastDigestAt(ctx, s.ID, now); err != nil {
+		return false, err
+	}
+
+	return hits.Total > 0, nil
+}
+
+func (d *Digester) message(user *models.Person, s *models.SavedSearch, hits *models.PublicationHits) *backends.MailMessage {
+	b := &strings.Builder{}
+
+	fmt.Fprintf(b, "Dear %s,\n\n", user.FullName)
+	if hits.Total == 1 {
+		fmt.Fprintf(b, "There is 1 new publication matching your saved search \"%s\":\n\n", s.Name)
+	} else {
+		fmt.Fprintf(b, "There are %d new publications matching your saved search \"%s\":\n\n", hits.Total, s.Name)
+	}
+	for _, p := range hits.Hits {
+		title := p.Title
+		if title == "" {
+			title = "Untitled record"
+		}
+		fmt.Fprintf(b, "- %s\n  %s/publication/%s\n", title, d.config.BaseURL, p.ID)
+	}
+	if hits.Total > len(hits.Hits) {
+		fmt.Fprintf(b, "\n...and %d more.\n", hits.Total-len(hits.Hits))
+	}
+	fmt.Fprintf(b, "\nView all results: %s\n", d.searchURL(s))
+	b.WriteString("\nYou receive this mail because you turned on notifications for this search in Biblio.\n")
+
+	return &backends.MailMessage{
+		To:      []string{user.Email},
+		Subject: fmt.Sprintf("Biblio: new publications for \"%s\"", s.Name),
+		Body:    b.String(),
+	}
+}
+
+func (d *Digester) searchURL(s *models.SavedSearch) string {
+	u := d.config.BaseURL + "/publication"
+	q, err := bind.EncodeQuery(s.SearchArgs.Clone().WithPage(1))
+	if err == nil && len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	return u
+}
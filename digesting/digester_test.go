@@ -0,0 +1,131 @@
+package digesting
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type fakeStore struct {
+	searches     []*models.SavedSearch
+	lastDigestAt map[string]time.Time
+}
+
+func (s *fakeStore) GetDigestSavedSearches(context.Context) ([]*models.SavedSearch, error) {
+	return s.searches, nil
+}
+
+func (s *fakeStore) SetSavedSearchLastDigestAt(_ context.Context, id string, t time.Time) error {
+	s.lastDigestAt[id] = t
+	return nil
+}
+
+type fakeIndex struct {
+	hits []*models.Publication
+	args []*models.SearchArgs
+}
+
+func (idx *fakeIndex) Search(args *models.SearchArgs) (*models.PublicationHits, error) {
+	idx.args = append(idx.args, args)
+	if args.Query == "fail" {
+		return nil, errors.New("search failed")
+	}
+	hits := &models.PublicationHits{Hits: idx.hits}
+	hits.Total = len(idx.hits)
+	return hits, nil
+}
+
+func (idx *fakeIndex) Each(context.Context, *models.SearchArgs, int, func(*models.Publication)) error {
+	return nil
+}
+func (idx *fakeIndex) Delete(string) error                                   { return nil }
+func (idx *fakeIndex) DeleteAll() error                                      { return nil }
+func (idx *fakeIndex) WithScope(string, ...string) backends.PublicationIndex { return idx }
+
+type fakeUserService struct{}
+
+func (fakeUserService) GetUser(id string) (*models.Person, error) {
+	return &models.Person{ID: id, FullName: "Jane Doe", Email: "jane@example.com"}, nil
+}
+
+func (fakeUserService) GetUserByUsername(string) (*models.Person, error) {
+	return nil, models.ErrNotFound
+}
+
+type recordingSender struct {
+	sent []*backends.MailMessage
+}
+
+func (s *recordingSender) SendMail(_ context.Context, msg *backends.MailMessage) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestDigesterRun(t *testing.T) {
+	lastDigestAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	store := &fakeStore{
+		searches: []*models.SavedSearch{
+			{ID: "1", UserID: "u1", Name: "Physics", SearchArgs: models.NewSearchArgs().WithQuery("physics"), LastDigestAt: &lastDigestAt},
+			{ID: "2", UserID: "u1", Name: "Broken", SearchArgs: models.NewSearchArgs().WithQuery("fail")},
+		},
+		lastDigestAt: map[string]time.Time{},
+	}
+	index := &fakeIndex{hits: []*models.Publication{{ID: "p1", Title: "Dark matter"}}}
+	sender := &recordingSender{}
+
+	d := NewDigester(Config{
+		Store:                  store,
+		PublicationSearchIndex: index,
+		UserService:            fakeUserService{},
+		MailSender:             sender,
+		BaseURL:                "https://biblio.example.com",
+		Logger:                 slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	n, err := d.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	require.Equal(t, []string{"2024-03-01T10:00:00Z"}, index.args[0].FiltersFor("created_since"))
+	require.Equal(t, "physics", index.args[0].Query)
+
+	require.Len(t, sender.sent, 1)
+	require.Equal(t, []string{"jane@example.com"}, sender.sent[0].To)
+	require.Contains(t, sender.sent[0].Subject, "Physics")
+	require.Contains(t, sender.sent[0].Body, "Dark matter")
+	require.Contains(t, sender.sent[0].Body, "https://biblio.example.com/publication/p1")
+	require.Contains(t, sender.sent[0].Body, "https://biblio.example.com/publication?")
+
+	// failed searches are retried next time
+	require.Contains(t, store.lastDigestAt, "1")
+	require.NotContains(t, store.lastDigestAt, "2")
+}
+
+func TestDigesterRunNoMatches(t *testing.T) {
+	store := &fakeStore{
+		searches:     []*models.SavedSearch{{ID: "1", UserID: "u1", Name: "Physics", SearchArgs: models.NewSearchArgs()}},
+		lastDigestAt: map[string]time.Time{},
+	}
+	sender := &recordingSender{}
+
+	d := NewDigester(Config{
+		Store:                  store,
+		PublicationSearchIndex: &fakeIndex{},
+		UserService:            fakeUserService{},
+		MailSender:             sender,
+		Logger:                 slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	n, err := d.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.Empty(t, sender.sent)
+	require.Contains(t, store.lastDigestAt, "1")
+}
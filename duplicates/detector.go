@@ -0,0 +1,247 @@
+// Package duplicates finds existing publications that are likely the same
+// work as a publication that is being created or imported.
+package duplicates
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/identifiers"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	ReasonDOI    = "doi"
+	ReasonPubMed = "pubmed"
+	ReasonArXiv  = "arxiv"
+	ReasonWOS    = "wos"
+	ReasonTitle  = "title"
+)
+
+const (
+	// maxMatches limits the number of candidates per search
+	maxMatches = 10
+	// titles with fewer words are too generic to compare ("Editorial")
+	minTitleWords = 3
+	// minimal similarity of two normalized titles
+	titleThreshold = 0.9
+	// number of title words used to find candidates
+	maxQueryWords = 10
+)
+
+// Match is an existing publication with the reasons it is considered a
+// duplicate
+type Match struct {
+	Publication *models.Publication
+	Reasons     []string
+}
+
+type Detector struct {
+	index backends.PublicationIndex
+}
+
+// NewDetector returns a detector that searches index. Scope the index to
+// limit which publications are considered, e.g. to exclude deleted records.
+func NewDetector(index backends.PublicationIndex) *Detector {
+	return &Detector{index: index}
+}
+
+// Scope limits the index to publications that the user may see as
+// duplicates. Curators see all records that are not deleted, other users only
+// public records.
+func Scope(index backends.PublicationIndex, curator bool) backends.PublicationIndex {
+	if curator {
+		return index.WithScope("status", "private", "public", "returned")
+	}
+	return index.WithScope("status", "public")
+}
+
+// Find returns publications with the same DOI, PubMed, arXiv or Web of
+// Science id, followed by publications with a near identical title, a year
+// that differs at most one and an author in common. The publication itself
+// is never returned.
+func (d *Detector) Find(p *models.Publication) ([]*Match, error) {
+	var matches []*Match
+
+	add := func(pub *models.Publication, reason string) {
+		if pub.ID == p.ID {
+			return
+		}
+		for _, m := range matches {
+			if m.Publication.ID == pub.ID {
+				if !slices.Contains(m.Reasons, reason) {
+					m.Reasons = append(m.Reasons, reason)
+				}
+				return
+			}
+		}
+		matches = append(matches, &Match{Publication: pub, Reasons: []string{reason}})
+	}
+
+	ids := publicationIdentifiers(p)
+
+	if len(ids) > 0 {
+		args := models.NewSearchArgs().
+			WithFilter("identifier", identifierTerms(ids)...).
+			WithPageSize(maxMatches)
+		hits, err := d.index.Search(args)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range hits.Hits {
+			hitIDs := publicationIdentifiers(hit)
+			for _, reason := range identifierReasons {
+				if id, ok := ids[reason]; ok && hitIDs[reason] == id {
+					add(hit, reason)
+				}
+			}
+		}
+	}
+
+	words := titleWords(p.Title)
+
+	if len(words) >= minTitleWords {
+		args := models.NewSearchArgs().
+			WithQuery(strings.Join(queryWords(words), " ")).
+			WithPageSize(maxMatches)
+		if year, err := strconv.Atoi(p.Year); err == nil {
+			args.WithFilter("year", strconv.Itoa(year-1), p.Year, strconv.Itoa(year+1))
+		}
+		hits, err := d.index.Search(args)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range hits.Hits {
+			if TitleSimilarity(p.Title, hit.Title) >= titleThreshold && similarYear(p.Year, hit.Year) && sharesAuthor(p, hit) {
+				add(hit, ReasonTitle)
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+var identifierReasons = []string{ReasonDOI, ReasonPubMed, ReasonArXiv, ReasonWOS}
+
+// publicationIdentifiers returns the normalized identifiers of a
+// publication by reason
+func publicationIdentifiers(p *models.Publication) map[string]string {
+	ids := map[string]string{}
+	set := func(reason string, t identifiers.Type, id string) {
+		if id == "" {
+			return
+		}
+		if norm, err := t.Normalize(id); err == nil && norm != "" {
+			ids[reason] = strings.ToLower(norm)
+		}
+	}
+	set(ReasonDOI, identifiers.DOI, p.DOI)
+	set(ReasonPubMed, identifiers.PubMed, p.PubMedID)
+	set(ReasonArXiv, identifiers.ArXiv, p.ArxivID)
+	set(ReasonWOS, identifiers.WebOfScience, p.WOSID)
+	return ids
+}
+
+// identifierTerms returns the forms under which the identifiers may be
+// indexed. The index also has arXiv ids without their version, see
+// es6.NewIndexedPublication.
+func identifierTerms(ids map[string]string) []string {
+	var terms []string
+	for _, reason := range identifierReasons {
+		id, ok := ids[reason]
+		if !ok {
+			continue
+		}
+		terms = append(terms, id)
+		switch reason {
+		case ReasonArXiv:
+			terms = append(terms, "arxiv:"+id)
+		case ReasonWOS:
+			terms = append(terms, "wos:"+id)
+		}
+	}
+	return terms
+}
+
+// titleWords lowercases a title and splits it in words, ignoring
+// punctuation
+func titleWords(title string) []string {
+	return strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// queryWords returns the longest words of a title, short words are mostly
+// stopwords that don't help to find candidates
+func queryWords(words []string) []string {
+	if len(words) <= maxQueryWords {
+		return words
+	}
+	sorted := slices.Clone(words)
+	slices.SortStableFunc(sorted, func(a, b string) int {
+		return len([]rune(b)) - len([]rune(a))
+	})
+	return sorted[:maxQueryWords]
+}
+
+// TitleSimilarity returns the Dice coefficient of the word sets of two
+// titles, 1 means both titles have the same words
+func TitleSimilarity(a, b string) float64 {
+	wordsA := wordSet(titleWords(a))
+	wordsB := wordSet(titleWords(b))
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+	n := 0
+	for w := range wordsA {
+		if _, ok := wordsB[w]; ok {
+			n++
+		}
+	}
+	return 2 * float64(n) / float64(len(wordsA)+len(wordsB))
+}
+
+func wordSet(words []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+// similarYear allows a difference of one year, online first and print
+// publication often differ
+func similarYear(a, b string) bool {
+	if a == "" || b == "" {
+		return true
+	}
+	yearA, errA := strconv.Atoi(a)
+	yearB, errB := strconv.Atoi(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return yearA-yearB <= 1 && yearB-yearA <= 1
+}
+
+// sharesAuthor reports if both publications have an author with the same
+// last name. Publications without authors are not compared.
+func sharesAuthor(a, b *models.Publication) bool {
+	if len(a.Author) == 0 || len(b.Author) == 0 {
+		return true
+	}
+	for _, authorA := range a.Author {
+		lastName := strings.ToLower(authorA.LastName())
+		if lastName == "" {
+			continue
+		}
+		for _, authorB := range b.Author {
+			if strings.ToLower(authorB.LastName()) == lastName {
+				return true
+			}
+		}
+	}
+	return false
+}
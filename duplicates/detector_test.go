@@ -0,0 +1,116 @@
+package duplicates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+// fakeIndex returns identifierHits for identifier searches and titleHits
+// for all other searches
+type fakeIndex struct {
+	identifierHits []*models.Publication
+	titleHits      []*models.Publication
+	args           []*models.SearchArgs
+}
+
+func (idx *fakeIndex) Search(args *models.SearchArgs) (*models.PublicationHits, error) {
+	idx.args = append(idx.args, args)
+	hits := &models.PublicationHits{Hits: idx.titleHits}
+	if args.HasFilter("identifier") {
+		hits.Hits = idx.identifierHits
+	}
+	hits.Total = len(hits.Hits)
+	return hits, nil
+}
+
+func (idx *fakeIndex) Each(context.Context, *models.SearchArgs, int, func(*models.Publication)) error {
+	return nil
+}
+func (idx *fakeIndex) Delete(string) error                                   { return nil }
+func (idx *fakeIndex) DeleteAll() error                                      { return nil }
+func (idx *fakeIndex) WithScope(string, ...string) backends.PublicationIndex { return idx }
+
+func TestFindByIdentifier(t *testing.T) {
+	index := &fakeIndex{
+		identifierHits: []*models.Publication{
+			{ID: "1", DOI: "10.1000/ABC", PubMedID: "123"},
+			{ID: "2", WOSID: "WOS:000111"},
+			{ID: "3", DOI: "10.1000/other"},
+		},
+	}
+
+	p := &models.Publication{
+		DOI:      "https://doi.org/10.1000/abc",
+		PubMedID: "PMID: 123",
+		WOSID:    "000111",
+	}
+
+	matches, err := NewDetector(index).Find(p)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, "1", matches[0].Publication.ID)
+	require.Equal(t, []string{ReasonDOI, ReasonPubMed}, matches[0].Reasons)
+	require.Equal(t, "2", matches[1].Publication.ID)
+	require.Equal(t, []string{ReasonWOS}, matches[1].Reasons)
+
+	require.ElementsMatch(t, []string{"10.1000/abc", "123", "000111", "wos:000111"}, index.args[0].FiltersFor("identifier"))
+}
+
+func TestFindByTitle(t *testing.T) {
+	index := &fakeIndex{
+		titleHits: []*models.Publication{
+			{ID: "self", Title: "Dark matter in dwarf galaxies", Year: "2021"},
+			{
+				ID:     "1",
+				Title:  "Dark Matter in Dwarf Galaxies.",
+				Year:   "2020",
+				Author: []*models.Contributor{models.ContributorFromFirstLastName("Jane", "Doe")},
+			},
+			{
+				ID:     "2",
+				Title:  "Dark matter in dwarf galaxies",
+				Year:   "2021",
+				Author: []*models.Contributor{models.ContributorFromFirstLastName("John", "Smith")},
+			},
+			{ID: "3", Title: "Dark matter in dwarf galaxies", Year: "2015"},
+			{ID: "4", Title: "Dark energy in dwarf galaxies", Year: "2021"},
+		},
+	}
+
+	p := &models.Publication{
+		ID:     "self",
+		Title:  "Dark matter in dwarf galaxies",
+		Year:   "2021",
+		Author: []*models.Contributor{models.ContributorFromFirstLastName("J.", "Doe")},
+	}
+
+	matches, err := NewDetector(index).Find(p)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "1", matches[0].Publication.ID)
+	require.Equal(t, []string{ReasonTitle}, matches[0].Reasons)
+
+	require.Len(t, index.args, 1)
+	require.Equal(t, []string{"2020", "2021", "2022"}, index.args[0].FiltersFor("year"))
+}
+
+func TestFindIgnoresShortTitles(t *testing.T) {
+	index := &fakeIndex{
+		titleHits: []*models.Publication{{ID: "1", Title: "Editorial"}},
+	}
+
+	matches, err := NewDetector(index).Find(&models.Publication{Title: "Editorial"})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+	require.Empty(t, index.args)
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	require.Equal(t, 1.0, TitleSimilarity("A study of things", "a Study of: things!"))
+	require.Equal(t, 0.0, TitleSimilarity("", "a study"))
+	require.InDelta(t, 0.75, TitleSimilarity("a study of things", "a study of stuff"), 0.001)
+}
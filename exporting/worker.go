@@ -0,0 +1,270 @@
+// Package exporting runs curator list exports in the background so that big
+// exports don't have to fit in a single HTTP request.
+package exporting
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	// progress is reported every progressInterval records, which also
+	// serves as a heartbeat for the job
+	progressInterval = 500
+	// running jobs without a heartbeat for this long are picked up again
+	staleAfter = 10 * time.Minute
+)
+
+var fileExtensions = map[string]string{
+	"bibtex":   "bib",
+	"csl-json": "json",
+}
+
+// FileName returns the name under which an export is downloaded
+func FileName(entity, format string, t time.Time) string {
+	ext := format
+	if e, ok := fileExtensions[format]; ok {
+		ext = e
+	}
+	return fmt.Sprintf("%ss_%s.%s", entity, t.Format("2006-01-02_15-04-05"), ext)
+}
+
+// MaxSize limits the number of exported records. xlsx workbooks are built in
+// memory, the other formats are streamed.
+func MaxSize(format string) int {
+	if format == "xlsx" {
+		return 10000
+	}
+	return math.MaxInt
+}
+
+type Worker struct {
+	services     *backends.Services
+	logger       *slog.Logger
+	ttl          time.Duration
+	pollInterval time.Duration
+}
+
+// NewWorker returns a worker that keeps export results for ttl
+func NewWorker(services *backends.Services, logger *slog.Logger, ttl time.Duration) *Worker {
+	return &Worker{
+		services:     services,
+		logger:       logger,
+		ttl:          ttl,
+		pollInterval: 5 * time.Second,
+	}
+}
+
+// Start processes export jobs until ctx is cancelled. It is safe to run a
+// worker in every server instance, a job is only claimed once.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			job, err := w.services.Repo.ClaimExportJob(ctx, time.Now().Add(-staleAfter))
+			if err != nil {
+				w.logger.Error("export jobs: claim failed", "error", err)
+				break
+			}
+			if job == nil {
+				break
+			}
+			w.Run(ctx, job)
+		}
+
+		w.deleteExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run executes a claimed job and records the outcome
+func (w *Worker) Run(ctx context.Context, job *models.ExportJob) {
+	w.logger.Info("export jobs: start", "id", job.ID, "entity", job.Entity, "format", job.Format)
+
+	err := w.export(ctx, job)
+
+	expires := time.Now().Add(w.ttl)
+
+	if err != nil {
+		w.logger.Error("export jobs: failed", "id", job.ID, "error", err)
+		if err := w.services.Repo.FailExportJob(ctx, job.ID, err, expires); err != nil {
+			w.logger.Error("export jobs: can't mark job as failed", "id", job.ID, "error", err)
+		}
+		return
+	}
+
+	job.DateExpires = &expires
+	if err := w.services.Repo.CompleteExportJob(ctx, job); err != nil {
+		w.logger.Error("export jobs: can't mark job as done", "id", job.ID, "error", err)
+		return
+	}
+
+	w.logger.Info("export jobs: done", "id", job.ID, "records", job.Processed)
+}
+
+func (w *Worker) export(ctx context.Context, job *models.ExportJob) error {
+	total, err := w.count(job)
+	if err != nil {
+		return err
+	}
+	// nothing matched, the job is done without a file
+	if total == 0 {
+		return nil
+	}
+
+	// the exporter writes to a pipe that is consumed by the file store so the
+	// result never has to fit in memory
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	type addResult struct {
+		id  string
+		err error
+	}
+	added := make(chan addResult, 1)
+	go func() {
+		id, err := w.services.FileStore.Add(ctx, pr, "")
+		pr.CloseWithError(err)
+		added <- addResult{id, err}
+	}()
+
+	contentType, err := w.each(ctx, job, min(total, MaxSize(job.Format)), pw)
+	pw.CloseWithError(err)
+
+	res := <-added
+	if err != nil {
+		return err
+	}
+	if res.err != nil {
+		return fmt.Errorf("can't store export: %w", res.err)
+	}
+
+	job.FileID = res.id
+	job.FileName = FileName(job.Entity, job.Format, job.DateCreated)
+	job.ContentType = contentType
+
+	return nil
+}
+
+// count returns the number of records that match the job search
+func (w *Worker) count(job *models.ExportJob) (int, error) {
+	args := job.SearchArgs.Clone().WithPageSize(0)
+
+	switch job.Entity {
+	case "publication":
+		hits, err := w.publicationSearcher().Search(args)
+		if err != nil {
+			return 0, err
+		}
+		return hits.Total, nil
+	case "dataset":
+		hits, err := w.datasetSearcher().Search(args)
+		if err != nil {
+			return 0, err
+		}
+		return hits.Total, nil
+	}
+
+	return 0, fmt.Errorf("unknown export entity %q", job.Entity)
+}
+
+// each runs the search and feeds every hit to the exporter
+func (w *Worker) each(ctx context.Context, job *models.ExportJob, total int, out io.Writer) (string, error) {
+	maxSize := MaxSize(job.Format)
+
+	switch job.Entity {
+	case "publication":
+		factory, ok := w.services.PublicationListExporters[job.Format]
+		if !ok {
+			return "", fmt.Errorf("unknown publication export format %q", job.Format)
+		}
+		exporter := factory(out)
+		progress := w.progressFunc(ctx, job, total)
+		err := w.publicationSearcher().Each(ctx, job.SearchArgs, maxSize, func(p *models.Publication) {
+			if p == nil {
+				return
+			}
+			exporter.Add(p)
+			progress()
+		})
+		if err != nil {
+			return "", err
+		}
+		return exporter.GetContentType(), exporter.Flush()
+	case "dataset":
+		factory, ok := w.services.DatasetListExporters[job.Format]
+		if !ok {
+			return "", fmt.Errorf("unknown dataset export format %q", job.Format)
+		}
+		exporter := factory(out)
+		progress := w.progressFunc(ctx, job, total)
+		err := w.datasetSearcher().Each(ctx, job.SearchArgs, maxSize, func(d *models.Dataset) {
+			if d == nil {
+				return
+			}
+			exporter.Add(d)
+			progress()
+		})
+		if err != nil {
+			return "", err
+		}
+		return exporter.GetContentType(), exporter.Flush()
+	}
+
+	return "", fmt.Errorf("unknown export entity %q", job.Entity)
+}
+
+func (w *Worker) publicationSearcher() backends.PublicationIndex {
+	return w.services.PublicationSearchIndex.WithScope("status", "private", "public", "returned")
+}
+
+func (w *Worker) datasetSearcher() backends.DatasetIndex {
+	return w.services.DatasetSearchIndex.WithScope("status", "private", "public", "returned")
+}
+
+// progressFunc returns a callback that counts exported records and
+// periodically stores the count
+func (w *Worker) progressFunc(ctx context.Context, job *models.ExportJob, total int) func() {
+	job.Total = total
+	w.updateProgress(ctx, job)
+
+	return func() {
+		job.Processed++
+		if job.Processed%progressInterval == 0 {
+			w.updateProgress(ctx, job)
+		}
+	}
+}
+
+func (w *Worker) updateProgress(ctx context.Context, job *models.ExportJob) {
+	if err := w.services.Repo.UpdateExportJobProgress(ctx, job.ID, job.Processed, job.Total); err != nil {
+		w.logger.Error("export jobs: can't update progress", "id", job.ID, "error", err)
+	}
+}
+
+func (w *Worker) deleteExpired(ctx context.Context) {
+	fileIDs, err := w.services.Repo.DeleteExpiredExportJobs(ctx)
+	if err != nil {
+		w.logger.Error("export jobs: can't delete expired jobs", "error", err)
+		return
+	}
+	for _, id := range fileIDs {
+		if err := w.services.FileStore.Delete(ctx, id); err != nil {
+			w.logger.Error("export jobs: can't delete expired file", "file", id, "error", err)
+		}
+	}
+}
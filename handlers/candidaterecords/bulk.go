@@ -0,0 +1,184 @@
+package candidaterecords
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/views"
+	candidaterecordviews "github.com/ugent-library/biblio-backoffice/views/candidaterecord"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+)
+
+const (
+	// maximum number of records in a bulk job
+	maxBulkSize   = 1000
+	bulkJobsLimit = 50
+)
+
+var bulkActions = []string{
+	models.CandidateRecordBulkImport,
+	models.CandidateRecordBulkReject,
+	models.CandidateRecordBulkAssign,
+}
+
+type bindBulkAction struct {
+	Action   string   `query:"action" form:"action"`
+	IDs      []string `query:"id" form:"id"`
+	Reason   string   `form:"reason"`
+	PersonID string   `form:"person_id"`
+}
+
+// ConfirmBulkAction shows the bulk action dialog for the selected records.
+// If nothing is selected, the action applies to all new records that match
+// the current filters.
+func ConfirmBulkAction(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	b := bindBulkAction{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+	if !slices.Contains(bulkActions, b.Action) || len(b.IDs) > maxBulkSize {
+		c.HandleError(w, r, httperror.BadRequest)
+		return
+	}
+
+	args := candidaterecordviews.ConfirmBulkArgs{
+		Action: b.Action,
+		IDs:    b.IDs,
+	}
+
+	if len(args.IDs) == 0 {
+		searchArgs := models.NewSearchArgs()
+		if err := bind.Request(r, searchArgs); err != nil {
+			c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+			return
+		}
+		ids, err := c.Repo.GetNewCandidateRecordIDs(r.Context(), searchArgs, maxBulkSize)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		args.IDs = ids
+		args.All = true
+	}
+
+	if args.Action == models.CandidateRecordBulkAssign {
+		hits, err := c.PersonSearchService.SuggestPeople("")
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		args.Hits = hits
+	}
+
+	views.ShowModal(candidaterecordviews.ConfirmBulk(c, args)).Render(r.Context(), w)
+}
+
+func SuggestAssignees(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	hits, err := c.PersonSearchService.SuggestPeople(r.URL.Query().Get("person_query"))
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	candidaterecordviews.AssigneeSuggestions(c, hits, "").Render(r.Context(), w)
+}
+
+// BulkAction queues a bulk job and redirects to the job overview
+func BulkAction(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	b := bindBulkAction{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+	b.Reason = strings.TrimSpace(b.Reason)
+
+	if !slices.Contains(bulkActions, b.Action) || len(b.IDs) == 0 || len(b.IDs) > maxBulkSize {
+		c.HandleError(w, r, httperror.BadRequest)
+		return
+	}
+
+	if b.Action == models.CandidateRecordBulkAssign {
+		var person *models.Person
+		var err error
+		if b.PersonID != "" {
+			person, err = c.PersonService.GetPerson(b.PersonID)
+		}
+		if b.PersonID == "" || err != nil {
+			hits, err := c.PersonSearchService.SuggestPeople("")
+			if err != nil {
+				c.HandleError(w, r, err)
+				return
+			}
+			views.ReplaceModal(candidaterecordviews.ConfirmBulk(c, candidaterecordviews.ConfirmBulkArgs{
+				Action: b.Action,
+				IDs:    b.IDs,
+				Hits:   hits,
+				Errors: []string{"Select a researcher."},
+			})).Render(r.Context(), w)
+			return
+		}
+		b.PersonID = person.ID
+	}
+
+	job := &models.CandidateRecordBulkJob{
+		UserID:             c.User.ID,
+		Action:             b.Action,
+		CandidateRecordIDs: b.IDs,
+	}
+	switch b.Action {
+	case models.CandidateRecordBulkReject:
+		job.Reason = b.Reason
+	case models.CandidateRecordBulkAssign:
+		job.PersonID = b.PersonID
+	}
+
+	if err := c.Repo.AddCandidateRecordBulkJob(r.Context(), job); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	f := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Bulk action was started, you can follow its progress here.</p>")
+	c.PersistFlash(w, *f)
+
+	w.Header().Set("HX-Redirect", c.PathTo("candidate_record_bulk_jobs").String())
+}
+
+func BulkJobs(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	jobs, err := c.Repo.GetCandidateRecordBulkJobsByUser(r.Context(), c.User.ID, bulkJobsLimit)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	candidaterecordviews.BulkJobs(c, jobs).Render(r.Context(), w)
+}
+
+// BulkJobList renders the job list only, the overview polls it while there
+// are unfinished jobs
+func BulkJobList(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	jobs, err := c.Repo.GetCandidateRecordBulkJobsByUser(r.Context(), c.User.ID, bulkJobsLimit)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	candidaterecordviews.BulkJobList(c, jobs).Render(r.Context(), w)
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/duplicates"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/biblio-backoffice/pagination"
 	"github.com/ugent-library/biblio-backoffice/views"
@@ -80,7 +81,22 @@ func CandidateRecordPreview(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
 	rec := ctx.GetCandidateRecord(r)
 
-	views.ShowModal(candidaterecordviews.Preview(c, rec)).Render(r.Context(), w)
+	matches, err := duplicates.NewDetector(duplicates.Scope(c.PublicationSearchIndex, c.UserRole == "curator")).Find(rec.Publication)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	var personMatches []*models.PersonMatch
+	if c.UserRole == "curator" {
+		personMatches, err = c.Repo.GetCandidateRecordPersonMatches(r.Context(), rec.ID)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+	}
+
+	views.ShowModal(candidaterecordviews.Preview(c, rec, matches, personMatches)).Render(r.Context(), w)
 }
 
 func ConfirmRejectCandidateRecord(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,60 @@
+package candidaterecords
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/models"
+	candidaterecordviews "github.com/ugent-library/biblio-backoffice/views/candidaterecord"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+)
+
+func ConfirmPersonMatch(w http.ResponseWriter, r *http.Request) {
+	updatePersonMatch(w, r, ctx.Get(r).Repo.ConfirmCandidateRecordPersonMatch)
+}
+
+func RejectPersonMatch(w http.ResponseWriter, r *http.Request) {
+	updatePersonMatch(w, r, ctx.Get(r).Repo.RejectCandidateRecordPersonMatch)
+}
+
+func updatePersonMatch(w http.ResponseWriter, r *http.Request, update func(context.Context, string, *models.Person) error) {
+	c := ctx.Get(r)
+	rec := ctx.GetCandidateRecord(r)
+
+	match, err := c.Repo.GetCandidateRecordPersonMatch(r.Context(), bind.PathValue(r, "match_id"))
+	if errors.Is(err, models.ErrNotFound) || (err == nil && match.CandidateRecordID != rec.ID) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	err = update(r.Context(), match.ID, c.User)
+	if errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	rec, err = c.Repo.GetCandidateRecord(r.Context(), rec.ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	matches, err := c.Repo.GetCandidateRecordPersonMatches(r.Context(), rec.ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	candidaterecordviews.PersonMatches(c, rec, matches).Render(r.Context(), w)
+}
@@ -0,0 +1,99 @@
+package comments
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/localize"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/views"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+	"github.com/ugent-library/okay"
+)
+
+type BindComment struct {
+	Body       string `form:"body"`
+	Visibility string `form:"visibility"`
+	ParentID   string `form:"parent_id"`
+}
+
+// Args loads the comment threads of a record that the current user can see.
+// Comments can only be added if addURL is set.
+func Args(r *http.Request, c *ctx.Ctx, recordType, recordID, addURL string) (views.CommentsArgs, error) {
+	comments, err := c.Repo.GetComments(r.Context(), recordType, recordID, c.Repo.CanCurate(c.User))
+	if err != nil {
+		return views.CommentsArgs{}, err
+	}
+	return views.CommentsArgs{
+		AddURL:  addURL,
+		Threads: models.ThreadComments(comments),
+	}, nil
+}
+
+func AddPublicationComment(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+	add(w, r, "publication", p.ID, c.PathTo("publication_add_comment", "id", p.ID).String())
+}
+
+func AddDatasetComment(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	d := ctx.GetDataset(r)
+	add(w, r, "dataset", d.ID, c.PathTo("dataset_add_comment", "id", d.ID).String())
+}
+
+func add(w http.ResponseWriter, r *http.Request, recordType, recordID, addURL string) {
+	c := ctx.Get(r)
+
+	b := BindComment{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	// only curators can leave comments that researchers don't see
+	visibility := models.CommentShared
+	if c.Repo.CanCurate(c.User) && b.Visibility != "" {
+		visibility = b.Visibility
+	}
+
+	comment := &models.Comment{
+		RecordType: recordType,
+		RecordID:   recordID,
+		ParentID:   b.ParentID,
+		UserID:     c.User.ID,
+		Body:       strings.TrimSpace(b.Body),
+		Visibility: visibility,
+	}
+	err := c.Repo.AddComment(r.Context(), comment)
+
+	var validationErrs *okay.Errors
+	var errs []string
+	switch {
+	case errors.As(err, &validationErrs):
+		errs = localize.ValidationErrors(c.Loc, validationErrs)
+	case errors.Is(err, models.ErrNotFound):
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	case err != nil:
+		c.HandleError(w, r, err)
+		return
+	}
+
+	args, err := Args(r, c, recordType, recordID, addURL)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+	if len(errs) > 0 {
+		args.Errors = errs
+		if b.ParentID == "" {
+			args.Body = b.Body
+		}
+	}
+
+	views.Comments(c, args).Render(r.Context(), w)
+}
@@ -0,0 +1,42 @@
+package dashboard
+
+import (
+	"net/http"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/models"
+	dashboardviews "github.com/ugent-library/biblio-backoffice/views/dashboard"
+	"github.com/ugent-library/biblio-backoffice/vocabularies"
+	"github.com/ugent-library/bind"
+)
+
+// CuratorReviews shows the number of open reviews per faculty
+func CuratorReviews(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	typ := bind.PathValue(r, "type") //TODO: bind via middleware
+
+	var recordType, activeSubNav string
+
+	switch typ {
+	case "datasets":
+		recordType = "dataset"
+		activeSubNav = "dashboard_reviews_datasets"
+	default:
+		recordType = "publication"
+		activeSubNav = "dashboard_reviews_publications"
+	}
+
+	counts, err := c.Repo.CountOpenReviews(r.Context(), recordType)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	dashboardviews.CuratorDashboardReviews(c, &dashboardviews.CuratorDashboardReviewsArgs{
+		ActiveSubNav: activeSubNav,
+		RecordType:   recordType,
+		Faculties:    append([]string{"all"}, vocabularies.Map["faculties"]...),
+		Statuses:     []string{models.ReviewPending, models.ReviewChangesRequested},
+		Counts:       counts,
+	}).Render(r.Context(), w)
+}
@@ -63,7 +63,7 @@ func CreateAbstract(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -155,7 +155,7 @@ func UpdateAbstract(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -212,7 +212,7 @@ func DeleteAbstract(w http.ResponseWriter, r *http.Request) {
 	d := ctx.GetDataset(r)
 	d.RemoveAbstract(b.AbstractID)
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
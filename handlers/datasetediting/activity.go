@@ -55,7 +55,7 @@ func UpdateMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -92,7 +92,12 @@ func UpdateReviewerTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	d := ctx.GetDataset(r)
+	// reviewer tags aren't part of the content and bypass the draft
+	d, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 	d.ReviewerTags = b.ReviewerTags
 
 	if validationErrs := d.Validate(); validationErrs != nil {
@@ -104,7 +109,7 @@ func UpdateReviewerTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -141,7 +146,12 @@ func UpdateReviewerNote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	d := ctx.GetDataset(r)
+	// the reviewer note isn't part of the content and bypasses the draft
+	d, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 	d.ReviewerNote = b.ReviewerNote
 
 	if validationErrs := d.Validate(); validationErrs != nil {
@@ -153,7 +163,7 @@ func UpdateReviewerNote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
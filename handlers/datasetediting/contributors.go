@@ -226,7 +226,7 @@ func CreateContributor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -446,7 +446,7 @@ func UpdateContributor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -531,7 +531,7 @@ func DeleteContributor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -569,7 +569,7 @@ func OrderContributors(w http.ResponseWriter, r *http.Request) {
 	}
 	dataset.SetContributors(b.Role, newContributors)
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -26,7 +26,12 @@ func ConfirmDelete(w http.ResponseWriter, r *http.Request) {
 
 func Delete(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	dataset := ctx.GetDataset(r)
+	// delete the current version, this also discards a pending draft
+	dataset, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	if !c.Repo.CanDeleteDataset(c.User, dataset) {
 		c.HandleError(w, r, httperror.Forbidden)
@@ -35,7 +40,7 @@ func Delete(w http.ResponseWriter, r *http.Request) {
 
 	dataset.Status = "deleted"
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
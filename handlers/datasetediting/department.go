@@ -76,7 +76,7 @@ func CreateDepartment(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err = c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -135,7 +135,7 @@ func DeleteDepartment(w http.ResponseWriter, r *http.Request) {
 
 	d.RemoveOrganization(b.DepartmentID)
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
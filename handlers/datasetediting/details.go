@@ -114,7 +114,7 @@ func UpdateDetails(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
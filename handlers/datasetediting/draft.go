@@ -0,0 +1,111 @@
+package datasetediting
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/diffing"
+	"github.com/ugent-library/biblio-backoffice/localize"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+	"github.com/ugent-library/biblio-backoffice/views"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
+	"github.com/ugent-library/httperror"
+	"github.com/ugent-library/okay"
+)
+
+// ReviewDraft shows the differences between the public version and the
+// working copy of a dataset
+func ReviewDraft(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	d := ctx.GetDataset(r)
+
+	if !ctx.HasDatasetDraft(r) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+
+	args, err := reviewDraftArgs(c, d)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	views.ShowModal(views.ReviewDraft(c, args)).Render(r.Context(), w)
+}
+
+func PublishDraft(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	d := ctx.GetDataset(r)
+
+	_, err := c.Repo.PublishDatasetDraft(r.Context(), d.ID, c.User)
+
+	var validationErrs *okay.Errors
+	var conflict *snapstore.Conflict
+	var errs []string
+	switch {
+	case errors.As(err, &validationErrs):
+		errs = localize.ValidationErrors(c.Loc, validationErrs)
+	case errors.As(err, &conflict):
+		errs = []string{c.Loc.Get("dataset.conflict_error_reload")}
+	case errors.Is(err, models.ErrNotFound):
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	case err != nil:
+		c.HandleError(w, r, err)
+		return
+	}
+	if len(errs) > 0 {
+		args, err := reviewDraftArgs(c, d)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		args.Errors = errs
+		views.ReplaceModal(views.ReviewDraft(c, args)).Render(r.Context(), w)
+		return
+	}
+
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Changes were successfully published.</p>")
+
+	c.PersistFlash(w, *flash)
+
+	w.Header().Set("HX-Redirect", c.PathTo("dataset", "id", d.ID).String())
+}
+
+func DiscardDraft(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	d := ctx.GetDataset(r)
+
+	if err := c.Repo.DiscardDatasetDraft(d.ID); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Changes were successfully discarded.</p>")
+
+	c.PersistFlash(w, *flash)
+
+	w.Header().Set("HX-Redirect", c.PathTo("dataset", "id", d.ID).String())
+}
+
+func reviewDraftArgs(c *ctx.Ctx, d *models.Dataset) (views.ReviewDraftArgs, error) {
+	current, err := c.Repo.GetDataset(d.ID)
+	if err != nil {
+		return views.ReviewDraftArgs{}, err
+	}
+	changes, err := diffing.Datasets(current, d)
+	if err != nil {
+		return views.ReviewDraftArgs{}, err
+	}
+	return views.ReviewDraftArgs{
+		PublishURL: c.PathTo("dataset_publish_draft", "id", d.ID).String(),
+		DiscardURL: c.PathTo("dataset_discard_draft", "id", d.ID).String(),
+		Changes:    changes,
+	}, nil
+}
@@ -61,7 +61,7 @@ func CreateLink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -139,7 +139,7 @@ func UpdateLink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -191,7 +191,7 @@ func DeleteLink(w http.ResponseWriter, r *http.Request) {
 
 	d.RemoveLink(b.LinkID)
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
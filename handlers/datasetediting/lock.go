@@ -14,7 +14,12 @@ import (
 
 func Lock(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	dataset := ctx.GetDataset(r)
+	// lock the current version, a pending draft is rebased on top of it
+	dataset, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	dataset.Locked = true
 
@@ -26,7 +31,7 @@ func Lock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(dataset.SnapshotID, dataset, c.User)
+	err = c.Repo.UpdateDataset(dataset.SnapshotID, dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -50,7 +55,12 @@ func Lock(w http.ResponseWriter, r *http.Request) {
 
 func Unlock(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	dataset := ctx.GetDataset(r)
+	// unlock the current version, a pending draft is rebased on top of it
+	dataset, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	dataset.Locked = false
 
@@ -62,7 +72,7 @@ func Unlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(dataset.SnapshotID, dataset, c.User)
+	err = c.Repo.UpdateDataset(dataset.SnapshotID, dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -74,7 +74,7 @@ func CreateProject(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err = c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -131,7 +131,7 @@ func DeleteProject(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), d, c.User)
+	err := c.Repo.EditDataset(r.Header.Get("If-Match"), d, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -65,7 +65,7 @@ func CreatePublication(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// TODO reduce calls to repository
-	p, err := c.Repo.GetPublication(b.PublicationID)
+	p, err := c.Repo.GetPublicationWorkingCopy(b.PublicationID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -74,14 +74,14 @@ func CreatePublication(w http.ResponseWriter, r *http.Request) {
 	// TODO handle validation errors
 	// TODO pass If-Match
 	// TODO handle conflict
-	err = c.Repo.AddPublicationDataset(p, dataset, c.User)
+	err = c.Repo.LinkPublicationDataset(p, dataset, c.User)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
 	}
 
 	// Refresh the ctx.Dataset: it still carries the old snapshotID
-	dataset, err = c.Repo.GetDataset(dataset.ID)
+	dataset, err = c.Repo.GetDatasetWorkingCopy(dataset.ID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -130,7 +130,7 @@ func DeletePublication(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// TODO reduce calls to repository
-	p, err := c.Repo.GetPublication(b.PublicationID)
+	p, err := c.Repo.GetPublicationWorkingCopy(b.PublicationID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -139,7 +139,7 @@ func DeletePublication(w http.ResponseWriter, r *http.Request) {
 	// TODO handle validation errors
 	// TODO pass If-Match
 	// TODO handle conflict
-	err = c.Repo.RemovePublicationDataset(p, dataset, c.User)
+	err = c.Repo.UnlinkPublicationDataset(p, dataset, c.User)
 
 	if err != nil {
 		c.HandleError(w, r, err)
@@ -147,7 +147,7 @@ func DeletePublication(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Refresh the dataset since it still caries the old snapshotid
-	dataset, err = c.Repo.GetDataset(dataset.ID)
+	dataset, err = c.Repo.GetDatasetWorkingCopy(dataset.ID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
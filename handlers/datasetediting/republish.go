@@ -23,7 +23,12 @@ func ConfirmRepublish(w http.ResponseWriter, r *http.Request) {
 
 func Republish(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	dataset := ctx.GetDataset(r)
+	// republish the current version, never a draft
+	dataset, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	if !c.Repo.CanPublishDataset(c.User, dataset) {
 		c.HandleError(w, r, httperror.Forbidden)
@@ -38,7 +43,7 @@ func Republish(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
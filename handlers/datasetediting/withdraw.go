@@ -23,7 +23,12 @@ func ConfirmWithdraw(w http.ResponseWriter, r *http.Request) {
 
 func Withdraw(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	dataset := ctx.GetDataset(r)
+	// withdraw the current version, this also discards a pending draft
+	dataset, err := c.Repo.GetDataset(ctx.GetDataset(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	if !c.Repo.CanWithdrawDataset(c.User, dataset) {
 		c.HandleError(w, r, httperror.Forbidden)
@@ -38,7 +43,7 @@ func Withdraw(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
+	err = c.Repo.UpdateDataset(r.Header.Get("If-Match"), dataset, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
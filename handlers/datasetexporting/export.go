@@ -1,16 +1,17 @@
 package datasetexporting
 
 import (
-	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/ugent-library/biblio-backoffice/ctx"
 	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
 	"github.com/ugent-library/bind"
 	"github.com/ugent-library/httperror"
 )
 
+// ExportByCurationSearch queues an export job, the result can be downloaded
+// from the export jobs page when it is ready
 func ExportByCurationSearch(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
 
@@ -19,12 +20,10 @@ func ExportByCurationSearch(w http.ResponseWriter, r *http.Request) {
 		format = "xlsx"
 	}
 
-	exporterFactory, exporterFactoryFound := c.DatasetListExporters[format]
-	if !exporterFactoryFound {
+	if _, ok := c.DatasetListExporters[format]; !ok {
 		c.HandleError(w, r, httperror.NotFound)
 		return
 	}
-	exporter := exporterFactory(w)
 
 	searchArgs := models.NewSearchArgs()
 	if err := bind.Request(r, searchArgs); err != nil {
@@ -32,34 +31,21 @@ func ExportByCurationSearch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	searcher := c.DatasetSearchIndex.WithScope("status", "private", "public", "returned")
-	searcherErr := searcher.Each(searchArgs, 10000, func(dataset *models.Dataset) {
-		exporter.Add(dataset)
-	})
-
-	if searcherErr != nil {
-		c.HandleError(w, r, searcherErr)
-		return
+	job := &models.ExportJob{
+		UserID:     c.User.ID,
+		Entity:     "dataset",
+		Format:     format,
+		SearchArgs: searchArgs,
 	}
-
-	/*
-		TODO:
-			- move to /tasks. Then we need to store this temporary file somewhere
-			For now keep maxSize
-			- use stream writer. Now the exporters assume a temporary file,
-			and then write everything at once to the http writer. Possible caveats
-			using a stream writer is that the headers need to proceed the content,
-			during which an error may occur. In which case you would get a non working
-			file, with the right file name, containing the server error
-	*/
-	fileName := fmt.Sprintf("datasets_%s.xlsx", time.Now().Format("2006-01-02_15-04-05"))
-	contentDisposition := fmt.Sprintf("attachment;filename=%s", fileName)
-	w.Header().Set("Content-Type", exporter.GetContentType())
-	w.Header().Set("Content-Disposition", contentDisposition)
-
-	if err := exporter.Flush(); err != nil {
+	if err := c.Repo.AddExportJob(r.Context(), job); err != nil {
 		c.HandleError(w, r, err)
 		return
 	}
 
+	f := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Your export has been queued. You can download it here when it's ready.</p>")
+	c.PersistFlash(w, *f)
+
+	http.Redirect(w, r, c.PathTo("export_jobs").String(), http.StatusSeeOther)
 }
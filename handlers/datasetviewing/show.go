@@ -1,11 +1,15 @@
 package datasetviewing
 
 import (
+	"errors"
 	"net/http"
 
 	"slices"
 
 	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/diffing"
+	"github.com/ugent-library/biblio-backoffice/handlers/comments"
+	"github.com/ugent-library/biblio-backoffice/models"
 	datasetviews "github.com/ugent-library/biblio-backoffice/views/dataset"
 )
 
@@ -22,7 +26,15 @@ func Show(w http.ResponseWriter, r *http.Request) {
 	}
 	c.SubNav = activeSubNav
 
-	datasetviews.Show(c, ctx.GetDataset(r), r.URL.Query().Get("redirect-url")).Render(r.Context(), w)
+	dataset := ctx.GetDataset(r)
+
+	review, err := c.Repo.GetOpenReview(r.Context(), "dataset", dataset.ID)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	datasetviews.Show(c, dataset, r.URL.Query().Get("redirect-url"), ctx.HasDatasetDraft(r), review).Render(r.Context(), w)
 }
 
 func ShowDescription(w http.ResponseWriter, r *http.Request) {
@@ -64,5 +76,27 @@ func ShowActivity(w http.ResponseWriter, r *http.Request) {
 	if redirectURL == "" {
 		redirectURL = c.PathTo("datasets").String()
 	}
-	datasetviews.Activity(ctx.Get(r), ctx.GetDataset(r), redirectURL).Render(r.Context(), w)
+	dataset := ctx.GetDataset(r)
+
+	var history []*diffing.Entry
+	if c.Repo.CanCurate(c.User) {
+		h, err := c.Repo.DatasetChanges(r.Context(), dataset.ID)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		history = h
+	}
+
+	var addCommentURL string
+	if c.Repo.CanEditDataset(c.User, dataset) {
+		addCommentURL = c.PathTo("dataset_add_comment", "id", dataset.ID).String()
+	}
+	commentsArgs, err := comments.Args(r, c, "dataset", dataset.ID, addCommentURL)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	datasetviews.Activity(c, dataset, redirectURL, history, commentsArgs).Render(r.Context(), w)
 }
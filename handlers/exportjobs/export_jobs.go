@@ -0,0 +1,83 @@
+package exportjobs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/models"
+	exportjobviews "github.com/ugent-library/biblio-backoffice/views/exportjob"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+)
+
+const listLimit = 50
+
+func Index(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	jobs, err := c.Repo.GetExportJobsByUser(r.Context(), c.User.ID, listLimit)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	exportjobviews.Index(c, jobs).Render(r.Context(), w)
+}
+
+// List renders the job list only, the index page polls it while there are
+// unfinished jobs
+func List(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	jobs, err := c.Repo.GetExportJobsByUser(r.Context(), c.User.ID, listLimit)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	exportjobviews.List(c, jobs).Render(r.Context(), w)
+}
+
+func Download(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	job, err := c.Repo.GetExportJob(r.Context(), bind.PathValue(r, "id"))
+	if errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	// jobs are private to the curator that started them
+	if job.UserID != c.User.ID {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+
+	if !job.Downloadable() {
+		c.HandleError(w, r, httperror.Gone)
+		return
+	}
+
+	b, err := c.FileStore.Get(r.Context(), job.FileID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+	defer b.Close()
+
+	w.Header().Set("Content-Type", job.ContentType)
+	w.Header().Set(
+		"Content-Disposition",
+		fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(job.FileName)),
+	)
+
+	io.Copy(w, b)
+}
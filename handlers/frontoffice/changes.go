@@ -0,0 +1,136 @@
+package frontoffice
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/changefeed"
+	"github.com/ugent-library/biblio-backoffice/frontoffice"
+	internal_time "github.com/ugent-library/biblio-backoffice/time"
+)
+
+const (
+	// changes made by other processes are picked up at this interval
+	changesPollInterval = 30 * time.Second
+	changesBatchSize    = 100
+)
+
+// GetChanges streams the current version of each publication and dataset that
+// changes as server-sent events. Each event has the record type as name and a
+// cursor as id. Clients resume with the Last-Event-ID header or the cursor
+// query parameter. A new stream without cursor starts at updated_since or at
+// the current time.
+func (h *Handler) GetChanges(w http.ResponseWriter, r *http.Request) {
+	var cursor changefeed.Cursor
+	if str := r.Header.Get("Last-Event-ID"); str != "" {
+		c, err := changefeed.ParseCursor(str)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		cursor = c
+	} else if str := r.URL.Query().Get("cursor"); str != "" {
+		c, err := changefeed.ParseCursor(str)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		cursor = c
+	} else {
+		t := time.Now()
+		if str := r.URL.Query().Get("updated_since"); str != "" {
+			since, err := internal_time.ParseTimeUTC(str)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+			t = *since
+		}
+		c, err := h.Repo.ChangesCursor(r.Context(), t)
+		if err != nil {
+			h.Log.Error("unable to retrieve change feed position", "error", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		cursor = c
+	}
+
+	// the stream outlives the server write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		h.Log.Warn("unable to clear write deadline", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// let the client know where the stream starts
+	if err := writeEvent(w, "cursor", cursor.String(), []byte(cursor.String())); err != nil {
+		return
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(changesPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// subscribe before querying so no change is missed in between
+		changed := h.ChangeFeed.Changed()
+
+		for {
+			changes, err := h.Repo.ChangesAfter(r.Context(), cursor, changesBatchSize)
+			if err != nil {
+				if r.Context().Err() == nil {
+					h.Log.Error("unable to retrieve changes", "cursor", cursor.String(), "error", err)
+				}
+				return
+			}
+			for _, change := range changes {
+				var rec *frontoffice.Record
+				if change.Publication != nil {
+					rec = frontoffice.MapPublication(change.Publication, h.Repo)
+				} else {
+					rec = frontoffice.MapDataset(change.Dataset, h.Repo)
+				}
+				data, err := json.Marshal(rec)
+				if err != nil {
+					h.Log.Error("unable to encode change", "cursor", cursor.String(), "error", err)
+					return
+				}
+				cursor = change.Cursor()
+				if err := writeEvent(w, change.RecordType(), cursor.String(), data); err != nil {
+					return
+				}
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			if len(changes) < changesBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-changed:
+		case <-ticker.C:
+			// keep idle connections open
+			if _, err := io.WriteString(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeEvent(w io.Writer, event, id string, data []byte) error {
+	_, err := fmt.Fprintf(w, "event: %s\nid: %s\ndata: %s\n\n", event, id, data)
+	return err
+}
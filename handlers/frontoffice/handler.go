@@ -8,12 +8,14 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gorilla/sessions"
 	"github.com/jpillora/ipfilter"
 	"github.com/nics/ich"
 	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/changefeed"
 	"github.com/ugent-library/biblio-backoffice/ctx"
 	"github.com/ugent-library/biblio-backoffice/frontoffice"
 	"github.com/ugent-library/biblio-backoffice/models"
@@ -33,6 +35,7 @@ type Handler struct {
 	SessionStore sessions.Store
 	SessionName  string
 	UserService  backends.UserService
+	ChangeFeed   *changefeed.Feed
 }
 
 type Hits[T any] struct {
@@ -55,6 +58,20 @@ func (h *Handler) GetPublication(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// merged publications redirect to the publication they were merged into
+	if p.Status == "deleted" {
+		targetID, err := h.Repo.GetPublicationRedirect(r.Context(), id)
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			h.Log.Error("unable to fetch publication redirect", "id", id, "error", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if targetID != "" {
+			http.Redirect(w, r, strings.Replace(r.URL.Path, "/publication/"+id, "/publication/"+targetID, 1), http.StatusMovedPermanently)
+			return
+		}
+	}
+
 	httpx.RenderJSON(w, 200, frontoffice.MapPublication(p, h.Repo))
 }
 
@@ -0,0 +1,114 @@
+package notifications
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/pagination"
+	"github.com/ugent-library/biblio-backoffice/views"
+	notificationviews "github.com/ugent-library/biblio-backoffice/views/notification"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+)
+
+func Index(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	searchArgs := models.NewSearchArgs()
+	if err := bind.Request(r, searchArgs); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+	searchArgs.Cleanup()
+
+	total, notifications, err := c.Repo.GetNotifications(r.Context(), c.User.IDs, searchArgs)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	unread, err := c.Repo.CountUnreadNotifications(r.Context(), c.User.IDs)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	notificationviews.Index(c, notificationviews.IndexArgs{
+		SearchArgs: searchArgs,
+		Pagination: pagination.Pagination{
+			Offset: searchArgs.Offset(),
+			Limit:  searchArgs.Limit(),
+			Total:  total,
+		},
+		Notifications: notifications,
+		Unread:        unread,
+	}).Render(r.Context(), w)
+}
+
+func Badge(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	unread, err := c.Repo.CountUnreadNotifications(r.Context(), c.User.IDs)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	views.NotificationsBadge(c, unread).Render(r.Context(), w)
+}
+
+// Show marks a notification as read and redirects to what it is about
+func Show(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	n, err := c.Repo.GetNotification(r.Context(), bind.PathValue(r, "id"))
+	if errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+	if !slices.Contains(c.User.IDs, n.PersonID) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+
+	if err := c.Repo.MarkNotificationRead(r.Context(), n.ID); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	var redirectURL string
+	switch n.RecordType {
+	case "publication":
+		redirectURL = c.PathTo("publication", "id", n.RecordID).String()
+	case "dataset":
+		redirectURL = c.PathTo("dataset", "id", n.RecordID).String()
+	case "candidate_record":
+		redirectURL = c.PathTo("candidate_records").String()
+	default:
+		if n.Kind == models.NotificationProxyAdded {
+			redirectURL = c.PathTo("proxy_settings").String()
+		} else {
+			redirectURL = c.PathTo("notifications").String()
+		}
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+func MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	if err := c.Repo.MarkAllNotificationsRead(r.Context(), c.User.IDs); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	w.Header().Set("HX-Redirect", c.PathTo("notifications").String())
+}
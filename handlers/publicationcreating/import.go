@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/duplicates"
 	"github.com/ugent-library/biblio-backoffice/localize"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/biblio-backoffice/snapstore"
@@ -57,30 +57,35 @@ func AddSingleImportConfirm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// check for duplicates
-	if b.Source == "crossref" && b.Identifier != "" {
-		args := models.NewSearchArgs().
-			WithFilter("identifier", strings.ToLower(b.Identifier)).
-			WithFilter("status", "public")
+	if b.Identifier == "" {
+		AddSingleImport(w, r)
+		return
+	}
 
-		existing, err := c.PublicationSearchIndex.Search(args)
-		if err != nil {
-			c.HandleError(w, r, err)
-			return
-		}
+	p, err := fetchPublicationByIdentifier(c, b.Source, b.Identifier)
+	if err != nil {
+		renderImportFailed(w, r, b, err)
+		return
+	}
 
-		if existing.Total > 0 {
-			pages.AddIdentifier(c, pages.AddIdentifierArgs{
-				Step:                 1,
-				Source:               b.Source,
-				Identifier:           b.Identifier,
-				DuplicatePublication: existing.Hits[0],
-			}).Render(r.Context(), w)
-			return
-		}
+	// check for duplicates
+	matches, err := duplicates.NewDetector(duplicates.Scope(c.PublicationSearchIndex, c.UserRole == "curator")).Find(p)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	if len(matches) > 0 {
+		pages.AddIdentifier(c, pages.AddIdentifierArgs{
+			Step:       1,
+			Source:     b.Source,
+			Identifier: b.Identifier,
+			Duplicates: matches,
+		}).Render(r.Context(), w)
+		return
 	}
 
-	AddSingleImport(w, r)
+	addSingleImport(w, r, b, p)
 }
 
 func AddSingleImport(w http.ResponseWriter, r *http.Request) {
@@ -101,18 +106,7 @@ func AddSingleImport(w http.ResponseWriter, r *http.Request) {
 	if b.Identifier != "" {
 		p, err = fetchPublicationByIdentifier(c, b.Source, b.Identifier)
 		if err != nil {
-			c.Log.Warn("import single publication: could not fetch publication", "errors", err, "publication", b.Identifier, "user", c.User.ID)
-
-			flash := flash.SimpleFlash().
-				WithLevel("error").
-				WithBody(c.Loc.Get("publication.single_import.import_by_id.import_failed"))
-			c.Flash = append(c.Flash, *flash)
-
-			pages.AddIdentifier(c, pages.AddIdentifierArgs{
-				Step:       1,
-				Source:     b.Source,
-				Identifier: b.Identifier,
-			}).Render(r.Context(), w)
+			renderImportFailed(w, r, b, err)
 			return
 		}
 	} else {
@@ -120,6 +114,29 @@ func AddSingleImport(w http.ResponseWriter, r *http.Request) {
 		p = &models.Publication{Type: b.PublicationType}
 	}
 
+	addSingleImport(w, r, b, p)
+}
+
+func renderImportFailed(w http.ResponseWriter, r *http.Request, b BindImportSingle, err error) {
+	c := ctx.Get(r)
+
+	c.Log.Warn("import single publication: could not fetch publication", "errors", err, "publication", b.Identifier, "user", c.User.ID)
+
+	flash := flash.SimpleFlash().
+		WithLevel("error").
+		WithBody(c.Loc.Get("publication.single_import.import_by_id.import_failed"))
+	c.Flash = append(c.Flash, *flash)
+
+	pages.AddIdentifier(c, pages.AddIdentifierArgs{
+		Step:       1,
+		Source:     b.Source,
+		Identifier: b.Identifier,
+	}).Render(r.Context(), w)
+}
+
+func addSingleImport(w http.ResponseWriter, r *http.Request, b BindImportSingle, p *models.Publication) {
+	c := ctx.Get(r)
+
 	p.ID = ulid.Make().String()
 	p.CreatorID = c.User.ID
 	p.Creator = c.User
@@ -144,8 +161,7 @@ func AddSingleImport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = c.Repo.SavePublication(p, c.User)
-	if err != nil {
+	if err := c.Repo.SavePublication(p, c.User); err != nil {
 		c.HandleError(w, r, err)
 		return
 	}
@@ -327,12 +343,29 @@ func AddMultipleConfirm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// check the publications on this page for duplicates outside the batch
+	detector := duplicates.NewDetector(
+		duplicates.Scope(c.PublicationSearchIndex, c.UserRole == "curator").WithScope("!batch_id", batchID),
+	)
+	dups := map[string][]*duplicates.Match{}
+	for _, hit := range hits.Hits {
+		matches, err := detector.Find(hit)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		if len(matches) > 0 {
+			dups[hit.ID] = matches
+		}
+	}
+
 	pages.AddMultipleConfirm(c, pages.AddMultipleConfirmArgs{
 		Step:        2,
 		RedirectURL: r.URL.String(),
 		BatchID:     batchID,
 		SearchArgs:  searchArgs,
 		Hits:        hits,
+		Duplicates:  dups,
 	}).Render(r.Context(), w)
 }
 
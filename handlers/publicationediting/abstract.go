@@ -61,7 +61,7 @@ func CreateAbstract(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -140,7 +140,7 @@ func UpdateAbstract(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -191,7 +191,7 @@ func DeleteAbstract(w http.ResponseWriter, r *http.Request) {
 
 	p.RemoveAbstract(b.AbstractID)
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -55,7 +55,7 @@ func UpdateMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -92,7 +92,12 @@ func UpdateReviewerTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	p := ctx.GetPublication(r)
+	// reviewer tags aren't part of the content and bypass the draft
+	p, err := c.Repo.GetPublication(ctx.GetPublication(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 	p.ReviewerTags = b.ReviewerTags
 
 	if validationErrs := p.Validate(); validationErrs != nil {
@@ -104,7 +109,7 @@ func UpdateReviewerTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -141,7 +146,12 @@ func UpdateReviewerNote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	p := ctx.GetPublication(r)
+	// the reviewer note isn't part of the content and bypasses the draft
+	p, err := c.Repo.GetPublication(ctx.GetPublication(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 	p.ReviewerNote = b.ReviewerNote
 
 	if validationErrs := p.Validate(); validationErrs != nil {
@@ -153,7 +163,7 @@ func UpdateReviewerNote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
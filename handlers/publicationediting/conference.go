@@ -48,7 +48,7 @@ func UpdateConference(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -227,7 +227,7 @@ func CreateContributor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -453,7 +453,7 @@ func UpdateContributor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -539,7 +539,7 @@ func DeleteContributor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -574,7 +574,7 @@ func OrderContributors(w http.ResponseWriter, r *http.Request) {
 	}
 	p.SetContributors(b.Role, newContributors)
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
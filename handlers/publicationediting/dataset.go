@@ -67,7 +67,7 @@ func CreateDataset(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// TODO reduce calls to repository
-	d, err := c.Repo.GetDataset(b.DatasetID)
+	d, err := c.Repo.GetDatasetWorkingCopy(b.DatasetID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -76,14 +76,14 @@ func CreateDataset(w http.ResponseWriter, r *http.Request) {
 	// TODO handle validation errors
 	// TODO pass If-Match
 	// TODO handle conflict
-	err = c.Repo.AddPublicationDataset(publication, d, c.User)
+	err = c.Repo.LinkPublicationDataset(publication, d, c.User)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
 	}
 
 	// Refresh the ctx.Publication: it still carries the old snapshotID
-	publication, err = c.Repo.GetPublication(publication.ID)
+	publication, err = c.Repo.GetPublicationWorkingCopy(publication.ID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -137,7 +137,7 @@ func DeleteDataset(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// TODO reduce calls to repository
-	d, err := c.Repo.GetDataset(b.DatasetID)
+	d, err := c.Repo.GetDatasetWorkingCopy(b.DatasetID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -146,7 +146,7 @@ func DeleteDataset(w http.ResponseWriter, r *http.Request) {
 	// TODO handle validation errors
 	// TODO pass If-Match
 	// TODO handle conflict
-	err = c.Repo.RemovePublicationDataset(publication, d, c.User)
+	err = c.Repo.UnlinkPublicationDataset(publication, d, c.User)
 
 	if err != nil {
 		c.HandleError(w, r, err)
@@ -154,7 +154,7 @@ func DeleteDataset(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Refresh the ctx.Publication: it still carries the old snapshotID
-	publication, err = c.Repo.GetPublication(publication.ID)
+	publication, err = c.Repo.GetPublicationWorkingCopy(publication.ID)
 	if err != nil {
 		c.HandleError(w, r, err)
 		return
@@ -26,7 +26,12 @@ func ConfirmDelete(w http.ResponseWriter, r *http.Request) {
 
 func Delete(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	publication := ctx.GetPublication(r)
+	// delete the current version, this also discards a pending draft
+	publication, err := c.Repo.GetPublication(ctx.GetPublication(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	if !c.Repo.CanDeletePublication(c.User, publication) {
 		c.HandleError(w, r, httperror.Forbidden)
@@ -35,7 +40,7 @@ func Delete(w http.ResponseWriter, r *http.Request) {
 
 	publication.Status = "deleted"
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), publication, c.User)
+	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), publication, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -76,7 +76,7 @@ func CreateDepartment(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err = c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -137,7 +137,7 @@ func DeleteDepartment(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
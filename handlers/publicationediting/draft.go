@@ -0,0 +1,111 @@
+package publicationediting
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/diffing"
+	"github.com/ugent-library/biblio-backoffice/localize"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+	"github.com/ugent-library/biblio-backoffice/views"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
+	"github.com/ugent-library/httperror"
+	"github.com/ugent-library/okay"
+)
+
+// ReviewDraft shows the differences between the public version and the
+// working copy of a publication
+func ReviewDraft(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	if !ctx.HasPublicationDraft(r) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+
+	args, err := reviewDraftArgs(c, p)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	views.ShowModal(views.ReviewDraft(c, args)).Render(r.Context(), w)
+}
+
+func PublishDraft(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	_, err := c.Repo.PublishPublicationDraft(r.Context(), p.ID, c.User)
+
+	var validationErrs *okay.Errors
+	var conflict *snapstore.Conflict
+	var errs []string
+	switch {
+	case errors.As(err, &validationErrs):
+		errs = localize.ValidationErrors(c.Loc, validationErrs)
+	case errors.As(err, &conflict):
+		errs = []string{c.Loc.Get("publication.conflict_error_reload")}
+	case errors.Is(err, models.ErrNotFound):
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	case err != nil:
+		c.HandleError(w, r, err)
+		return
+	}
+	if len(errs) > 0 {
+		args, err := reviewDraftArgs(c, p)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		args.Errors = errs
+		views.ReplaceModal(views.ReviewDraft(c, args)).Render(r.Context(), w)
+		return
+	}
+
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Changes were successfully published.</p>")
+
+	c.PersistFlash(w, *flash)
+
+	w.Header().Set("HX-Redirect", c.PathTo("publication", "id", p.ID).String())
+}
+
+func DiscardDraft(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	if err := c.Repo.DiscardPublicationDraft(p.ID); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Changes were successfully discarded.</p>")
+
+	c.PersistFlash(w, *flash)
+
+	w.Header().Set("HX-Redirect", c.PathTo("publication", "id", p.ID).String())
+}
+
+func reviewDraftArgs(c *ctx.Ctx, p *models.Publication) (views.ReviewDraftArgs, error) {
+	current, err := c.Repo.GetPublication(p.ID)
+	if err != nil {
+		return views.ReviewDraftArgs{}, err
+	}
+	changes, err := diffing.Publications(current, p)
+	if err != nil {
+		return views.ReviewDraftArgs{}, err
+	}
+	return views.ReviewDraftArgs{
+		PublishURL: c.PathTo("publication_publish_draft", "id", p.ID).String(),
+		DiscardURL: c.PathTo("publication_discard_draft", "id", p.ID).String(),
+		Changes:    changes,
+	}, nil
+}
@@ -99,7 +99,7 @@ func UploadFile(w http.ResponseWriter, r *http.Request) {
 	*/
 	p.AddFile(pubFile)
 
-	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err = c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -240,7 +240,7 @@ func UpdateFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -293,7 +293,7 @@ func DeleteFile(w http.ResponseWriter, r *http.Request) {
 
 	p.RemoveFile(b.FileID)
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
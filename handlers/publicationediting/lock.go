@@ -14,7 +14,12 @@ import (
 
 func Lock(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	publication := ctx.GetPublication(r)
+	// lock the current version, a pending draft is rebased on top of it
+	publication, err := c.Repo.GetPublication(ctx.GetPublication(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	publication.Locked = true
 
@@ -26,7 +31,7 @@ func Lock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(publication.SnapshotID, publication, c.User)
+	err = c.Repo.UpdatePublication(publication.SnapshotID, publication, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -50,7 +55,12 @@ func Lock(w http.ResponseWriter, r *http.Request) {
 
 func Unlock(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	publication := ctx.GetPublication(r)
+	// unlock the current version, a pending draft is rebased on top of it
+	publication, err := c.Repo.GetPublication(ctx.GetPublication(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	publication.Locked = false
 
@@ -62,7 +72,7 @@ func Unlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(publication.SnapshotID, publication, c.User)
+	err = c.Repo.UpdatePublication(publication.SnapshotID, publication, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
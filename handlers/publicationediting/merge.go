@@ -0,0 +1,119 @@
+package publicationediting
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/duplicates"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/repositories"
+	"github.com/ugent-library/biblio-backoffice/views"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
+	publicationviews "github.com/ugent-library/biblio-backoffice/views/publication"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+)
+
+type BindMerge struct {
+	SurvivorID      string `form:"survivor_id"`
+	OtherSurvivorID string `form:"other_survivor_id"`
+}
+
+func ConfirmMerge(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	matches, err := duplicates.NewDetector(duplicates.Scope(c.PublicationSearchIndex, true)).Find(p)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	views.ShowModal(publicationviews.ConfirmMerge(c, publicationviews.ConfirmMergeArgs{
+		Publication: p,
+		Duplicates:  matches,
+	})).Render(r.Context(), w)
+}
+
+// Merge merges the current publication into the chosen survivor and
+// redirects to the survivor
+func Merge(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	b := BindMerge{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	survivorID := strings.TrimSpace(b.OtherSurvivorID)
+	if survivorID == "" {
+		survivorID = b.SurvivorID
+	}
+
+	renderError := func(msg string) {
+		matches, err := duplicates.NewDetector(duplicates.Scope(c.PublicationSearchIndex, true)).Find(p)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		views.ReplaceModal(publicationviews.ConfirmMerge(c, publicationviews.ConfirmMergeArgs{
+			Publication: p,
+			Duplicates:  matches,
+			SurvivorID:  b.SurvivorID,
+			Error:       msg,
+		})).Render(r.Context(), w)
+	}
+
+	if survivorID == "" {
+		renderError("Choose the publication to merge into.")
+		return
+	}
+	if survivorID == p.ID {
+		renderError("A publication can't be merged into itself.")
+		return
+	}
+	survivor, err := c.Repo.GetPublication(survivorID)
+	if errors.Is(err, models.ErrNotFound) {
+		renderError("Publication " + survivorID + " doesn't exist.")
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+	if survivor.Status == "deleted" {
+		renderError("Publication " + survivorID + " is deleted.")
+		return
+	}
+
+	_, err = c.Repo.MergePublications(r.Context(), survivor.ID, p.ID, c.User)
+	if errors.Is(err, repositories.ErrMergeLocked) {
+		renderError("Locked publications can't be merged, unlock them first.")
+		return
+	}
+	if errors.Is(err, repositories.ErrMergeHasDraft) {
+		renderError("Publish or discard the unpublished changes of both publications first.")
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Publication was successfully merged.</p>")
+
+	c.PersistFlash(w, *flash)
+
+	// TODO temporary fix until we can figure out a way let ES notify this handler that it did its thing.
+	// see: https://github.com/ugent-library/biblio-backoffice/issues/590
+	time.Sleep(1250 * time.Millisecond)
+
+	w.Header().Set("HX-Redirect", c.PathTo("publication", "id", survivor.ID).String())
+}
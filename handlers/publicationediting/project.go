@@ -73,7 +73,7 @@ func CreateProject(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err = c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
@@ -130,7 +130,7 @@ func DeleteProject(w http.ResponseWriter, r *http.Request) {
 
 	// TODO handle validation errors
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), p, c.User)
+	err := c.Repo.EditPublication(r.Header.Get("If-Match"), p, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
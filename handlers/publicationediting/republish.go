@@ -23,7 +23,12 @@ func ConfirmRepublish(w http.ResponseWriter, r *http.Request) {
 
 func Republish(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
-	publication := ctx.GetPublication(r)
+	// republish the current version, never a draft
+	publication, err := c.Repo.GetPublication(ctx.GetPublication(r).ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
 
 	if !c.Repo.CanPublishPublication(c.User, publication) {
 		c.HandleError(w, r, httperror.Forbidden)
@@ -38,7 +43,7 @@ func Republish(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.Repo.UpdatePublication(r.Header.Get("If-Match"), publication, c.User)
+	err = c.Repo.UpdatePublication(r.Header.Get("If-Match"), publication, c.User)
 
 	var conflict *snapstore.Conflict
 	if errors.As(err, &conflict) {
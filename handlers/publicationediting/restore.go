@@ -0,0 +1,107 @@
+package publicationediting
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/localize"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/repositories"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+	"github.com/ugent-library/biblio-backoffice/views"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+	"github.com/ugent-library/okay"
+)
+
+type BindRestore struct {
+	SnapshotID string `path:"snapshot_id"`
+	Reason     string `form:"reason"`
+}
+
+func ConfirmRestore(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	b := BindRestore{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	old, err := c.Repo.GetPublicationSnapshot(p.ID, b.SnapshotID)
+	if errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	views.ShowModal(views.ConfirmRestore(c, views.ConfirmRestoreArgs{
+		RestoreURL: c.PathTo("publication_restore", "id", p.ID, "snapshot_id", old.SnapshotID).String(),
+		DateFrom:   old.DateFrom,
+	})).Render(r.Context(), w)
+}
+
+func Restore(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+	p := ctx.GetPublication(r)
+
+	b := BindRestore{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+	b.Reason = strings.TrimSpace(b.Reason)
+
+	renderErrors := func(errs []string) {
+		views.ReplaceModal(views.ConfirmRestore(c, views.ConfirmRestoreArgs{
+			RestoreURL: c.PathTo("publication_restore", "id", p.ID, "snapshot_id", b.SnapshotID).String(),
+			Reason:     b.Reason,
+			Errors:     errs,
+		})).Render(r.Context(), w)
+	}
+
+	if b.Reason == "" {
+		renderErrors([]string{"Reason can't be empty."})
+		return
+	}
+
+	_, err := c.Repo.RestorePublication(r.Context(), p.ID, b.SnapshotID, b.Reason, c.User)
+
+	if errors.Is(err, repositories.ErrSnapshotIsCurrent) {
+		renderErrors([]string{"This version is the same as the current version."})
+		return
+	}
+	var validationErrs *okay.Errors
+	if errors.As(err, &validationErrs) {
+		renderErrors(localize.ValidationErrors(c.Loc, validationErrs))
+		return
+	}
+	var conflict *snapstore.Conflict
+	if errors.As(err, &conflict) {
+		renderErrors([]string{c.Loc.Get("publication.conflict_error_reload")})
+		return
+	}
+	if errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Publication was successfully restored.</p>")
+
+	c.PersistFlash(w, *flash)
+
+	w.Header().Set("HX-Redirect", c.PathTo("publication", "id", p.ID, "show", "activity").String())
+}
@@ -3,14 +3,27 @@ package publicationexporting
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
+	"github.com/ugent-library/biblio-backoffice/backends"
 	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/exporting"
+	"github.com/ugent-library/biblio-backoffice/handlers/publicationsearching"
 	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
 	"github.com/ugent-library/bind"
 	"github.com/ugent-library/httperror"
 )
 
+// reference manager formats that researchers can export to
+var userFormats = []string{"bibtex", "ris", "csl-json"}
+
+// researcher exports run in the request, bigger exports are curator jobs
+const maxUserExportSize = 10000
+
+// ExportByCurationSearch queues an export job, the result can be downloaded
+// from the export jobs page when it is ready
 func ExportByCurationSearch(w http.ResponseWriter, r *http.Request) {
 	c := ctx.Get(r)
 
@@ -19,6 +32,46 @@ func ExportByCurationSearch(w http.ResponseWriter, r *http.Request) {
 		format = "xlsx"
 	}
 
+	if _, ok := c.PublicationListExporters[format]; !ok {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
+
+	searchArgs := models.NewSearchArgs()
+	if err := bind.Request(r, searchArgs); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	job := &models.ExportJob{
+		UserID:     c.User.ID,
+		Entity:     "publication",
+		Format:     format,
+		SearchArgs: searchArgs,
+	}
+	if err := c.Repo.AddExportJob(r.Context(), job); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	f := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody("<p>Your export has been queued. You can download it here when it's ready.</p>")
+	c.PersistFlash(w, *f)
+
+	http.Redirect(w, r, c.PathTo("export_jobs").String(), http.StatusSeeOther)
+}
+
+// ExportBySearch lets researchers export their own publications in the
+// formats that reference managers understand
+func ExportBySearch(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	format := bind.PathValue(r, "format")
+	if !slices.Contains(userFormats, format) {
+		c.HandleError(w, r, httperror.NotFound)
+		return
+	}
 	exporterFactory, exporterFactoryFound := c.PublicationListExporters[format]
 	if !exporterFactoryFound {
 		c.HandleError(w, r, httperror.NotFound)
@@ -28,36 +81,48 @@ func ExportByCurationSearch(w http.ResponseWriter, r *http.Request) {
 
 	searchArgs := models.NewSearchArgs()
 	if err := bind.Request(r, searchArgs); err != nil {
-		c.HandleError(w, r, err)
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
 		return
 	}
-	searcher := c.PublicationSearchIndex.WithScope("status", "private", "public", "returned")
-	searcherErr := searcher.Each(searchArgs, 10000, func(pub *models.Publication) {
+	searchArgs.Cleanup()
+	if searchArgs.FilterFor("scope") == "" {
+		searchArgs.WithFilter("scope", "all")
+	}
+
+	searcher, _, err := publicationsearching.UserSearcher(c, searchArgs)
+	if err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	// the headers must be set before the first record is added, exporters
+	// start writing the response as soon as their buffer is full
+	setHeaders(w, format, exporter)
+
+	searcherErr := searcher.Each(r.Context(), searchArgs, maxUserExportSize, func(pub *models.Publication) {
 		exporter.Add(pub)
 	})
-
 	if searcherErr != nil {
-		c.HandleError(w, r, searcherErr)
+		handleExportError(w, r, searcherErr)
 		return
 	}
 
-	/*
-		TODO:
-			- move to /tasks. Then we need to store this temporary file somewhere
-			For now keep maxSize
-			- use stream writer. Now the exporters assume a temporary file,
-			and then write everything at once to the http writer. Possible caveats
-			using a stream writer is that the headers need to proceed the content,
-			during which an error may occur. In which case you would get a non working
-			file, with the right file name, containing the server error
-	*/
-	fileName := fmt.Sprintf("publications_%s.xlsx", time.Now().Format("2006-01-02_15-04-05"))
-	contentDisposition := fmt.Sprintf("attachment;filename=%s", fileName)
-	w.Header().Set("Content-Type", exporter.GetContentType())
-	w.Header().Set("Content-Disposition", contentDisposition)
-
 	if err := exporter.Flush(); err != nil {
-		c.HandleError(w, r, err)
+		handleExportError(w, r, err)
 		return
 	}
 }
+
+// handleExportError doesn't serve the error page as an attachment if nothing
+// was written yet. Otherwise the download is cut short.
+func handleExportError(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Del("Content-Disposition")
+	ctx.Get(r).HandleError(w, r, err)
+}
+
+func setHeaders(w http.ResponseWriter, format string, exporter backends.PublicationListExporter) {
+	fileName := exporting.FileName("publication", format, time.Now())
+	contentDisposition := fmt.Sprintf("attachment;filename=%s", fileName)
+	w.Header().Set("Content-Type", exporter.GetContentType())
+	w.Header().Set("Content-Disposition", contentDisposition)
+}
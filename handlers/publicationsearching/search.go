@@ -37,35 +37,12 @@ func Search(w http.ResponseWriter, r *http.Request) {
 		searchArgs.WithFilter("scope", "all")
 	}
 
-	searcher := c.PublicationSearchIndex.WithScope("status", "private", "public", "returned")
 	args := searchArgs.Clone()
-	var currentScope string
-
-	// view publications of proxy
-	personID := c.User.ID
-	if proxiedPersonID := args.FilterFor("person"); proxiedPersonID != "" {
-		personID = proxiedPersonID
-	}
-
-	switch args.FilterFor("scope") {
-	case "created":
-		searcher = searcher.WithScope("creator_id", personID)
-		currentScope = "created"
-	case "contributed":
-		searcher = searcher.WithScope("author_id", personID)
-		currentScope = "contributed"
-	case "supervised":
-		searcher = searcher.WithScope("supervisor_id", personID)
-		currentScope = "supervised"
-	case "all":
-		searcher = searcher.WithScope("creator_id|author_id|supervisor_id", personID)
-		currentScope = "all"
-	default:
-		c.HandleError(w, r, httperror.BadRequest.Wrap(fmt.Errorf("unknown scope: %s", args.FilterFor("scope"))))
+	searcher, currentScope, err := UserSearcher(c, args)
+	if err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
 		return
 	}
-	delete(args.Filters, "person")
-	delete(args.Filters, "scope")
 
 	hits, err := searcher.Search(args)
 	if err != nil {
@@ -106,6 +83,41 @@ func Search(w http.ResponseWriter, r *http.Request) {
 	}).Render(r.Context(), w)
 }
 
+// UserSearcher limits a search to the publications of the user (or the
+// person they're proxying) in the scope given by the scope filter. The scope
+// and person filters are removed from args.
+func UserSearcher(c *ctx.Ctx, args *models.SearchArgs) (backends.PublicationIndex, string, error) {
+	searcher := c.PublicationSearchIndex.WithScope("status", "private", "public", "returned")
+	var currentScope string
+
+	// view publications of proxy
+	personID := c.User.ID
+	if proxiedPersonID := args.FilterFor("person"); proxiedPersonID != "" {
+		personID = proxiedPersonID
+	}
+
+	switch args.FilterFor("scope") {
+	case "created":
+		searcher = searcher.WithScope("creator_id", personID)
+		currentScope = "created"
+	case "contributed":
+		searcher = searcher.WithScope("author_id", personID)
+		currentScope = "contributed"
+	case "supervised":
+		searcher = searcher.WithScope("supervisor_id", personID)
+		currentScope = "supervised"
+	case "all":
+		searcher = searcher.WithScope("creator_id|author_id|supervisor_id", personID)
+		currentScope = "all"
+	default:
+		return nil, "", fmt.Errorf("unknown scope: %s", args.FilterFor("scope"))
+	}
+	delete(args.Filters, "person")
+	delete(args.Filters, "scope")
+
+	return searcher, currentScope, nil
+}
+
 /*
 globalSearch(searcher)
 
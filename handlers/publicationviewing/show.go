@@ -1,9 +1,13 @@
 package publicationviewing
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/diffing"
+	"github.com/ugent-library/biblio-backoffice/handlers/comments"
+	"github.com/ugent-library/biblio-backoffice/models"
 	publicationviews "github.com/ugent-library/biblio-backoffice/views/publication"
 )
 
@@ -22,7 +26,13 @@ func Show(w http.ResponseWriter, r *http.Request) {
 	}
 	c.SubNav = subNav
 
-	publicationviews.Show(c, p, redirectURL).Render(r.Context(), w)
+	review, err := c.Repo.GetOpenReview(r.Context(), "publication", p.ID)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	publicationviews.Show(c, p, redirectURL, ctx.HasPublicationDraft(r), review).Render(r.Context(), w)
 }
 
 func ShowDescription(w http.ResponseWriter, r *http.Request) {
@@ -83,5 +93,25 @@ func ShowActivity(w http.ResponseWriter, r *http.Request) {
 		redirectURL = c.PathTo("publications").String()
 	}
 
-	publicationviews.Activity(c, p, redirectURL).Render(r.Context(), w)
+	var history []*diffing.Entry
+	if c.Repo.CanCurate(c.User) {
+		h, err := c.Repo.PublicationChanges(r.Context(), p.ID)
+		if err != nil {
+			c.HandleError(w, r, err)
+			return
+		}
+		history = h
+	}
+
+	var addCommentURL string
+	if c.Repo.CanEditPublication(c.User, p) {
+		addCommentURL = c.PathTo("publication_add_comment", "id", p.ID).String()
+	}
+	commentsArgs, err := comments.Args(r, c, "publication", p.ID, addCommentURL)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	publicationviews.Activity(c, p, redirectURL, history, commentsArgs).Render(r.Context(), w)
 }
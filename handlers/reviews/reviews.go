@@ -0,0 +1,345 @@
+package reviews
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/localize"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/pagination"
+	"github.com/ugent-library/biblio-backoffice/repositories"
+	"github.com/ugent-library/biblio-backoffice/views"
+	"github.com/ugent-library/biblio-backoffice/views/flash"
+	reviewviews "github.com/ugent-library/biblio-backoffice/views/review"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+	"github.com/ugent-library/okay"
+)
+
+type bindAssignReview struct {
+	Username string `form:"username"`
+}
+
+type bindDecideReview struct {
+	Comment string `form:"comment"`
+}
+
+// reviewTarget is the review a request acts on and the page to return to
+type reviewTarget struct {
+	ID          string
+	RedirectURL string
+}
+
+func getReviewTarget(r *http.Request) reviewTarget {
+	return reviewTarget{
+		ID:          bind.PathValue(r, "id"),
+		RedirectURL: r.URL.Query().Get("redirect-url"),
+	}
+}
+
+// Index lists the review queue
+func Index(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	searchArgs := models.NewSearchArgs()
+	if err := bind.Request(r, searchArgs); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+	searchArgs.Cleanup()
+
+	total, reviews, err := c.Repo.GetReviews(r.Context(), searchArgs)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	items, err := reviewItems(c, reviews)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	reviewviews.Index(c, reviewviews.IndexArgs{
+		SearchArgs: searchArgs,
+		Pagination: pagination.Pagination{
+			Offset: searchArgs.Offset(),
+			Limit:  searchArgs.Limit(),
+			Total:  total,
+		},
+		Items: items,
+	}).Render(r.Context(), w)
+}
+
+func Show(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	args, err := reviewArgs(r, c, getReviewTarget(r))
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	views.ShowModal(reviewviews.Review(c, args)).Render(r.Context(), w)
+}
+
+// Assign assigns a review to the curator with the given username, an empty
+// username unassigns it
+func Assign(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	t := getReviewTarget(r)
+	b := bindAssignReview{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	var assigneeID string
+	if username := strings.TrimSpace(b.Username); username != "" {
+		assignee, err := c.UserService.GetUserByUsername(username)
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			c.HandleError(w, r, err)
+			return
+		}
+		if assignee == nil || !c.Repo.CanCurate(assignee) {
+			renderReviewErrors(w, r, c, t, b.Username, "", username+" is not a curator.")
+			return
+		}
+		assigneeID = assignee.ID
+	}
+
+	err := c.Repo.AssignReview(r.Context(), t.ID, assigneeID)
+	if errors.Is(err, repositories.ErrReviewClosed) {
+		renderReviewErrors(w, r, c, t, b.Username, "", "This review is already approved.")
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	body := "<p>Review was successfully assigned.</p>"
+	if assigneeID == "" {
+		body = "<p>Review was successfully unassigned.</p>"
+	}
+	redirect(w, r, c, t, body)
+}
+
+func Approve(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	t := getReviewTarget(r)
+	b := bindDecideReview{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	err := c.Repo.ApproveReview(r.Context(), t.ID, c.User, strings.TrimSpace(b.Comment))
+	if errors.Is(err, repositories.ErrReviewClosed) {
+		renderReviewErrors(w, r, c, t, "", b.Comment, "This review is already approved.")
+		return
+	}
+	var validationErrs *okay.Errors
+	if errors.As(err, &validationErrs) {
+		renderReviewErrors(w, r, c, t, "", b.Comment, localize.ValidationErrors(c.Loc, validationErrs)...)
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	redirect(w, r, c, t, "<p>Review was successfully approved.</p>")
+}
+
+// RequestChanges sends the record back to the researcher, the comment is
+// shown on the record
+func RequestChanges(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	t := getReviewTarget(r)
+	b := bindDecideReview{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	comment := strings.TrimSpace(b.Comment)
+	if comment == "" {
+		renderReviewErrors(w, r, c, t, "", b.Comment, "Please tell the researcher what to change.")
+		return
+	}
+
+	err := c.Repo.RequestReviewChanges(r.Context(), t.ID, c.User, comment)
+	if errors.Is(err, repositories.ErrReviewClosed) {
+		renderReviewErrors(w, r, c, t, "", b.Comment, "This review is already approved.")
+		return
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	redirect(w, r, c, t, "<p>Changes were successfully requested.</p>")
+}
+
+func redirect(w http.ResponseWriter, r *http.Request, c *ctx.Ctx, t reviewTarget, body string) {
+	flash := flash.SimpleFlash().
+		WithLevel("success").
+		WithBody(body)
+
+	c.PersistFlash(w, *flash)
+
+	redirectURL := t.RedirectURL
+	if redirectURL == "" {
+		redirectURL = c.PathTo("reviews").String()
+	}
+	w.Header().Set("HX-Redirect", redirectURL)
+}
+
+func renderReviewErrors(w http.ResponseWriter, r *http.Request, c *ctx.Ctx, t reviewTarget, username, comment string, errs ...string) {
+	args, err := reviewArgs(r, c, t)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+	args.Username = username
+	args.Comment = comment
+	args.Errors = errs
+
+	views.ReplaceModal(reviewviews.Review(c, args)).Render(r.Context(), w)
+}
+
+func reviewArgs(r *http.Request, c *ctx.Ctx, t reviewTarget) (reviewviews.ReviewArgs, error) {
+	review, err := c.Repo.GetReview(r.Context(), t.ID)
+	if errors.Is(err, models.ErrNotFound) {
+		return reviewviews.ReviewArgs{}, httperror.NotFound
+	}
+	if err != nil {
+		return reviewviews.ReviewArgs{}, err
+	}
+
+	items, err := reviewItems(c, []*models.Review{review})
+	if err != nil {
+		return reviewviews.ReviewArgs{}, err
+	}
+
+	args := reviewviews.ReviewArgs{
+		Item:              items[0],
+		AssignURL:         c.PathTo("assign_review", "id", review.ID, "redirect-url", t.RedirectURL).String(),
+		ApproveURL:        c.PathTo("approve_review", "id", review.ID, "redirect-url", t.RedirectURL).String(),
+		RequestChangesURL: c.PathTo("request_review_changes", "id", review.ID, "redirect-url", t.RedirectURL).String(),
+	}
+	if items[0].Assignee != nil {
+		args.Username = items[0].Assignee.Username
+	}
+
+	switch review.RecordType {
+	case "publication":
+		_, err = c.Repo.GetPublicationDraft(review.RecordID)
+	case "dataset":
+		_, err = c.Repo.GetDatasetDraft(review.RecordID)
+	}
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return reviewviews.ReviewArgs{}, err
+	}
+	args.HasDraft = err == nil
+
+	return args, nil
+}
+
+// reviewItems loads the records and people of reviews
+func reviewItems(c *ctx.Ctx, reviews []*models.Review) ([]*reviewviews.Item, error) {
+	var publicationIDs, datasetIDs []string
+	for _, review := range reviews {
+		switch review.RecordType {
+		case "publication":
+			publicationIDs = append(publicationIDs, review.RecordID)
+		case "dataset":
+			datasetIDs = append(datasetIDs, review.RecordID)
+		}
+	}
+
+	items := make(map[string]*reviewviews.Item, len(reviews))
+
+	if len(publicationIDs) > 0 {
+		publications, err := c.Repo.GetPublications(publicationIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range publications {
+			items["publication/"+p.ID] = &reviewviews.Item{
+				RecordTitle:  p.Title,
+				RecordStatus: p.Status,
+				RecordURL:    c.PathTo("publication", "id", p.ID).String(),
+			}
+		}
+	}
+	if len(datasetIDs) > 0 {
+		datasets, err := c.Repo.GetDatasets(datasetIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range datasets {
+			items["dataset/"+d.ID] = &reviewviews.Item{
+				RecordTitle:  d.Title,
+				RecordStatus: d.Status,
+				RecordURL:    c.PathTo("dataset", "id", d.ID).String(),
+			}
+		}
+	}
+
+	users := map[string]*models.Person{}
+	getUser := func(id string) (*models.Person, error) {
+		if id == "" {
+			return nil, nil
+		}
+		if u, ok := users[id]; ok {
+			return u, nil
+		}
+		u, err := c.UserService.GetUser(id)
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			return nil, err
+		}
+		users[id] = u
+		return u, nil
+	}
+
+	result := make([]*reviewviews.Item, 0, len(reviews))
+	for _, review := range reviews {
+		item, ok := items[review.RecordType+"/"+review.RecordID]
+		if !ok {
+			item = &reviewviews.Item{RecordTitle: review.RecordID}
+		}
+		// copy, a record can only have one open review but may have been
+		// approved more than once
+		item = &reviewviews.Item{
+			Review:       review,
+			RecordTitle:  item.RecordTitle,
+			RecordStatus: item.RecordStatus,
+			RecordURL:    item.RecordURL,
+		}
+		if item.RecordTitle == "" {
+			item.RecordTitle = "Untitled record"
+		}
+
+		u, err := getUser(review.UserID)
+		if err != nil {
+			return nil, err
+		}
+		item.User = u
+		assignee, err := getUser(review.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+		item.Assignee = assignee
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
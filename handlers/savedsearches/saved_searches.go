@@ -0,0 +1,174 @@
+package savedsearches
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/ctx"
+	"github.com/ugent-library/biblio-backoffice/models"
+	publicationviews "github.com/ugent-library/biblio-backoffice/views/publication"
+	"github.com/ugent-library/bind"
+	"github.com/ugent-library/httperror"
+)
+
+type bindSavedSearch struct {
+	ID string `path:"id"`
+	// encoded query string of the search that is currently displayed
+	Query string `form:"query"`
+}
+
+type bindCreateSavedSearch struct {
+	Name   string `form:"name"`
+	Digest bool   `form:"digest"`
+	Query  string `form:"query"`
+}
+
+// Sidebar lists the saved searches of the current user next to the search
+// results. The current search args are passed in the query string.
+func Sidebar(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	searchArgs := models.NewSearchArgs()
+	if err := bind.Request(r, searchArgs); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+	searchArgs.Cleanup()
+
+	renderSidebar(w, r, searchArgs, "")
+}
+
+func Create(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	b := bindCreateSavedSearch{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	searchArgs, err := decodeSearchArgs(b.Query)
+	if err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	name := strings.TrimSpace(b.Name)
+	if name == "" {
+		renderSidebar(w, r, searchArgs, "Name can't be empty.")
+		return
+	}
+
+	s := &models.SavedSearch{
+		UserID:     c.User.ID,
+		Name:       name,
+		SearchArgs: searchArgs.Clone().WithPage(1),
+		Digest:     b.Digest,
+	}
+	if err := c.Repo.AddSavedSearch(r.Context(), s); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	renderSidebar(w, r, searchArgs, "")
+}
+
+func ToggleDigest(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	b, s, ok := getSavedSearch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.Repo.SetSavedSearchDigest(r.Context(), s.ID, !s.Digest); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	searchArgs, err := decodeSearchArgs(b.Query)
+	if err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	renderSidebar(w, r, searchArgs, "")
+}
+
+func Delete(w http.ResponseWriter, r *http.Request) {
+	c := ctx.Get(r)
+
+	b, s, ok := getSavedSearch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.Repo.DeleteSavedSearch(r.Context(), s.ID); err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	searchArgs, err := decodeSearchArgs(b.Query)
+	if err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return
+	}
+
+	renderSidebar(w, r, searchArgs, "")
+}
+
+// getSavedSearch loads the saved search in the path and checks that it
+// belongs to the current user
+func getSavedSearch(w http.ResponseWriter, r *http.Request) (bindSavedSearch, *models.SavedSearch, bool) {
+	c := ctx.Get(r)
+
+	b := bindSavedSearch{}
+	if err := bind.Request(r, &b); err != nil {
+		c.HandleError(w, r, httperror.BadRequest.Wrap(err))
+		return b, nil, false
+	}
+
+	s, err := c.Repo.GetSavedSearch(r.Context(), b.ID)
+	if errors.Is(err, models.ErrNotFound) {
+		c.HandleError(w, r, httperror.NotFound)
+		return b, nil, false
+	}
+	if err != nil {
+		c.HandleError(w, r, err)
+		return b, nil, false
+	}
+
+	if s.UserID != c.User.ID {
+		c.HandleError(w, r, httperror.NotFound)
+		return b, nil, false
+	}
+
+	return b, s, true
+}
+
+func decodeSearchArgs(q string) (*models.SearchArgs, error) {
+	vals, err := url.ParseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	searchArgs := models.NewSearchArgs()
+	if err := bind.DecodeQuery(vals, searchArgs); err != nil {
+		return nil, err
+	}
+	searchArgs.Cleanup()
+	return searchArgs, nil
+}
+
+func renderSidebar(w http.ResponseWriter, r *http.Request, searchArgs *models.SearchArgs, errMsg string) {
+	c := ctx.Get(r)
+
+	searches, err := c.Repo.GetSavedSearchesByUser(r.Context(), c.User.ID)
+	if err != nil {
+		c.HandleError(w, r, err)
+		return
+	}
+
+	publicationviews.SavedSearches(c, searches, searchArgs, errMsg).Render(r.Context(), w)
+}
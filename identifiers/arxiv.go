@@ -0,0 +1,29 @@
+package identifiers
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	reArXivPrefix  = regexp.MustCompile(`(?i)^(arxiv:|https?://arxiv\.org/(abs|pdf)/)`)
+	reArXivVersion = regexp.MustCompile(`v\d+$`)
+)
+
+type ArXivType struct{}
+
+func (i *ArXivType) Validate(id string) bool {
+	return true
+}
+
+// Normalize strips prefixes and the version suffix, all versions of a preprint
+// share the same id
+func (i *ArXivType) Normalize(id string) (string, error) {
+	id = reArXivPrefix.ReplaceAllString(strings.TrimSpace(id), "")
+	id = strings.TrimSuffix(id, ".pdf")
+	return reArXivVersion.ReplaceAllString(id, ""), nil
+}
+
+func (i *ArXivType) Resolve(id string) string {
+	return "https://arxiv.org/abs/" + id
+}
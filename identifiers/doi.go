@@ -1,6 +1,13 @@
 package identifiers
 
-import "github.com/caltechlibrary/doitools"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/caltechlibrary/doitools"
+)
+
+var reDOIPrefix = regexp.MustCompile(`(?i)^doi:\s*`)
 
 type DOIType struct{}
 
@@ -9,7 +16,7 @@ func (i *DOIType) Validate(id string) bool {
 }
 
 func (i *DOIType) Normalize(id string) (string, error) {
-	return doitools.NormalizeDOI(id)
+	return doitools.NormalizeDOI(reDOIPrefix.ReplaceAllString(strings.TrimSpace(id), ""))
 }
 
 func (i *DOIType) Resolve(id string) string {
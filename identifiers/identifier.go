@@ -1,6 +1,7 @@
 package identifiers
 
 var (
+	ArXiv         = &ArXivType{}
 	BioStudies    = &BioStudiesType{}
 	DOI           = &DOIType{}
 	ENA           = &ENAType{}
@@ -13,6 +14,7 @@ var (
 )
 
 var types = map[string]Type{
+	"ArXiv":         ArXiv,
 	"BioStudies":    BioStudies,
 	"DOI":           DOI,
 	"EGA":           EGA,
@@ -1,5 +1,16 @@
 package identifiers
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	rePubMedPrefix = regexp.MustCompile(`(?i)^(pmid:?\s*|https?://(www\.)?ncbi\.nlm\.nih\.gov/pubmed/|https?://pubmed\.ncbi\.nlm\.nih\.gov/)`)
+	rePubMed       = regexp.MustCompile(`^\d+$`)
+)
+
 type PubMedType struct{}
 
 func (i *PubMedType) Validate(id string) bool {
@@ -7,6 +18,10 @@ func (i *PubMedType) Validate(id string) bool {
 }
 
 func (i *PubMedType) Normalize(id string) (string, error) {
+	id = strings.TrimSuffix(rePubMedPrefix.ReplaceAllString(strings.TrimSpace(id), ""), "/")
+	if !rePubMed.MatchString(id) {
+		return id, fmt.Errorf("invalid pubmed id %q", id)
+	}
 	return id, nil
 }
 
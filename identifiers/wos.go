@@ -1,5 +1,12 @@
 package identifiers
 
+import (
+	"regexp"
+	"strings"
+)
+
+var reWebOfSciencePrefix = regexp.MustCompile(`(?i)^wos:`)
+
 type WebOfScienceType struct{}
 
 func (i *WebOfScienceType) Validate(id string) bool {
@@ -7,7 +14,7 @@ func (i *WebOfScienceType) Validate(id string) bool {
 }
 
 func (i *WebOfScienceType) Normalize(id string) (string, error) {
-	return id, nil
+	return reWebOfSciencePrefix.ReplaceAllString(strings.TrimSpace(id), ""), nil
 }
 
 func (i *WebOfScienceType) Resolve(id string) string {
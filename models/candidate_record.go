@@ -21,4 +21,9 @@ type CandidateRecord struct {
 	StatusPersonID string       `json:"status_person_id"`
 	StatusPerson   *Person      `json:"status_person"`
 	ImportedID     string       `json:"imported_id"`
+	// why a curator rejected the record, if given
+	StatusReason string `json:"status_reason,omitempty"`
+	// researcher that a curator handed the record to
+	AssignedPersonID string  `json:"assigned_person_id,omitempty"`
+	AssignedPerson   *Person `json:"assigned_person,omitempty"`
 }
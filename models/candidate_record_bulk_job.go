@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	CandidateRecordBulkImport = "import"
+	CandidateRecordBulkReject = "reject"
+	CandidateRecordBulkAssign = "assign"
+
+	CandidateRecordBulkJobPending = "pending"
+	CandidateRecordBulkJobRunning = "running"
+	CandidateRecordBulkJobDone    = "done"
+	CandidateRecordBulkJobFailed  = "failed"
+)
+
+// CandidateRecordBulkJob applies a curator action to a selection of candidate
+// records in the background. Records that are no longer new when the job
+// gets to them are skipped.
+type CandidateRecordBulkJob struct {
+	ID                 string   `json:"id"`
+	UserID             string   `json:"user_id"`
+	Action             string   `json:"action"` // import, reject or assign
+	CandidateRecordIDs []string `json:"candidate_record_ids"`
+	// the rejection reason
+	Reason string `json:"reason,omitempty"`
+	// the researcher records are assigned to
+	PersonID    string    `json:"person_id,omitempty"`
+	Person      *Person   `json:"-"`
+	Status      string    `json:"status"`
+	Processed   int       `json:"processed"`
+	Skipped     int       `json:"skipped"`
+	Total       int       `json:"total"`
+	Error       string    `json:"error,omitempty"`
+	DateCreated time.Time `json:"date_created"`
+	DateUpdated time.Time `json:"date_updated"`
+}
+
+// Progress returns the percentage of records that are processed
+func (j *CandidateRecordBulkJob) Progress() int {
+	if j.Status == CandidateRecordBulkJobDone {
+		return 100
+	}
+	if j.Total == 0 {
+		return 0
+	}
+	return min(100, j.Processed*100/j.Total)
+}
+
+func (j *CandidateRecordBulkJob) Finished() bool {
+	return j.Status == CandidateRecordBulkJobDone || j.Status == CandidateRecordBulkJobFailed
+}
+
+// Succeeded returns the number of processed records that weren't skipped
+func (j *CandidateRecordBulkJob) Succeeded() int {
+	return j.Processed - j.Skipped
+}
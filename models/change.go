@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/ugent-library/biblio-backoffice/changefeed"
+)
+
+// Change is the current version of a changed publication or dataset, only
+// one of both is set
+type Change struct {
+	// the transaction that added the snapshot
+	TxID        int64
+	Publication *Publication
+	Dataset     *Dataset
+}
+
+func (c *Change) RecordType() string {
+	if c.Publication != nil {
+		return "publication"
+	}
+	return "dataset"
+}
+
+// Cursor returns the position of the change in the change feed
+func (c *Change) Cursor() changefeed.Cursor {
+	if c.Publication != nil {
+		return changefeed.Cursor{TxID: c.TxID, SnapshotID: c.Publication.SnapshotID}
+	}
+	return changefeed.Cursor{TxID: c.TxID, SnapshotID: c.Dataset.SnapshotID}
+}
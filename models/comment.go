@@ -0,0 +1,80 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ugent-library/okay"
+)
+
+const (
+	// visible to everyone who can view the record
+	CommentShared = "shared"
+	// only visible to curators
+	CommentCurators = "curators"
+)
+
+var CommentVisibilities = []string{CommentShared, CommentCurators}
+
+// Comment is a message about a publication or dataset. A reply has the ID of
+// the comment it answers as ParentID, replies to replies are not nested any
+// further.
+type Comment struct {
+	ID          string     `json:"id"`
+	RecordType  string     `json:"record_type"` // publication or dataset
+	RecordID    string     `json:"record_id"`
+	ParentID    string     `json:"parent_id,omitempty"`
+	UserID      string     `json:"user_id"`
+	User        *Person    `json:"-"`
+	Body        string     `json:"body"`
+	Visibility  string     `json:"visibility"`
+	DateCreated time.Time  `json:"date_created"`
+	DateUpdated time.Time  `json:"date_updated"`
+	Replies     []*Comment `json:"-"`
+}
+
+func (c *Comment) Validate() error {
+	errs := okay.NewErrors()
+
+	if strings.TrimSpace(c.Body) == "" {
+		errs.Add(okay.NewError("/body", "comment.body.required"))
+	}
+	if !slices.Contains(CommentVisibilities, c.Visibility) {
+		errs.Add(okay.NewError("/visibility", "comment.visibility.invalid"))
+	}
+	if c.UserID == "" {
+		errs.Add(okay.NewError("/user_id", "comment.user_id.required"))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// ThreadComments groups comments, oldest first, into threads. Replies are
+// attached to the top level comment of their thread.
+func ThreadComments(comments []*Comment) []*Comment {
+	byID := make(map[string]*Comment, len(comments))
+	for _, c := range comments {
+		byID[c.ID] = c
+		c.Replies = nil
+	}
+
+	threads := []*Comment{}
+	for _, c := range comments {
+		root := c
+		for root.ParentID != "" {
+			parent, ok := byID[root.ParentID]
+			if !ok {
+				break
+			}
+			root = parent
+		}
+		if root == c {
+			threads = append(threads, c)
+		} else {
+			root.Replies = append(root.Replies, c)
+		}
+	}
+
+	return threads
+}
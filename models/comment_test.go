@@ -0,0 +1,36 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreadComments(t *testing.T) {
+	comments := []*Comment{
+		{ID: "1"},
+		{ID: "2"},
+		{ID: "3", ParentID: "1"},
+		{ID: "4", ParentID: "3"},
+		{ID: "5", ParentID: "2"},
+		// parent is not visible
+		{ID: "6", ParentID: "x"},
+	}
+
+	threads := ThreadComments(comments)
+
+	require.Len(t, threads, 3)
+	require.Equal(t, "1", threads[0].ID)
+	require.Equal(t, []*Comment{comments[2], comments[3]}, threads[0].Replies)
+	require.Equal(t, "2", threads[1].ID)
+	require.Equal(t, []*Comment{comments[4]}, threads[1].Replies)
+	require.Equal(t, "6", threads[2].ID)
+}
+
+func TestCommentValidate(t *testing.T) {
+	c := &Comment{UserID: "u1", Body: "Please add the DOI.", Visibility: CommentShared}
+	require.NoError(t, c.Validate())
+
+	c = &Comment{UserID: "u1", Body: " ", Visibility: "everyone"}
+	require.Error(t, c.Validate())
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	ExportJobPending = "pending"
+	ExportJobRunning = "running"
+	ExportJobDone    = "done"
+	ExportJobFailed  = "failed"
+)
+
+// ExportJob is a curator list export that runs in the background. The result
+// is stored in the file store and can be downloaded until DateExpires.
+type ExportJob struct {
+	ID          string      `json:"id"`
+	UserID      string      `json:"user_id"`
+	Entity      string      `json:"entity"` // publication or dataset
+	Format      string      `json:"format"`
+	SearchArgs  *SearchArgs `json:"search_args"`
+	Status      string      `json:"status"`
+	Processed   int         `json:"processed"`
+	Total       int         `json:"total"`
+	FileID      string      `json:"file_id,omitempty"`
+	FileName    string      `json:"file_name,omitempty"`
+	ContentType string      `json:"content_type,omitempty"`
+	Error       string      `json:"error,omitempty"`
+	DateCreated time.Time   `json:"date_created"`
+	DateUpdated time.Time   `json:"date_updated"`
+	DateExpires *time.Time  `json:"date_expires,omitempty"`
+}
+
+// Progress returns the percentage of records that are exported
+func (j *ExportJob) Progress() int {
+	if j.Status == ExportJobDone {
+		return 100
+	}
+	if j.Total == 0 {
+		return 0
+	}
+	return min(100, j.Processed*100/j.Total)
+}
+
+func (j *ExportJob) Finished() bool {
+	return j.Status == ExportJobDone || j.Status == ExportJobFailed
+}
+
+func (j *ExportJob) Expired() bool {
+	return j.DateExpires != nil && j.DateExpires.Before(time.Now())
+}
+
+// Downloadable reports if the result can still be downloaded
+func (j *ExportJob) Downloadable() bool {
+	return j.Status == ExportJobDone && j.FileID != "" && !j.Expired()
+}
@@ -7,4 +7,7 @@ type ExternalPerson struct {
 	// TODO forms are not yet aware of these new fields
 	HonorificPrefix string `json:"honorific_prefix,omitempty"`
 	Affiliation     string `json:"affiliation,omitempty"`
+	// identifiers given by a record source, used to match a person later on
+	ORCID   string `json:"orcid,omitempty"`
+	UGentID string `json:"ugent_id,omitempty"`
 }
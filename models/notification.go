@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	NotificationLocked          = "locked"
+	NotificationWithdrawn       = "withdrawn"
+	NotificationPublished       = "published"
+	NotificationCandidateRecord = "candidate_record"
+	NotificationProxyAdded      = "proxy_added"
+)
+
+// Notification tells a person about something that happened to one of their
+// records or to their account
+type Notification struct {
+	ID         string `json:"id"`
+	PersonID   string `json:"person_id"`
+	Kind       string `json:"kind"`
+	RecordType string `json:"record_type,omitempty"` // publication, dataset or candidate_record
+	RecordID   string `json:"record_id,omitempty"`
+	// title of the record at the time of the notification
+	Title string `json:"title,omitempty"`
+	// person that made the change or, for proxy_added, the new proxy
+	ActorID     string     `json:"actor_id,omitempty"`
+	Actor       *Person    `json:"-"`
+	DateCreated time.Time  `json:"date_created"`
+	DateRead    *time.Time `json:"date_read,omitempty"`
+}
+
+func (n *Notification) Read() bool {
+	return n.DateRead != nil
+}
+
+// StatusNotificationKinds returns the notifications the owners of a record
+// get when its status or lock changes. Only curators publishing a record is
+// worth a notification, researchers know when they publish their own.
+func StatusNotificationKinds(oldStatus string, oldLocked bool, status string, locked bool, byCurator bool) []string {
+	var kinds []string
+	if locked && !oldLocked {
+		kinds = append(kinds, NotificationLocked)
+	}
+	if status == "returned" && oldStatus != "returned" {
+		kinds = append(kinds, NotificationWithdrawn)
+	}
+	if status == "public" && oldStatus != "public" && byCurator {
+		kinds = append(kinds, NotificationPublished)
+	}
+	return kinds
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusNotificationKinds(t *testing.T) {
+	require.Empty(t, StatusNotificationKinds("private", false, "private", false, true))
+	require.Equal(t, []string{NotificationLocked}, StatusNotificationKinds("public", false, "public", true, true))
+	// unlocking is not worth a notification
+	require.Empty(t, StatusNotificationKinds("public", true, "public", false, true))
+	require.Equal(t, []string{NotificationWithdrawn}, StatusNotificationKinds("public", false, "returned", false, false))
+	require.Equal(t, []string{NotificationLocked, NotificationPublished}, StatusNotificationKinds("private", false, "public", true, true))
+	require.Empty(t, StatusNotificationKinds("private", false, "public", false, false))
+}
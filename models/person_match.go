@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+const (
+	PersonMatchProposed  = "proposed"
+	PersonMatchConfirmed = "confirmed"
+	PersonMatchRejected  = "rejected"
+)
+
+// PersonMatch proposes a person from the authority for a contributor of a
+// candidate record. Curators confirm or reject proposed matches.
+type PersonMatch struct {
+	ID                string `json:"id"`
+	CandidateRecordID string `json:"candidate_record_id"`
+	// author, editor or supervisor
+	Role     string  `json:"role"`
+	Position int     `json:"position"`
+	PersonID string  `json:"person_id"`
+	Person   *Person `json:"-"`
+	// confidence between 0 and 1
+	Score          float64   `json:"score"`
+	Reasons        []string  `json:"reasons"`
+	Status         string    `json:"status"`
+	StatusPersonID string    `json:"status_person_id,omitempty"`
+	DateCreated    time.Time `json:"date_created"`
+	DateUpdated    time.Time `json:"date_updated"`
+}
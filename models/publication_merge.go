@@ -0,0 +1,98 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// Merge copies the contributors, files, identifiers, links, projects,
+// departments and dataset relations of other into p. Values that p already
+// has are kept, other only fills the gaps.
+func (p *Publication) Merge(other *Publication) {
+	for _, role := range []string{"author", "editor", "supervisor"} {
+		p.SetContributors(role, mergeContributors(p.Contributors(role), other.Contributors(role)))
+	}
+
+	for _, f := range other.File {
+		if !slices.ContainsFunc(p.File, func(file *PublicationFile) bool {
+			return file.ID == f.ID || (f.SHA256 != "" && file.SHA256 == f.SHA256)
+		}) {
+			p.File = append(p.File, f)
+		}
+	}
+
+	mergeString(&p.DOI, other.DOI)
+	mergeString(&p.PubMedID, other.PubMedID)
+	mergeString(&p.ArxivID, other.ArxivID)
+	mergeString(&p.WOSID, other.WOSID)
+	mergeString(&p.ESCIID, other.ESCIID)
+	p.ISBN = mergeStrings(p.ISBN, other.ISBN)
+	p.EISBN = mergeStrings(p.EISBN, other.EISBN)
+	p.ISSN = mergeStrings(p.ISSN, other.ISSN)
+	p.EISSN = mergeStrings(p.EISSN, other.EISSN)
+
+	for _, l := range other.Link {
+		if !slices.ContainsFunc(p.Link, func(link *PublicationLink) bool {
+			return strings.EqualFold(link.URL, l.URL)
+		}) {
+			p.Link = append(p.Link, l)
+		}
+	}
+
+	for _, rel := range other.RelatedProjects {
+		if !slices.ContainsFunc(p.RelatedProjects, func(r *RelatedProject) bool {
+			return r.ProjectID == rel.ProjectID
+		}) {
+			p.RelatedProjects = append(p.RelatedProjects, rel)
+		}
+	}
+
+	for _, rel := range other.RelatedOrganizations {
+		if !slices.ContainsFunc(p.RelatedOrganizations, func(r *RelatedOrganization) bool {
+			return r.OrganizationID == rel.OrganizationID
+		}) {
+			p.RelatedOrganizations = append(p.RelatedOrganizations, rel)
+		}
+	}
+
+	for _, rel := range other.RelatedDataset {
+		if !p.HasRelatedDataset(rel.ID) {
+			p.RelatedDataset = append(p.RelatedDataset, rel)
+		}
+	}
+}
+
+// mergeContributors appends the contributors in other that are not in c.
+// Contributors are the same if they are linked to the same person or have the
+// same name. An unlinked contributor takes over the link of its counterpart.
+func mergeContributors(c, other []*Contributor) []*Contributor {
+	for _, o := range other {
+		i := slices.IndexFunc(c, func(contributor *Contributor) bool {
+			if contributor.PersonID != "" && o.PersonID != "" {
+				return contributor.PersonID == o.PersonID
+			}
+			return strings.EqualFold(contributor.Name(), o.Name())
+		})
+		if i == -1 {
+			c = append(c, o)
+		} else if c[i].PersonID == "" && o.PersonID != "" {
+			c[i] = o
+		}
+	}
+	return c
+}
+
+func mergeString(s *string, other string) {
+	if *s == "" {
+		*s = other
+	}
+}
+
+func mergeStrings(s, other []string) []string {
+	for _, o := range other {
+		if !slices.Contains(s, o) {
+			s = append(s, o)
+		}
+	}
+	return s
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicationMerge(t *testing.T) {
+	p := &Publication{
+		ID:  "1",
+		DOI: "10.1000/abc",
+		Author: []*Contributor{
+			ContributorFromFirstLastName("Jane", "Doe"),
+		},
+		File:            []*PublicationFile{{ID: "f1", SHA256: "aaa"}},
+		Link:            []*PublicationLink{{ID: "l1", URL: "https://example.com"}},
+		RelatedProjects: []*RelatedProject{{ProjectID: "p1"}},
+		RelatedDataset:  []RelatedDataset{{ID: "d1"}},
+	}
+	other := &Publication{
+		ID:       "2",
+		DOI:      "10.1000/other",
+		PubMedID: "123",
+		ISBN:     []string{"978-3-16-148410-0"},
+		Author: []*Contributor{
+			{PersonID: "jane", ExternalPerson: &ExternalPerson{FullName: "Jane Doe"}},
+			ContributorFromFirstLastName("John", "Smith"),
+		},
+		File:                 []*PublicationFile{{ID: "f2", SHA256: "aaa"}, {ID: "f3", SHA256: "bbb"}},
+		Link:                 []*PublicationLink{{ID: "l2", URL: "https://EXAMPLE.com"}},
+		RelatedProjects:      []*RelatedProject{{ProjectID: "p1"}, {ProjectID: "p2"}},
+		RelatedOrganizations: []*RelatedOrganization{{OrganizationID: "o1"}},
+		RelatedDataset:       []RelatedDataset{{ID: "d1"}, {ID: "d2"}},
+	}
+
+	p.Merge(other)
+
+	require.Equal(t, "10.1000/abc", p.DOI)
+	require.Equal(t, "123", p.PubMedID)
+	require.Equal(t, []string{"978-3-16-148410-0"}, p.ISBN)
+	require.Len(t, p.Author, 2)
+	require.Equal(t, "jane", p.Author[0].PersonID)
+	require.Equal(t, "John Smith", p.Author[1].Name())
+	require.Len(t, p.File, 2)
+	require.Equal(t, "f3", p.File[1].ID)
+	require.Len(t, p.Link, 1)
+	require.Len(t, p.RelatedProjects, 2)
+	require.Len(t, p.RelatedOrganizations, 1)
+	require.Equal(t, []RelatedDataset{{ID: "d1"}, {ID: "d2"}}, p.RelatedDataset)
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	ReviewPending          = "pending"
+	ReviewApproved         = "approved"
+	ReviewChangesRequested = "changes_requested"
+
+	// the record was made public
+	ReviewReasonPublished = "published"
+	// a public record was edited
+	ReviewReasonEdited = "edited"
+)
+
+// Review places a publication or dataset in the curator review queue. A
+// record has at most one open review, which is pending or waiting for the
+// researcher to address the requested changes.
+type Review struct {
+	ID         string   `json:"id"`
+	RecordType string   `json:"record_type"` // publication or dataset
+	RecordID   string   `json:"record_id"`
+	Status     string   `json:"status"`
+	Reason     string   `json:"reason"`
+	FacultyIDs []string `json:"faculty_ids"`
+	// researcher whose change placed the record in the queue
+	UserID string `json:"user_id,omitempty"`
+	// curator that claimed the review
+	AssigneeID string `json:"assignee_id,omitempty"`
+	// curator that approved or requested changes
+	ReviewerID string `json:"reviewer_id,omitempty"`
+	// comment for the researcher
+	Comment     string    `json:"comment,omitempty"`
+	DateCreated time.Time `json:"date_created"`
+	DateUpdated time.Time `json:"date_updated"`
+}
+
+func (r *Review) Open() bool {
+	return r.Status != ReviewApproved
+}
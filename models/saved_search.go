@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// SavedSearch is a publication search that a curator can re-run. When Digest
+// is set, new matches are mailed to the user periodically.
+type SavedSearch struct {
+	ID           string      `json:"id"`
+	UserID       string      `json:"user_id"`
+	Name         string      `json:"name"`
+	SearchArgs   *SearchArgs `json:"search_args"`
+	Digest       bool        `json:"digest"`
+	LastDigestAt *time.Time  `json:"last_digest_at,omitempty"`
+	DateCreated  time.Time   `json:"date_created"`
+	DateUpdated  time.Time   `json:"date_updated"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+const (
+	SyncJobPending = "pending"
+	SyncJobRunning = "running"
+	SyncJobFailed  = "failed"
+
+	SyncORCIDWorks = "orcid_works"
+	SyncDOI        = "doi"
+)
+
+// SyncJob pushes the current state of a record to an external service. Failed
+// attempts are retried at NextAttempt until the job is given up.
+type SyncJob struct {
+	Kind        string    `json:"kind"`
+	RecordID    string    `json:"record_id"`
+	Status      string    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	Error       string    `json:"error,omitempty"`
+	NextAttempt time.Time `json:"next_attempt"`
+	DateCreated time.Time `json:"date_created"`
+	DateUpdated time.Time `json:"date_updated"`
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryRunning   = "running"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+
+	WebhookCreated   = "created"
+	WebhookUpdated   = "updated"
+	WebhookPublished = "published"
+	WebhookWithdrawn = "withdrawn"
+	WebhookDeleted   = "deleted"
+)
+
+// WebhookDelivery sends a record lifecycle event to one webhook endpoint.
+// Failed attempts are retried at NextAttempt until the delivery is given up.
+type WebhookDelivery struct {
+	ID string `json:"id"`
+	// the same for all endpoints that receive the event
+	EventID    string `json:"event_id"`
+	Event      string `json:"event"` // e.g. publication.published
+	RecordType string `json:"record_type"`
+	RecordID   string `json:"record_id"`
+	URL        string `json:"url"`
+	// the request body
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"response_status,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	NextAttempt    time.Time       `json:"next_attempt"`
+	DateCreated    time.Time       `json:"date_created"`
+	DateUpdated    time.Time       `json:"date_updated"`
+}
+
+// WebhookPayload is the body of a webhook request
+type WebhookPayload struct {
+	ID          string    `json:"id"`
+	Event       string    `json:"event"`
+	RecordType  string    `json:"record_type"`
+	RecordID    string    `json:"record_id"`
+	DateCreated time.Time `json:"date_created"`
+	Record      any       `json:"record"`
+}
+
+// WebhookEvent returns the lifecycle event of a record that goes from the
+// last sent status to status. oldStatus is empty for records that weren't sent
+// before, a status change can't be told apart from an update for those.
+func WebhookEvent(oldStatus, status string, created bool) string {
+	switch {
+	case created:
+		return WebhookCreated
+	case status == "deleted" && oldStatus != "deleted":
+		return WebhookDeleted
+	case oldStatus == "":
+		return WebhookUpdated
+	case status == "public" && oldStatus != "public":
+		return WebhookPublished
+	case status == "returned" && oldStatus != "returned":
+		return WebhookWithdrawn
+	default:
+		return WebhookUpdated
+	}
+}
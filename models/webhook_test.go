@@ -0,0 +1,19 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookEvent(t *testing.T) {
+	require.Equal(t, WebhookCreated, WebhookEvent("", "private", true))
+	require.Equal(t, WebhookUpdated, WebhookEvent("private", "private", false))
+	require.Equal(t, WebhookPublished, WebhookEvent("private", "public", false))
+	require.Equal(t, WebhookUpdated, WebhookEvent("public", "public", false))
+	require.Equal(t, WebhookWithdrawn, WebhookEvent("public", "returned", false))
+	require.Equal(t, WebhookDeleted, WebhookEvent("returned", "deleted", false))
+	require.Equal(t, WebhookDeleted, WebhookEvent("", "deleted", false))
+	// records from before webhooks were configured
+	require.Equal(t, WebhookUpdated, WebhookEvent("", "public", false))
+}
@@ -0,0 +1,229 @@
+// Package personmatching proposes people from the person authority for the
+// contributors of a candidate record that aren't linked to a person yet.
+package personmatching
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	ReasonORCID    = "orcid"
+	ReasonUGentID  = "ugent_id"
+	ReasonName     = "name"
+	ReasonInitials = "initials"
+	ReasonSwapped  = "swapped_name"
+)
+
+const (
+	// weaker matches are not proposed
+	minScore = 0.5
+	// number of proposed people per contributor
+	maxMatches = 3
+)
+
+var Roles = []string{"author", "editor", "supervisor"}
+
+type Matcher struct {
+	search backends.PersonSearchService
+}
+
+func NewMatcher(search backends.PersonSearchService) *Matcher {
+	return &Matcher{search: search}
+}
+
+// Match returns proposed people for each contributor of p that isn't linked
+// to a person yet, best match first. An ORCID iD or UGent ID given by the
+// source is a certain match, otherwise people with a similar name are
+// proposed.
+func (m *Matcher) Match(p *models.Publication) ([]*models.PersonMatch, error) {
+	var matches []*models.PersonMatch
+	for _, role := range Roles {
+		for i, c := range p.Contributors(role) {
+			if c.PersonID != "" || c.ExternalPerson == nil {
+				continue
+			}
+			contributorMatches, err := m.matchContributor(c.ExternalPerson)
+			if err != nil {
+				return nil, err
+			}
+			for _, match := range contributorMatches {
+				match.Role = role
+				match.Position = i
+			}
+			matches = append(matches, contributorMatches...)
+		}
+	}
+	return matches, nil
+}
+
+func (m *Matcher) matchContributor(ep *models.ExternalPerson) ([]*models.PersonMatch, error) {
+	if ep.ORCID != "" {
+		match, err := m.matchID(ep.ORCID, ReasonORCID, func(p *models.Person) bool {
+			return p.ORCID == ep.ORCID
+		})
+		if match != nil || err != nil {
+			return []*models.PersonMatch{match}, err
+		}
+	}
+
+	if ep.UGentID != "" {
+		match, err := m.matchID(ep.UGentID, ReasonUGentID, func(p *models.Person) bool {
+			return slices.Contains(p.UGentID, ep.UGentID)
+		})
+		if match != nil || err != nil {
+			return []*models.PersonMatch{match}, err
+		}
+	}
+
+	firstName, lastName := ep.FirstName, ep.LastName
+	if lastName == "" {
+		return nil, nil
+	}
+	if firstName == "[missing]" {
+		firstName = ""
+	}
+
+	hits, err := m.search.SuggestPeople(strings.TrimSpace(firstName + " " + lastName))
+	if err != nil {
+		return nil, err
+	}
+	// sources sometimes mix up first and last name
+	if firstName != "" {
+		swappedHits, err := m.search.SuggestPeople(lastName + " " + firstName)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, swappedHits...)
+	}
+
+	var matches []*models.PersonMatch
+	for _, hit := range hits {
+		if slices.ContainsFunc(matches, func(match *models.PersonMatch) bool { return match.PersonID == hit.ID }) {
+			continue
+		}
+		score, reason := NameScore(firstName, lastName, hit)
+		if score < minScore {
+			continue
+		}
+		matches = append(matches, &models.PersonMatch{
+			PersonID: hit.ID,
+			Person:   hit,
+			Score:    score,
+			Reasons:  []string{reason},
+		})
+	}
+
+	slices.SortStableFunc(matches, func(a, b *models.PersonMatch) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+
+	if len(matches) > maxMatches {
+		matches = matches[:maxMatches]
+	}
+
+	return matches, nil
+}
+
+func (m *Matcher) matchID(id, reason string, same func(*models.Person) bool) (*models.PersonMatch, error) {
+	hits, err := m.search.SuggestPeople(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, hit := range hits {
+		if same(hit) {
+			return &models.PersonMatch{
+				PersonID: hit.ID,
+				Person:   hit,
+				Score:    1,
+				Reasons:  []string{reason},
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// NameScore compares a name with the name of a person. Last names must be the
+// same, ignoring case, accents and punctuation. Full first names score higher
+// than initials. A first name that is only an initial or missing can't tell
+// people with the same last name apart and scores low.
+func NameScore(firstName, lastName string, p *models.Person) (float64, string) {
+	first, last := normalizeName(firstName), normalizeName(lastName)
+	pFirst, pLast := normalizeName(p.FirstName), normalizeName(p.LastName)
+
+	if last == "" || pLast == "" {
+		return 0, ""
+	}
+
+	switch {
+	case last == pLast && first != "" && first == pFirst:
+		return 0.9, ReasonName
+	case last == pLast && sameInitials(first, pFirst):
+		return 0.6, ReasonInitials
+	case last == pLast && first == "":
+		return 0.5, ReasonName
+	case first == pLast && last == pFirst:
+		return 0.7, ReasonSwapped
+	}
+
+	return 0, ""
+}
+
+// sameInitials compares the first letter of each part of the names. One of
+// both names must consist of initials only.
+func sameInitials(a, b string) bool {
+	partsA, partsB := strings.Fields(a), strings.Fields(b)
+	if len(partsA) == 0 || len(partsB) == 0 {
+		return false
+	}
+	if !onlyInitials(partsA) && !onlyInitials(partsB) {
+		return false
+	}
+	n := min(len(partsA), len(partsB))
+	for i := 0; i < n; i++ {
+		if partsA[i][0] != partsB[i][0] {
+			return false
+		}
+	}
+	return true
+}
+
+func onlyInitials(parts []string) bool {
+	for _, part := range parts {
+		if len(part) > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeName lowercases a name and removes accents and punctuation.
+// Hyphens and dots separate name parts.
+func normalizeName(name string) string {
+	name, _, _ = transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	name = strings.ToLower(name)
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r):
+			return r
+		case r == '-' || r == '.' || unicode.IsSpace(r):
+			return ' '
+		}
+		return -1
+	}, name)
+	return strings.Join(strings.Fields(name), " ")
+}
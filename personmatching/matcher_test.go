@@ -0,0 +1,87 @@
+package personmatching
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type personSearchService []*models.Person
+
+// SuggestPeople returns people with a name part or identifier in the query
+func (s personSearchService) SuggestPeople(q string) ([]*models.Person, error) {
+	var hits []*models.Person
+	for _, p := range s {
+		for _, term := range strings.Fields(q) {
+			if term == p.ORCID || term == p.LastName || (len(p.UGentID) > 0 && term == p.UGentID[0]) {
+				hits = append(hits, p)
+				break
+			}
+		}
+	}
+	return hits, nil
+}
+
+func TestNameScore(t *testing.T) {
+	p := &models.Person{FirstName: "Jean-Pierre", LastName: "Dupré"}
+
+	score, reason := NameScore("Jean-Pierre", "Dupre", p)
+	require.Equal(t, 0.9, score)
+	require.Equal(t, ReasonName, reason)
+
+	score, reason = NameScore("J.-P.", "DUPRÉ", p)
+	require.Equal(t, 0.6, score)
+	require.Equal(t, ReasonInitials, reason)
+
+	score, reason = NameScore("Dupré", "Jean-Pierre", p)
+	require.Equal(t, 0.7, score)
+	require.Equal(t, ReasonSwapped, reason)
+
+	score, _ = NameScore("Jacques", "Dupré", p)
+	require.Equal(t, 0.0, score)
+
+	score, _ = NameScore("Jean-Pierre", "Dupont", p)
+	require.Equal(t, 0.0, score)
+}
+
+func TestMatch(t *testing.T) {
+	people := personSearchService{
+		{ID: "1", FirstName: "Jane", LastName: "Doe", ORCID: "0000-0002-1825-0097"},
+		{ID: "2", FirstName: "John", LastName: "Doe", UGentID: []string{"000123"}},
+		{ID: "3", FirstName: "Josephine", LastName: "Doe"},
+	}
+
+	p := &models.Publication{
+		Author: []*models.Contributor{
+			{PersonID: "1"},
+			{ExternalPerson: &models.ExternalPerson{FirstName: "J.", LastName: "Doe"}},
+			{ExternalPerson: &models.ExternalPerson{FirstName: "Jay", LastName: "Doe", ORCID: "0000-0002-1825-0097"}},
+		},
+		Supervisor: []*models.Contributor{
+			{ExternalPerson: &models.ExternalPerson{FirstName: "John", LastName: "Doe", UGentID: "000123"}},
+		},
+	}
+
+	matches, err := NewMatcher(people).Match(p)
+	require.NoError(t, err)
+	require.Len(t, matches, 5)
+
+	// initials match all three people equally
+	for _, m := range matches[:3] {
+		require.Equal(t, "author", m.Role)
+		require.Equal(t, 1, m.Position)
+		require.Equal(t, 0.6, m.Score)
+	}
+
+	require.Equal(t, "author", matches[3].Role)
+	require.Equal(t, 2, matches[3].Position)
+	require.Equal(t, "1", matches[3].PersonID)
+	require.Equal(t, 1.0, matches[3].Score)
+	require.Equal(t, []string{ReasonORCID}, matches[3].Reasons)
+
+	require.Equal(t, "supervisor", matches[4].Role)
+	require.Equal(t, "2", matches[4].PersonID)
+	require.Equal(t, []string{ReasonUGentID}, matches[4].Reasons)
+}
@@ -0,0 +1,76 @@
+package arxiv
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/backends/arxiv"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+var reVersion = regexp.MustCompile(`v\d+$`)
+
+type arxivRecord struct {
+	id   string
+	data []byte
+}
+
+// NewRecord keeps the entry as json, the source id is the arXiv id without
+// version
+func NewRecord(entry arxiv.Entry) (*arxivRecord, error) {
+	id := entry.ID
+	if _, after, ok := strings.Cut(id, "/abs/"); ok {
+		id = after
+	}
+	id = reVersion.ReplaceAllString(id, "")
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arxivRecord{
+		id:   id,
+		data: data,
+	}, nil
+}
+
+func (r *arxivRecord) SourceName() string {
+	return "arxiv"
+}
+
+func (r *arxivRecord) SourceID() string {
+	return r.id
+}
+
+func (r *arxivRecord) SourceMetadata() []byte {
+	return r.data
+}
+
+func (r *arxivRecord) ToCandidateRecord(services *backends.Services) (*models.CandidateRecord, error) {
+	entry := arxiv.Entry{}
+	if err := json.Unmarshal(r.data, &entry); err != nil {
+		return nil, err
+	}
+
+	p := arxiv.MapEntry(r.id, entry)
+	p.Status = "private"
+	p.Classification = "U"
+	p.SourceDB = "arxiv"
+	p.SourceID = r.id
+
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CandidateRecord{
+		SourceName:     r.SourceName(),
+		SourceID:       r.SourceID(),
+		SourceMetadata: r.data,
+		Type:           "Publication",
+		Metadata:       j,
+	}, nil
+}
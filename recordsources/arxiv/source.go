@@ -0,0 +1,172 @@
+package arxiv
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v10"
+	"github.com/ugent-library/biblio-backoffice/backends/arxiv"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+const (
+	pageSize = 100
+	// entries only show up in the api when they are announced, which can be
+	// days after their submission date over weekends and holidays
+	announcementDelay = 7 * 24 * time.Hour
+)
+
+func init() {
+	recordsources.Register("arxiv", NewSource)
+}
+
+type Config struct {
+	URL string `env:"URL" envDefault:"https://export.arxiv.org/api/query"`
+	// entries by one of these authors, e.g. Jane Doe
+	Authors []string `env:"AUTHORS"`
+	// arXiv can't search on affiliation, if set only entries with an author
+	// affiliation that contains this are kept
+	Affiliation string `env:"AFFILIATION"`
+	// only entries that were submitted in the last n days if there was no
+	// previous harvest
+	Days int `env:"DAYS" envDefault:"7"`
+}
+
+func NewSource() (recordsources.Source, error) {
+	c := Config{}
+	if err := env.ParseWithOptions(&c, env.Options{
+		Prefix: "BIBLIO_BACKOFFICE_ARXIV_",
+	}); err != nil {
+		return nil, fmt.Errorf("arxiv: %w", err)
+	}
+	return New(c), nil
+}
+
+func New(c Config) *arxivSource {
+	return &arxivSource{
+		config: c,
+		client: &http.Client{Timeout: 30 * time.Second},
+		// arxiv asks to wait 3 seconds between requests
+		delay: 3 * time.Second,
+	}
+}
+
+type arxivSource struct {
+	config Config
+	client *http.Client
+	delay  time.Duration
+}
+
+func (s *arxivSource) GetRecords(ctx context.Context, cb func(recordsources.Record) error) error {
+	return s.getRecords(ctx, time.Now().AddDate(0, 0, -s.config.Days), cb)
+}
+
+// GetRecordsSince returns entries that were announced since the given time.
+// Entries that were submitted up to a week earlier are included.
+func (s *arxivSource) GetRecordsSince(ctx context.Context, since time.Time, cb func(recordsources.Record) error) error {
+	return s.getRecords(ctx, since.Add(-announcementDelay), cb)
+}
+
+// getRecords returns entries that were submitted since the given time
+func (s *arxivSource) getRecords(ctx context.Context, since time.Time, cb func(recordsources.Record) error) error {
+	if len(s.config.Authors) == 0 {
+		return nil
+	}
+
+	baseURL, err := url.ParseRequestURI(s.config.URL)
+	if err != nil {
+		return fmt.Errorf("arxiv: %w", err)
+	}
+
+	terms := make([]string, len(s.config.Authors))
+	for i, author := range s.config.Authors {
+		terms[i] = fmt.Sprintf("au:%q", author)
+	}
+	query := strings.Join(terms, " OR ")
+
+	for start := 0; ; start += pageSize {
+		if start > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.delay):
+			}
+		}
+
+		u := *baseURL
+		q := u.Query()
+		q.Set("search_query", query)
+		q.Set("sortBy", "submittedDate")
+		q.Set("sortOrder", "descending")
+		q.Set("start", fmt.Sprint(start))
+		q.Set("max_results", fmt.Sprint(pageSize))
+		u.RawQuery = q.Encode()
+
+		body, err := s.fetchPage(ctx, u.String())
+		if err != nil {
+			return fmt.Errorf("arxiv: %w", err)
+		}
+
+		feed := arxiv.Feed{}
+		if err := xml.Unmarshal(body, &feed); err != nil {
+			return fmt.Errorf("arxiv: %w", err)
+		}
+
+		for _, entry := range feed.Entries {
+			// entries are sorted newest first
+			if published, err := time.Parse(time.RFC3339, entry.Published); err == nil && published.Before(since) {
+				return nil
+			}
+			if !s.hasAffiliation(entry) {
+				continue
+			}
+			rec, err := NewRecord(entry)
+			if err != nil {
+				return fmt.Errorf("arxiv: %w", err)
+			}
+			if err := cb(rec); err != nil {
+				return fmt.Errorf("arxiv: %w", err)
+			}
+		}
+
+		if len(feed.Entries) < pageSize {
+			return nil
+		}
+	}
+}
+
+func (s *arxivSource) hasAffiliation(entry arxiv.Entry) bool {
+	if s.config.Affiliation == "" {
+		return true
+	}
+	for _, author := range entry.Author {
+		for _, aff := range author.Affiliation {
+			if strings.Contains(strings.ToLower(aff), strings.ToLower(s.config.Affiliation)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *arxivSource) fetchPage(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %q: %s", u, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
@@ -0,0 +1,81 @@
+package arxiv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+const entry = `<entry>
+	<id>http://arxiv.org/abs/%s</id>
+	<published>%s</published>
+	<title>%s</title>
+	<author><name>Jane Doe</name><arxiv:affiliation>%s</arxiv:affiliation></author>
+</entry>`
+
+func TestGetRecords(t *testing.T) {
+	recent := time.Now().AddDate(0, 0, -1).UTC().Format(time.RFC3339)
+	old := time.Now().AddDate(0, 0, -30).UTC().Format(time.RFC3339)
+
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal(t, `au:"Jane Doe"`, q.Get("search_query"))
+		require.Equal(t, "descending", q.Get("sortOrder"))
+		fmt.Fprintf(w, `<feed xmlns="http://www.w3.org/2005/Atom" xmlns:arxiv="http://arxiv.org/schemas/atom">%s%s%s</feed>`,
+			fmt.Sprintf(entry, "2406.00001v2", recent, "Kept", "Ghent University, Belgium"),
+			fmt.Sprintf(entry, "2406.00002v1", recent, "Other affiliation", "Elsewhere"),
+			fmt.Sprintf(entry, "2405.00003v1", old, "Too old", "Ghent University"),
+		)
+	}))
+	defer stub.Close()
+
+	src := New(Config{URL: stub.URL, Authors: []string{"Jane Doe"}, Affiliation: "ghent university", Days: 7})
+
+	var recs []recordsources.Record
+	err := src.GetRecords(context.Background(), func(rec recordsources.Record) error {
+		recs = append(recs, rec)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	require.Equal(t, "2406.00001", recs[0].SourceID())
+
+	candidateRec, err := recs[0].ToCandidateRecord(nil)
+	require.NoError(t, err)
+
+	p := &models.Publication{}
+	require.NoError(t, json.Unmarshal(candidateRec.Metadata, p))
+	require.Equal(t, "2406.00001", p.ArxivID)
+	require.Equal(t, "Kept", p.Title)
+	require.Equal(t, "arxiv", p.SourceDB)
+}
+
+func TestGetRecordsSinceIncludesLateAnnouncements(t *testing.T) {
+	// submitted on friday, announced on monday
+	submitted := time.Now().AddDate(0, 0, -3).UTC().Format(time.RFC3339)
+
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<feed xmlns="http://www.w3.org/2005/Atom" xmlns:arxiv="http://arxiv.org/schemas/atom">%s</feed>`,
+			fmt.Sprintf(entry, "2406.00004v1", submitted, "Announced late", "Ghent University"),
+		)
+	}))
+	defer stub.Close()
+
+	src := New(Config{URL: stub.URL, Authors: []string{"Jane Doe"}})
+
+	var recs []recordsources.Record
+	err := src.GetRecordsSince(context.Background(), time.Now().AddDate(0, 0, -1), func(rec recordsources.Record) error {
+		recs = append(recs, rec)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+}
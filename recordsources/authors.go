@@ -0,0 +1,36 @@
+package recordsources
+
+import (
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+// LinkAuthorByORCID replaces the i-th author with the known person that has
+// this ORCID iD. This makes the candidate record show up for them. If there is
+// no such person the ORCID iD is kept to match the author later on.
+func LinkAuthorByORCID(services *backends.Services, p *models.Publication, i int, orcid string) error {
+	if orcid == "" || i >= len(p.Author) {
+		return nil
+	}
+	hits, err := services.PersonSearchService.SuggestPeople(orcid)
+	if err != nil {
+		return err
+	}
+	for _, hit := range hits {
+		if hit.ORCID != orcid {
+			continue
+		}
+		p.Author[i] = models.ContributorFromPerson(hit)
+		for _, aff := range hit.Affiliations {
+			p.RemoveOrganization(aff.OrganizationID)
+			p.RelatedOrganizations = append(p.RelatedOrganizations, &models.RelatedOrganization{
+				OrganizationID: aff.OrganizationID,
+			})
+		}
+		return nil
+	}
+	if ep := p.Author[i].ExternalPerson; ep != nil {
+		ep.ORCID = orcid
+	}
+	return nil
+}
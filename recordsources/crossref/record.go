@@ -0,0 +1,93 @@
+package crossref
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/backends/crossref"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+type crossrefRecord struct {
+	id   string
+	data []byte
+}
+
+// volatileFields change every time crossref reindexes a work
+var volatileFields = []string{"indexed", "is-referenced-by-count", "score"}
+
+func NewRecord(doi string, data []byte) *crossrefRecord {
+	return &crossrefRecord{
+		id:   strings.ToLower(doi),
+		data: stripVolatileFields(data),
+	}
+}
+
+// stripVolatileFields keeps the source metadata of a work the same as long
+// as the work itself doesn't change
+func stripVolatileFields(data []byte) []byte {
+	work := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &work); err != nil {
+		return data
+	}
+	for _, field := range volatileFields {
+		delete(work, field)
+	}
+	b, err := json.Marshal(work)
+	if err != nil {
+		return data
+	}
+	return b
+}
+
+func (r *crossrefRecord) SourceName() string {
+	return "crossref"
+}
+
+func (r *crossrefRecord) SourceID() string {
+	return r.id
+}
+
+func (r *crossrefRecord) SourceMetadata() []byte {
+	return r.data
+}
+
+func (r *crossrefRecord) ToCandidateRecord(services *backends.Services) (*models.CandidateRecord, error) {
+	md := gjson.ParseBytes(r.data)
+
+	p := crossref.MapWork(md)
+	p.Status = "private"
+	p.Classification = "U"
+	p.SourceDB = "crossref"
+	p.SourceID = r.id
+
+	for i, author := range md.Get("author").Array() {
+		if err := recordsources.LinkAuthorByORCID(services, p, i, orcidFromURL(author.Get("ORCID").String())); err != nil {
+			return nil, err
+		}
+	}
+
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CandidateRecord{
+		SourceName:     r.SourceName(),
+		SourceID:       r.SourceID(),
+		SourceMetadata: r.data,
+		Type:           "Publication",
+		Metadata:       j,
+	}, nil
+}
+
+// crossref gives ORCID iDs as url
+func orcidFromURL(u string) string {
+	if i := strings.LastIndex(u, "/"); i >= 0 {
+		return u[i+1:]
+	}
+	return u
+}
@@ -0,0 +1,181 @@
+package crossref
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v10"
+	"github.com/tidwall/gjson"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+const (
+	rows = 100
+	// crossref limits the length of the filter parameter
+	orcidsPerQuery = 20
+)
+
+func init() {
+	recordsources.Register("crossref", NewSource)
+}
+
+type Config struct {
+	URL    string `env:"URL" envDefault:"https://api.crossref.org"`
+	Mailto string `env:"MAILTO"`
+	// works with an author affiliation that contains this value
+	Affiliation string `env:"AFFILIATION"`
+	// works by authors with one of these ORCID iDs, see SetORCIDs
+	ORCIDs []string `env:"-"`
+	// only works that were indexed in the last n days if there was no
+	// previous harvest
+	Days int `env:"DAYS" envDefault:"7"`
+}
+
+func NewSource() (recordsources.Source, error) {
+	c := Config{}
+	if err := env.ParseWithOptions(&c, env.Options{
+		Prefix: "BIBLIO_BACKOFFICE_CROSSREF_",
+	}); err != nil {
+		return nil, fmt.Errorf("crossref: %w", err)
+	}
+	return New(c), nil
+}
+
+func New(c Config) *crossrefSource {
+	return &crossrefSource{
+		config: c,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type crossrefSource struct {
+	config Config
+	client *http.Client
+}
+
+// SetORCIDs sets the ORCID iDs of the people whose works are harvested
+func (s *crossrefSource) SetORCIDs(orcids []string) {
+	s.config.ORCIDs = orcids
+}
+
+func (s *crossrefSource) GetRecords(ctx context.Context, cb func(recordsources.Record) error) error {
+	return s.GetRecordsSince(ctx, time.Now().AddDate(0, 0, -s.config.Days), cb)
+}
+
+// GetRecordsSince returns works that were indexed since the given day
+func (s *crossrefSource) GetRecordsSince(ctx context.Context, since time.Time, cb func(recordsources.Record) error) error {
+	baseURL, err := url.ParseRequestURI(strings.TrimSuffix(s.config.URL, "/") + "/works")
+	if err != nil {
+		return fmt.Errorf("crossref: %w", err)
+	}
+
+	filter := "from-index-date:" + since.Format(time.DateOnly)
+
+	var queries []url.Values
+	if s.config.Affiliation != "" {
+		queries = append(queries, url.Values{
+			"filter":            []string{filter},
+			"query.affiliation": []string{s.config.Affiliation},
+		})
+	}
+	for orcids := range slices.Chunk(s.config.ORCIDs, orcidsPerQuery) {
+		f := filter
+		for _, orcid := range orcids {
+			f += ",orcid:" + orcid
+		}
+		queries = append(queries, url.Values{"filter": []string{f}})
+	}
+	if len(queries) == 0 {
+		return fmt.Errorf("crossref: no affiliation or orcid ids: %w", recordsources.ErrNothingToHarvest)
+	}
+
+	// a work can match more than one query
+	seen := map[string]struct{}{}
+
+	for _, q := range queries {
+		err := s.getWorks(ctx, baseURL, q, func(work gjson.Result) error {
+			// the affiliation query is a relevance ranked full text search
+			// that also returns works that only share a word with it
+			if q.Has("query.affiliation") && !hasAffiliation(work, s.config.Affiliation) {
+				return nil
+			}
+			rec := NewRecord(work.Get("DOI").String(), []byte(work.Raw))
+			if _, ok := seen[rec.SourceID()]; ok {
+				return nil
+			}
+			seen[rec.SourceID()] = struct{}{}
+			return cb(rec)
+		})
+		if err != nil {
+			return fmt.Errorf("crossref: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// hasAffiliation reports whether an author of the work has an affiliation
+// that contains aff
+func hasAffiliation(work gjson.Result, aff string) bool {
+	aff = strings.ToLower(aff)
+	for _, name := range work.Get("author.#.affiliation.#.name|@flatten").Array() {
+		if strings.Contains(strings.ToLower(name.String()), aff) {
+			return true
+		}
+	}
+	return false
+}
+
+// getWorks walks all pages of a query with a deep paging cursor
+func (s *crossrefSource) getWorks(ctx context.Context, baseURL *url.URL, q url.Values, cb func(gjson.Result) error) error {
+	cursor := "*"
+	for {
+		u := *baseURL
+		q.Set("rows", fmt.Sprint(rows))
+		q.Set("cursor", cursor)
+		if s.config.Mailto != "" {
+			q.Set("mailto", s.config.Mailto)
+		}
+		u.RawQuery = q.Encode()
+
+		body, err := s.fetchPage(ctx, u.String())
+		if err != nil {
+			return err
+		}
+
+		msg := gjson.GetBytes(body, "message")
+		items := msg.Get("items").Array()
+		for _, item := range items {
+			if err := cb(item); err != nil {
+				return err
+			}
+		}
+
+		cursor = msg.Get("next-cursor").String()
+		if len(items) < rows || cursor == "" {
+			return nil
+		}
+	}
+}
+
+func (s *crossrefSource) fetchPage(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %q: %s", u, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
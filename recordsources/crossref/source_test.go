@@ -0,0 +1,119 @@
+package crossref
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+type personSearchService map[string]*models.Person
+
+func (s personSearchService) SuggestPeople(q string) ([]*models.Person, error) {
+	if p, ok := s[q]; ok {
+		return []*models.Person{p}, nil
+	}
+	return nil, nil
+}
+
+func works(dois ...string) string {
+	items := make([]string, len(dois))
+	for i, doi := range dois {
+		items[i] = fmt.Sprintf(`{"DOI":%q,"type":"journal-article","title":["Work %d"],"author":[{"given":"Jane","family":"Doe","ORCID":"http://orcid.org/0000-0002-1825-0097"},{"given":"John","family":"Roe"}]}`, doi, i)
+	}
+	return fmt.Sprintf(`{"message":{"next-cursor":"next","items":[%s]}}`, strings.Join(items, ","))
+}
+
+func TestGetRecords(t *testing.T) {
+	var queries []string
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/works", r.URL.Path)
+		q := r.URL.Query()
+		queries = append(queries, q.Get("filter"))
+		switch {
+		case q.Get("query.affiliation") != "":
+			require.Equal(t, "Ghent University", q.Get("query.affiliation"))
+			require.Equal(t, "*", q.Get("cursor"))
+			w.Write([]byte(`{"message":{"next-cursor":"next","items":[` +
+				`{"DOI":"10.1000/A","author":[{"given":"Jane","family":"Doe","affiliation":[{"name":"Dept. of Biology, Ghent University, Belgium"}]}]},` +
+				`{"DOI":"10.1000/B","author":[{"given":"John","family":"Roe"},{"given":"Jane","family":"Doe","affiliation":[{"name":"ghent university"}]}]},` +
+				`{"DOI":"10.1000/D","author":[{"given":"John","family":"Roe","affiliation":[{"name":"Ghent University Hospital"}]}]},` +
+				`{"DOI":"10.1000/E","author":[{"given":"John","family":"Roe","affiliation":[{"name":"University of Oxford"}]}]}` +
+				`]}}`))
+		default:
+			w.Write([]byte(works("10.1000/b", "10.1000/c")))
+		}
+	}))
+	defer stub.Close()
+
+	src := New(Config{
+		URL:         stub.URL,
+		Affiliation: "Ghent University",
+		ORCIDs:      []string{"0000-0002-1825-0097", "0000-0001-5109-3700"},
+		Days:        7,
+	})
+
+	var ids []string
+	err := src.GetRecords(context.Background(), func(rec recordsources.Record) error {
+		ids = append(ids, rec.SourceID())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.1000/a", "10.1000/b", "10.1000/d", "10.1000/c"}, ids)
+	require.Len(t, queries, 2)
+	require.Contains(t, queries[1], ",orcid:0000-0002-1825-0097,orcid:0000-0001-5109-3700")
+}
+
+func TestGetRecordsWithoutQueries(t *testing.T) {
+	src := New(Config{Days: 7})
+	err := src.GetRecords(context.Background(), func(rec recordsources.Record) error {
+		return nil
+	})
+	require.ErrorIs(t, err, recordsources.ErrNothingToHarvest)
+}
+
+func TestToCandidateRecord(t *testing.T) {
+	person := &models.Person{
+		ID:           "1",
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		ORCID:        "0000-0002-1825-0097",
+		Affiliations: []*models.Affiliation{{OrganizationID: "CA20"}},
+	}
+	services := &backends.Services{
+		PersonSearchService: personSearchService{person.ORCID: person},
+	}
+
+	rec := NewRecord("10.1000/A", []byte(`{"DOI":"10.1000/A","type":"journal-article","title":["A work"],"author":[{"given":"Jane","family":"Doe","ORCID":"http://orcid.org/0000-0002-1825-0097"},{"given":"John","family":"Roe"}]}`))
+	candidateRec, err := rec.ToCandidateRecord(services)
+	require.NoError(t, err)
+	require.Equal(t, "crossref", candidateRec.SourceName)
+	require.Equal(t, "10.1000/a", candidateRec.SourceID)
+
+	p := &models.Publication{}
+	require.NoError(t, json.Unmarshal(candidateRec.Metadata, p))
+	require.Equal(t, "journal_article", p.Type)
+	require.Equal(t, "A work", p.Title)
+	require.Len(t, p.Author, 2)
+	require.Equal(t, "1", p.Author[0].PersonID)
+	require.Equal(t, "", p.Author[1].PersonID)
+	require.Len(t, p.RelatedOrganizations, 1)
+	require.Equal(t, "CA20", p.RelatedOrganizations[0].OrganizationID)
+}
+
+func TestSourceMetadataIgnoresVolatileFields(t *testing.T) {
+	a := NewRecord("10.1000/A", []byte(`{"DOI":"10.1000/A","title":["A work"],"score":12.5,"indexed":{"date-time":"2024-06-01T10:00:00Z"},"is-referenced-by-count":3}`))
+	b := NewRecord("10.1000/A", []byte(`{"title":["A work"],"is-referenced-by-count":4,"DOI":"10.1000/A","score":1,"indexed":{"date-time":"2024-06-02T10:00:00Z"}}`))
+	require.Equal(t, a.SourceMetadata(), b.SourceMetadata())
+
+	c := NewRecord("10.1000/A", []byte(`{"DOI":"10.1000/A","title":["Another work"],"score":12.5}`))
+	require.NotEqual(t, a.SourceMetadata(), c.SourceMetadata())
+}
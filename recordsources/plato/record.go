@@ -33,6 +33,10 @@ func (r *platoRecord) SourceID() string {
 	return r.id
 }
 
+func (r *platoRecord) SourceMetadata() []byte {
+	return r.data
+}
+
 func (r *platoRecord) ToCandidateRecord(services *backends.Services) (*models.CandidateRecord, error) {
 	p := &models.Publication{}
 	md := gjson.ParseBytes(r.data)
@@ -0,0 +1,69 @@
+package pubmed
+
+import (
+	"encoding/json"
+
+	"github.com/tidwall/gjson"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/backends/pubmed"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+type pubmedRecord struct {
+	id   string
+	data []byte
+}
+
+func NewRecord(pmid string, data []byte) *pubmedRecord {
+	return &pubmedRecord{
+		id:   pmid,
+		data: data,
+	}
+}
+
+func (r *pubmedRecord) SourceName() string {
+	return "pubmed"
+}
+
+func (r *pubmedRecord) SourceID() string {
+	return r.id
+}
+
+func (r *pubmedRecord) SourceMetadata() []byte {
+	return r.data
+}
+
+func (r *pubmedRecord) ToCandidateRecord(services *backends.Services) (*models.CandidateRecord, error) {
+	md := gjson.ParseBytes(r.data)
+
+	p := pubmed.MapResult(md)
+	p.Status = "private"
+	p.Classification = "U"
+	p.PublicationStatus = "published"
+	p.SourceDB = "pubmed"
+	p.SourceID = r.id
+
+	for i, author := range md.Get("authorList.author").Array() {
+		var orcid string
+		if author.Get("authorId.type").String() == "ORCID" {
+			orcid = author.Get("authorId.value").String()
+		}
+		if err := recordsources.LinkAuthorByORCID(services, p, i, orcid); err != nil {
+			return nil, err
+		}
+	}
+
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CandidateRecord{
+		SourceName:     r.SourceName(),
+		SourceID:       r.SourceID(),
+		SourceMetadata: r.data,
+		Type:           "Publication",
+		Metadata:       j,
+	}, nil
+}
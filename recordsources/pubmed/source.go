@@ -0,0 +1,138 @@
+package pubmed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v10"
+	"github.com/tidwall/gjson"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+const pageSize = 100
+
+func init() {
+	recordsources.Register("pubmed", NewSource)
+}
+
+type Config struct {
+	// Europe PMC search endpoint
+	URL string `env:"URL" envDefault:"https://www.ebi.ac.uk/europepmc/webservices/rest/search"`
+	// records with an author affiliation that matches this query
+	Affiliation string `env:"AFFILIATION"`
+	// records by one of these authors, e.g. Doe J
+	Authors []string `env:"AUTHORS"`
+	// only records that were indexed in the last n days if there was no
+	// previous harvest
+	Days int `env:"DAYS" envDefault:"7"`
+}
+
+func NewSource() (recordsources.Source, error) {
+	c := Config{}
+	if err := env.ParseWithOptions(&c, env.Options{
+		Prefix: "BIBLIO_BACKOFFICE_PUBMED_",
+	}); err != nil {
+		return nil, fmt.Errorf("pubmed: %w", err)
+	}
+	return New(c), nil
+}
+
+func New(c Config) *pubmedSource {
+	return &pubmedSource{
+		config: c,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type pubmedSource struct {
+	config Config
+	client *http.Client
+}
+
+// searchQuery returns the Europe PMC query for the configured authors and
+// affiliation. An empty string means there is nothing to harvest.
+func (s *pubmedSource) searchQuery(since, now time.Time) string {
+	var terms []string
+	if s.config.Affiliation != "" {
+		terms = append(terms, fmt.Sprintf("AFF:%q", s.config.Affiliation))
+	}
+	for _, author := range s.config.Authors {
+		terms = append(terms, fmt.Sprintf("AUTH:%q", author))
+	}
+	if len(terms) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%s) AND SRC:MED AND FIRST_IDATE:[%s TO %s]",
+		strings.Join(terms, " OR "),
+		since.Format(time.DateOnly),
+		now.Format(time.DateOnly),
+	)
+}
+
+func (s *pubmedSource) GetRecords(ctx context.Context, cb func(recordsources.Record) error) error {
+	return s.GetRecordsSince(ctx, time.Now().AddDate(0, 0, -s.config.Days), cb)
+}
+
+// GetRecordsSince returns records that were indexed since the given day
+func (s *pubmedSource) GetRecordsSince(ctx context.Context, since time.Time, cb func(recordsources.Record) error) error {
+	query := s.searchQuery(since, time.Now())
+	if query == "" {
+		return nil
+	}
+
+	baseURL, err := url.ParseRequestURI(s.config.URL)
+	if err != nil {
+		return fmt.Errorf("pubmed: %w", err)
+	}
+
+	cursor := "*"
+	for {
+		u := *baseURL
+		q := u.Query()
+		q.Set("query", query)
+		q.Set("format", "json")
+		q.Set("resultType", "core")
+		q.Set("pageSize", fmt.Sprint(pageSize))
+		q.Set("cursorMark", cursor)
+		u.RawQuery = q.Encode()
+
+		body, err := s.fetchPage(ctx, u.String())
+		if err != nil {
+			return fmt.Errorf("pubmed: %w", err)
+		}
+
+		results := gjson.GetBytes(body, "resultList.result").Array()
+		for _, res := range results {
+			if err := cb(NewRecord(res.Get("pmid").String(), []byte(res.Raw))); err != nil {
+				return fmt.Errorf("pubmed: %w", err)
+			}
+		}
+
+		nextCursor := gjson.GetBytes(body, "nextCursorMark").String()
+		if len(results) < pageSize || nextCursor == "" || nextCursor == cursor {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+func (s *pubmedSource) fetchPage(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %q: %s", u, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
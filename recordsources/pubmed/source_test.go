@@ -0,0 +1,82 @@
+package pubmed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/recordsources"
+)
+
+type personSearchService map[string]*models.Person
+
+func (s personSearchService) SuggestPeople(q string) ([]*models.Person, error) {
+	if p, ok := s[q]; ok {
+		return []*models.Person{p}, nil
+	}
+	return nil, nil
+}
+
+const result = `{
+	"pmid": "38000001",
+	"title": "A study",
+	"pubYear": "2024",
+	"journalInfo": {"volume": "12", "journal": {"title": "Journal of Studies"}},
+	"authorList": {"author": [
+		{"firstName": "Jane", "lastName": "Doe", "authorId": {"type": "ORCID", "value": "0000-0002-1825-0097"}},
+		{"firstName": "John", "lastName": "Roe"}
+	]}
+}`
+
+func TestSearchQuery(t *testing.T) {
+	src := New(Config{Affiliation: "Ghent University", Authors: []string{"Doe J"}, Days: 7})
+	since := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, `(AFF:"Ghent University" OR AUTH:"Doe J") AND SRC:MED AND FIRST_IDATE:[2024-06-03 TO 2024-06-10]`, src.searchQuery(since, now))
+
+	require.Equal(t, "", New(Config{Days: 7}).searchQuery(since, now))
+}
+
+func TestGetRecords(t *testing.T) {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal(t, "core", q.Get("resultType"))
+		require.Equal(t, "*", q.Get("cursorMark"))
+		w.Write([]byte(`{"hitCount": 1, "nextCursorMark": "AoE", "resultList": {"result": [` + result + `]}}`))
+	}))
+	defer stub.Close()
+
+	src := New(Config{URL: stub.URL, Affiliation: "Ghent University", Days: 7})
+
+	var recs []recordsources.Record
+	err := src.GetRecords(context.Background(), func(rec recordsources.Record) error {
+		recs = append(recs, rec)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	require.Equal(t, "38000001", recs[0].SourceID())
+
+	services := &backends.Services{
+		PersonSearchService: personSearchService{
+			"0000-0002-1825-0097": {ID: "1", FirstName: "Jane", LastName: "Doe", ORCID: "0000-0002-1825-0097"},
+		},
+	}
+	candidateRec, err := recs[0].ToCandidateRecord(services)
+	require.NoError(t, err)
+	require.Equal(t, "pubmed", candidateRec.SourceName)
+
+	p := &models.Publication{}
+	require.NoError(t, json.Unmarshal(candidateRec.Metadata, p))
+	require.Equal(t, "38000001", p.PubMedID)
+	require.Equal(t, "Journal of Studies", p.Publication)
+	require.Len(t, p.Author, 2)
+	require.Equal(t, "1", p.Author[0].PersonID)
+	require.Equal(t, "", p.Author[1].PersonID)
+}
@@ -2,8 +2,11 @@ package recordsources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/ugent-library/biblio-backoffice/backends"
 	"github.com/ugent-library/biblio-backoffice/models"
@@ -13,9 +16,27 @@ type Source interface {
 	GetRecords(context.Context, func(Record) error) error
 }
 
+// IncrementalSource can skip records that didn't change since the last harvest
+type IncrementalSource interface {
+	Source
+	GetRecordsSince(context.Context, time.Time, func(Record) error) error
+}
+
+// PeopleSource harvests the records of known people. The ORCID iDs of the
+// people in the person authority are set before each harvest.
+type PeopleSource interface {
+	Source
+	SetORCIDs([]string)
+}
+
+// ErrNothingToHarvest is returned by sources that have no queries to run
+var ErrNothingToHarvest = errors.New("source has nothing to harvest")
+
 type Record interface {
 	SourceName() string
 	SourceID() string
+	// the raw record, used to detect changes
+	SourceMetadata() []byte
 	ToCandidateRecord(*backends.Services) (*models.CandidateRecord, error)
 }
 
@@ -39,3 +60,15 @@ func New(name string) (Source, error) {
 	}
 	return factory()
 }
+
+// Names returns the names of all registered sources
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
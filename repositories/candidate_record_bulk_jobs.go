@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+// GetNewCandidateRecordIDs returns the ids of at most limit new candidate
+// records that match the search filters, oldest first
+func (r *Repo) GetNewCandidateRecordIDs(ctx context.Context, searchArgs *models.SearchArgs, limit int) ([]string, error) {
+	query := addQueryFilters(getBaseQuery("id"), searchArgs).
+		Where(sq.Eq{"status": "new"}).
+		OrderBy("date_created ASC").
+		Limit(uint64(limit))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetNewCandidateRecordIDs: %w", err)
+	}
+	rows, err := r.conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetNewCandidateRecordIDs: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetNewCandidateRecordIDs: %w", err)
+	}
+	return ids, nil
+}
+
+// ImportCandidateRecordsAsPublications imports candidate records as draft
+// publications in a single transaction and returns the ids of the new
+// publications. Records that are no longer new are skipped.
+func (r *Repo) ImportCandidateRecordsAsPublications(ctx context.Context, ids []string, user *models.Person) ([]string, error) {
+	var pubIDs []string
+
+	err := r.tx(ctx, func(r *Repo) error {
+		pubIDs = nil
+		for _, id := range ids {
+			// lock the record so that concurrent jobs, imports and rejects
+			// wait for this transaction and then see the new status
+			isNew, err := r.lockNewCandidateRecord(ctx, id)
+			if err != nil {
+				return err
+			}
+			if !isNew {
+				continue
+			}
+			rec, err := r.GetCandidateRecord(ctx, id)
+			if err != nil {
+				return err
+			}
+			if err := r.importCandidateRecord(ctx, rec, user); err != nil {
+				return fmt.Errorf("%s: %w", id, err)
+			}
+			pubIDs = append(pubIDs, rec.Publication.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.ImportCandidateRecordsAsPublications: %w", err)
+	}
+
+	return pubIDs, nil
+}
+
+// lockNewCandidateRecord locks a candidate record until the end of the
+// transaction and tells if it is still new
+func (r *Repo) lockNewCandidateRecord(ctx context.Context, id string) (bool, error) {
+	q := `
+		select status = 'new' from candidate_records where id = $1 for update;
+	`
+	var isNew bool
+	err := r.db().QueryRow(ctx, q, id).Scan(&isNew)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return isNew, err
+}
+
+// RejectCandidateRecords rejects the candidate records that are still new and
+// returns how many were rejected. The reason is optional.
+func (r *Repo) RejectCandidateRecords(ctx context.Context, ids []string, reason string, user *models.Person) (int, error) {
+	q := `
+		update candidate_records
+		set status = 'rejected', status_date = now(), status_person_id = $2, status_reason = nullif($3, ''), imported_id = null
+		where id = any($1) and status = 'new';
+	`
+	res, err := r.conn.Exec(ctx, q, ids, user.ID, reason)
+	if err != nil {
+		return 0, fmt.Errorf("repo.RejectCandidateRecords: %w", err)
+	}
+	return int(res.RowsAffected()), nil
+}
+
+// AssignCandidateRecords hands the candidate records that are still new to a
+// researcher, who can then import or reject them like their own. The
+// researcher and their proxies are notified. It returns how many records were
+// assigned.
+func (r *Repo) AssignCandidateRecords(ctx context.Context, ids []string, personID string, user *models.Person) (int, error) {
+	q := `
+		update candidate_records set assigned_person_id = $2
+		where id = any($1) and status = 'new'
+		returning id, coalesce(metadata->>'title', '');
+	`
+	rows, err := r.conn.Query(ctx, q, ids, personID)
+	if err != nil {
+		return 0, fmt.Errorf("repo.AssignCandidateRecords: %w", err)
+	}
+	type assigned struct {
+		ID    string
+		Title string
+	}
+	recs, err := pgx.CollectRows(rows, pgx.RowToStructByPos[assigned])
+	if err != nil {
+		return 0, fmt.Errorf("repo.AssignCandidateRecords: %w", err)
+	}
+
+	recipients, err := r.notificationRecipients(ctx, []owner{{id: personID, ids: []string{personID}}}, user)
+	if err != nil {
+		return 0, fmt.Errorf("repo.AssignCandidateRecords: %w", err)
+	}
+	for _, rec := range recs {
+		n := &models.Notification{
+			Kind:       models.NotificationCandidateRecord,
+			RecordType: "candidate_record",
+			RecordID:   rec.ID,
+			Title:      rec.Title,
+			ActorID:    user.ID,
+		}
+		if err := r.addNotifications(ctx, recipients, n, ""); err != nil {
+			return 0, fmt.Errorf("repo.AssignCandidateRecords: %w", err)
+		}
+	}
+
+	return len(recs), nil
+}
+
+type candidateRecordBulkJobRow struct {
+	ID                 string
+	UserID             string
+	Action             string
+	CandidateRecordIDs []string
+	Reason             *string
+	PersonID           *string
+	Status             string
+	Processed          int
+	Skipped            int
+	Total              int
+	Error              *string
+	DateCreated        time.Time
+	DateUpdated        time.Time
+}
+
+func (row candidateRecordBulkJobRow) toModel() *models.CandidateRecordBulkJob {
+	return &models.CandidateRecordBulkJob{
+		ID:                 row.ID,
+		UserID:             row.UserID,
+		Action:             row.Action,
+		CandidateRecordIDs: row.CandidateRecordIDs,
+		Reason:             lo.FromPtr(row.Reason),
+		PersonID:           lo.FromPtr(row.PersonID),
+		Status:             row.Status,
+		Processed:          row.Processed,
+		Skipped:            row.Skipped,
+		Total:              row.Total,
+		Error:              lo.FromPtr(row.Error),
+		DateCreated:        row.DateCreated,
+		DateUpdated:        row.DateUpdated,
+	}
+}
+
+func (r *Repo) queryCandidateRecordBulkJobs(ctx context.Context, q string, args ...any) ([]*models.CandidateRecordBulkJob, error) {
+	rows, err := r.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	jobRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[candidateRecordBulkJobRow])
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*models.CandidateRecordBulkJob, 0, len(jobRows))
+	for _, row := range jobRows {
+		job := row.toModel()
+		for _, fn := range r.config.CandidateRecordBulkJobLoaders {
+			if err := fn(job); err != nil {
+				return nil, err
+			}
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (r *Repo) AddCandidateRecordBulkJob(ctx context.Context, job *models.CandidateRecordBulkJob) error {
+	job.ID = ulid.Make().String()
+	job.Status = models.CandidateRecordBulkJobPending
+	job.Total = len(job.CandidateRecordIDs)
+
+	q := `
+		insert into candidate_record_bulk_jobs (id, user_id, action, candidate_record_ids, reason, person_id, status, total)
+		values ($1, $2, $3, $4, nullif($5, ''), nullif($6, ''), $7, $8)
+		returning date_created, date_updated;
+	`
+	err := r.conn.QueryRow(ctx, q, job.ID, job.UserID, job.Action, job.CandidateRecordIDs, job.Reason, job.PersonID, job.Status, job.Total).
+		Scan(&job.DateCreated, &job.DateUpdated)
+	if err != nil {
+		return fmt.Errorf("repo.AddCandidateRecordBulkJob: %w", err)
+	}
+
+	return nil
+}
+
+// GetCandidateRecordBulkJobsByUser returns the most recent bulk jobs of a
+// user, newest first
+func (r *Repo) GetCandidateRecordBulkJobsByUser(ctx context.Context, userID string, limit int) ([]*models.CandidateRecordBulkJob, error) {
+	q := `
+		select * from candidate_record_bulk_jobs
+		where user_id = $1
+		order by date_created desc
+		limit $2;
+	`
+	jobs, err := r.queryCandidateRecordBulkJobs(ctx, q, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetCandidateRecordBulkJobsByUser %s: %w", userID, err)
+	}
+	return jobs, nil
+}
+
+// ClaimCandidateRecordBulkJob marks the oldest pending bulk job as running and
+// returns it. Running jobs that haven't reported progress since staleBefore
+// are assumed to be abandoned by a crashed worker and are claimed again, they
+// resume after the last reported record. Nil is returned if there is nothing
+// to do.
+func (r *Repo) ClaimCandidateRecordBulkJob(ctx context.Context, staleBefore time.Time) (*models.CandidateRecordBulkJob, error) {
+	q := `
+		update candidate_record_bulk_jobs set status = 'running', date_updated = now()
+		where id = (
+			select id from candidate_record_bulk_jobs
+			where status = 'pending' or (status = 'running' and date_updated < $1)
+			order by date_created
+			limit 1
+			for update skip locked
+		)
+		returning *;
+	`
+	jobs, err := r.queryCandidateRecordBulkJobs(ctx, q, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ClaimCandidateRecordBulkJob: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+func (r *Repo) UpdateCandidateRecordBulkJobProgress(ctx context.Context, id string, processed, skipped int) error {
+	q := `
+		update candidate_record_bulk_jobs set processed = $2, skipped = $3, date_updated = now()
+		where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, processed, skipped); err != nil {
+		return fmt.Errorf("repo.UpdateCandidateRecordBulkJobProgress %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Repo) CompleteCandidateRecordBulkJob(ctx context.Context, job *models.CandidateRecordBulkJob) error {
+	q := `
+		update candidate_record_bulk_jobs set status = 'done', processed = $2, skipped = $3, date_updated = now()
+		where id = $1;
+	`
+	job.Status = models.CandidateRecordBulkJobDone
+	if _, err := r.conn.Exec(ctx, q, job.ID, job.Processed, job.Skipped); err != nil {
+		return fmt.Errorf("repo.CompleteCandidateRecordBulkJob %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+// FailCandidateRecordBulkJob gives up on a bulk job. Records that were
+// processed before the failure aren't rolled back.
+func (r *Repo) FailCandidateRecordBulkJob(ctx context.Context, id string, jobErr error) error {
+	q := `
+		update candidate_record_bulk_jobs set status = 'failed', error = $2, date_updated = now()
+		where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, jobErr.Error()); err != nil {
+		return fmt.Errorf("repo.FailCandidateRecordBulkJob %s: %w", id, err)
+	}
+	return nil
+}
@@ -17,18 +17,20 @@ import (
 )
 
 type candidateRecordRow struct {
-	ID             string
-	SourceName     string
-	SourceID       string
-	SourceMetadata []byte
-	Type           string
-	Metadata       json.RawMessage
-	Status         string
-	DateCreated    time.Time
-	StatusDate     *time.Time
-	StatusPersonID *string
-	ImportedID     *string
-	Total          int
+	ID               string
+	SourceName       string
+	SourceID         string
+	SourceMetadata   []byte
+	Type             string
+	Metadata         json.RawMessage
+	Status           string
+	DateCreated      time.Time
+	StatusDate       *time.Time
+	StatusPersonID   *string
+	ImportedID       *string
+	StatusReason     *string
+	AssignedPersonID *string
+	Total            int
 }
 
 func (r *Repo) AddCandidateRecord(ctx context.Context, rec *models.CandidateRecord) error {
@@ -41,8 +43,53 @@ func (r *Repo) AddCandidateRecord(ctx context.Context, rec *models.CandidateReco
 		Type:           rec.Type,
 		Metadata:       rec.Metadata,
 	}
-	_, err := r.queries.AddCandidateRecord(ctx, params)
-	return err
+	if _, err := r.queries.AddCandidateRecord(ctx, params); err != nil {
+		return err
+	}
+	return r.notifyCandidateRecord(ctx, rec)
+}
+
+// UpdateCandidateRecordSource replaces the source metadata and metadata of a
+// candidate record that is still new. Contributors that a curator linked to a
+// person stay linked.
+func (r *Repo) UpdateCandidateRecordSource(ctx context.Context, id string, rec *models.CandidateRecord) error {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("repo.UpdateCandidateRecordSource %s: %w", id, err)
+	}
+	defer tx.Rollback(ctx)
+
+	// wait for a confirmation that is in progress
+	var isNew bool
+	err = tx.QueryRow(ctx, `
+		select status = 'new' from candidate_records where id = $1 for update;
+	`, id).Scan(&isNew)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !isNew) {
+		return fmt.Errorf("repo.UpdateCandidateRecordSource %s: %w", id, models.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("repo.UpdateCandidateRecordSource %s: %w", id, err)
+	}
+
+	metadata, err := applyConfirmedPersonMatches(ctx, tx, id, rec.Metadata)
+	if err != nil {
+		return fmt.Errorf("repo.UpdateCandidateRecordSource %s: %w", id, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		update candidate_records set source_metadata = $2, metadata = $3 where id = $1;
+	`, id, rec.SourceMetadata, metadata)
+	if err != nil {
+		return fmt.Errorf("repo.UpdateCandidateRecordSource %s: %w", id, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("repo.UpdateCandidateRecordSource %s: %w", id, err)
+	}
+
+	rec.Metadata = metadata
+
+	return nil
 }
 
 func (r *Repo) GetCandidateRecords(ctx context.Context, searchArgs *models.SearchArgs) (total int, result []*models.CandidateRecord, err error) {
@@ -65,7 +112,10 @@ func (r *Repo) HasCandidateRecords(ctx context.Context) (bool, error) {
 }
 
 func (r *Repo) PersonHasCandidateRecords(ctx context.Context, personID string) (bool, error) {
-	exists, err := r.queries.PersonHasCandidateRecords(ctx, getPersonFilter(personID))
+	exists, err := r.queries.PersonHasCandidateRecords(ctx, db.PersonHasCandidateRecordsParams{
+		Query:    getPersonFilter(personID),
+		PersonID: &personID,
+	})
 	if err != nil {
 		return false, err
 	}
@@ -73,7 +123,10 @@ func (r *Repo) PersonHasCandidateRecords(ctx context.Context, personID string) (
 }
 
 func (r *Repo) CountPersonCandidateRecords(ctx context.Context, personID string) (int, error) {
-	n, err := r.queries.CountPersonCandidateRecords(ctx, getPersonFilter(personID))
+	n, err := r.queries.CountPersonCandidateRecords(ctx, db.CountPersonCandidateRecordsParams{
+		Query:    getPersonFilter(personID),
+		PersonID: &personID,
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -93,17 +146,20 @@ func (r *Repo) GetCandidateRecordBySource(ctx context.Context, sourceName string
 	}
 
 	rec := &models.CandidateRecord{
-		ID:             row.ID,
-		SourceName:     row.SourceName,
-		SourceID:       row.SourceID,
-		Type:           row.Type,
-		Metadata:       row.Metadata,
-		DateCreated:    row.DateCreated.Time,
-		Status:         row.Status,
-		Publication:    &models.Publication{},
-		StatusDate:     &row.StatusDate.Time,
-		StatusPersonID: lo.FromPtr(row.StatusPersonID),
-		ImportedID:     lo.FromPtr(row.ImportedID),
+		ID:               row.ID,
+		SourceName:       row.SourceName,
+		SourceID:         row.SourceID,
+		SourceMetadata:   row.SourceMetadata,
+		Type:             row.Type,
+		Metadata:         row.Metadata,
+		DateCreated:      row.DateCreated.Time,
+		Status:           row.Status,
+		Publication:      &models.Publication{},
+		StatusDate:       &row.StatusDate.Time,
+		StatusPersonID:   lo.FromPtr(row.StatusPersonID),
+		ImportedID:       lo.FromPtr(row.ImportedID),
+		StatusReason:     lo.FromPtr(row.StatusReason),
+		AssignedPersonID: lo.FromPtr(row.AssignedPersonID),
 	}
 
 	r.loadCandidateRecord(rec)
@@ -121,17 +177,20 @@ func (r *Repo) GetCandidateRecord(ctx context.Context, id string) (*models.Candi
 	}
 
 	rec := &models.CandidateRecord{
-		ID:             row.ID,
-		SourceName:     row.SourceName,
-		SourceID:       row.SourceID,
-		Type:           row.Type,
-		Metadata:       row.Metadata,
-		DateCreated:    row.DateCreated.Time,
-		Status:         row.Status,
-		Publication:    &models.Publication{},
-		StatusDate:     &row.StatusDate.Time,
-		StatusPersonID: lo.FromPtr(row.StatusPersonID),
-		ImportedID:     lo.FromPtr(row.ImportedID),
+		ID:               row.ID,
+		SourceName:       row.SourceName,
+		SourceID:         row.SourceID,
+		SourceMetadata:   row.SourceMetadata,
+		Type:             row.Type,
+		Metadata:         row.Metadata,
+		DateCreated:      row.DateCreated.Time,
+		Status:           row.Status,
+		Publication:      &models.Publication{},
+		StatusDate:       &row.StatusDate.Time,
+		StatusPersonID:   lo.FromPtr(row.StatusPersonID),
+		ImportedID:       lo.FromPtr(row.ImportedID),
+		StatusReason:     lo.FromPtr(row.StatusReason),
+		AssignedPersonID: lo.FromPtr(row.AssignedPersonID),
 	}
 
 	r.loadCandidateRecord(rec)
@@ -181,25 +240,8 @@ func (r *Repo) ImportCandidateRecordAsPublication(ctx context.Context, id string
 		return "", err
 	}
 
-	rec.Publication.ID = ulid.Make().String()
-	rec.Publication.CreatorID = user.ID
-	rec.Publication.Creator = user
-
 	err = r.tx(ctx, func(r *Repo) error {
-		if err := r.SavePublication(rec.Publication, user); err != nil {
-			return err
-		}
-
-		if _, err := r.queries.SetCandidateRecordStatus(ctx, db.SetCandidateRecordStatusParams{
-			ID:             rec.ID,
-			Status:         "imported",
-			StatusPersonID: &user.ID,
-			ImportedID:     &rec.Publication.ID,
-		}); err != nil {
-			return err
-		}
-
-		return nil
+		return r.importCandidateRecord(ctx, rec, user)
 	})
 	if err != nil {
 		return "", err
@@ -208,6 +250,29 @@ func (r *Repo) ImportCandidateRecordAsPublication(ctx context.Context, id string
 	return rec.Publication.ID, nil
 }
 
+// importCandidateRecord saves the candidate record as a new publication, it
+// should be called inside a transaction
+func (r *Repo) importCandidateRecord(ctx context.Context, rec *models.CandidateRecord, user *models.Person) error {
+	rec.Publication.ID = ulid.Make().String()
+	rec.Publication.CreatorID = user.ID
+	rec.Publication.Creator = user
+
+	if err := r.SavePublication(rec.Publication, user); err != nil {
+		return err
+	}
+
+	if _, err := r.queries.SetCandidateRecordStatus(ctx, db.SetCandidateRecordStatusParams{
+		ID:             rec.ID,
+		Status:         "imported",
+		StatusPersonID: &user.ID,
+		ImportedID:     &rec.Publication.ID,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repo) GetCandidateRecordsStatusFacet(ctx context.Context, searchArgs *models.SearchArgs) (models.FacetValues, error) {
 	query := getBaseQuery("status AS Value", "COUNT(*) AS Count").
 		OrderBy("array_position(ARRAY['new', 'imported', 'rejected'], status)").
@@ -325,7 +390,7 @@ func addQueryFilters(query sq.SelectBuilder, searchArgs *models.SearchArgs, omit
 
 		case "person_id":
 			personFilter := getPersonFilter(filterValue[0])
-			query = query.Where("(metadata->'author' @> ?::jsonb OR metadata->'supervisor' @> ?::jsonb)", personFilter, personFilter)
+			query = query.Where("(metadata->'author' @> ?::jsonb OR metadata->'supervisor' @> ?::jsonb OR assigned_person_id = ?)", personFilter, personFilter, filterValue[0])
 		}
 	}
 
@@ -355,17 +420,19 @@ func (r *Repo) mapRows(rows []candidateRecordRow) (int, []*models.CandidateRecor
 
 	for _, item := range rows {
 		result := &models.CandidateRecord{
-			ID:             item.ID,
-			SourceName:     item.SourceName,
-			SourceID:       item.SourceID,
-			Type:           item.Type,
-			Metadata:       item.Metadata,
-			DateCreated:    item.DateCreated,
-			Status:         item.Status,
-			Publication:    &models.Publication{},
-			StatusDate:     item.StatusDate,
-			StatusPersonID: lo.FromPtr(item.StatusPersonID),
-			ImportedID:     lo.FromPtr(item.ImportedID),
+			ID:               item.ID,
+			SourceName:       item.SourceName,
+			SourceID:         item.SourceID,
+			Type:             item.Type,
+			Metadata:         item.Metadata,
+			DateCreated:      item.DateCreated,
+			Status:           item.Status,
+			Publication:      &models.Publication{},
+			StatusDate:       item.StatusDate,
+			StatusPersonID:   lo.FromPtr(item.StatusPersonID),
+			ImportedID:       lo.FromPtr(item.ImportedID),
+			StatusReason:     lo.FromPtr(item.StatusReason),
+			AssignedPersonID: lo.FromPtr(item.AssignedPersonID),
 		}
 
 		err := r.loadCandidateRecord(result)
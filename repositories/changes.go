@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/changefeed"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+)
+
+// ChangesCursor returns the change feed position from where all publications
+// and datasets that changed since t are sent. Pass the current time to start
+// at the current position. Snapshots from before transaction ids were recorded
+// all count as transaction 0, so a t before that sends all of them.
+func (s *Repo) ChangesCursor(ctx context.Context, t time.Time) (changefeed.Cursor, error) {
+	q := `
+		select coalesce(least(
+			(select min(txid) from publications where date_until is null and date_from >= $1),
+			(select min(txid) from datasets where date_until is null and date_from >= $1)
+		), txid_snapshot_xmin(txid_current_snapshot()));
+	`
+	var cursor changefeed.Cursor
+	if err := s.conn.QueryRow(ctx, q, t).Scan(&cursor.TxID); err != nil {
+		return cursor, fmt.Errorf("repo.ChangesCursor: %w", err)
+	}
+	return cursor, nil
+}
+
+// ChangesAfter returns the current version of publications and datasets that
+// changed after cursor, in transaction order. Snapshots of transactions that
+// are still running hold back the snapshots of all later transactions, this
+// way no change is skipped no matter how long a transaction takes to commit.
+func (s *Repo) ChangesAfter(ctx context.Context, cursor changefeed.Cursor, limit int) ([]*models.Change, error) {
+	q := `
+		with horizon as (
+			select txid_snapshot_xmin(txid_current_snapshot()) as txid
+		)
+		select * from (
+			select 'publication' as type, txid, snapshot_id, id, data, date_from from publications
+			where date_until is null and (txid, snapshot_id) > ($1, $2) and txid < (select txid from horizon)
+			union all
+			select 'dataset' as type, txid, snapshot_id, id, data, date_from from datasets
+			where date_until is null and (txid, snapshot_id) > ($1, $2) and txid < (select txid from horizon)
+		) as changes
+		order by txid, snapshot_id
+		limit $3;
+	`
+
+	rows, err := s.conn.Query(ctx, q, cursor.TxID, cursor.SnapshotID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ChangesAfter: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*models.Change
+	for rows.Next() {
+		var recordType string
+		var txID int64
+		var dateFrom time.Time
+		snap := &snapstore.Snapshot{}
+		if err := rows.Scan(&recordType, &txID, &snap.SnapshotID, &snap.ID, &snap.Data, &dateFrom); err != nil {
+			return nil, fmt.Errorf("repo.ChangesAfter: %w", err)
+		}
+		snap.DateFrom = &dateFrom
+
+		change := &models.Change{TxID: txID}
+		switch recordType {
+		case "publication":
+			p, err := s.snapshotToPublication(snap)
+			if err != nil {
+				return nil, fmt.Errorf("repo.ChangesAfter: %w", err)
+			}
+			change.Publication = p
+		case "dataset":
+			d, err := s.snapshotToDataset(snap)
+			if err != nil {
+				return nil, fmt.Errorf("repo.ChangesAfter: %w", err)
+			}
+			change.Dataset = d
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ChangesAfter: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GetCheckpoint returns the high-water mark that was stored under name by a
+// previous run of a sync job. The zero time is returned if there is none.
+func (s *Repo) GetCheckpoint(ctx context.Context, name string) (time.Time, error) {
+	q := `
+		select value from checkpoints where name = $1;
+	`
+	var t time.Time
+	err := s.conn.QueryRow(ctx, q, name).Scan(&t)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("repo.GetCheckpoint %s: %w", name, err)
+	}
+	return t, nil
+}
+
+func (s *Repo) SetCheckpoint(ctx context.Context, name string, t time.Time) error {
+	q := `
+		insert into checkpoints (name, value) values ($1, $2)
+		on conflict (name) do update set value = excluded.value, date_updated = now();
+	`
+	if _, err := s.conn.Exec(ctx, q, name, t); err != nil {
+		return fmt.Errorf("repo.SetCheckpoint %s: %w", name, err)
+	}
+	return nil
+}
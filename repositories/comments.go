@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type commentRow struct {
+	ID          string
+	RecordType  string
+	RecordID    string
+	ParentID    *string
+	UserID      string
+	Body        string
+	Visibility  string
+	DateCreated time.Time
+	DateUpdated time.Time
+}
+
+func (row commentRow) toModel() *models.Comment {
+	c := &models.Comment{
+		ID:          row.ID,
+		RecordType:  row.RecordType,
+		RecordID:    row.RecordID,
+		UserID:      row.UserID,
+		Body:        row.Body,
+		Visibility:  row.Visibility,
+		DateCreated: row.DateCreated,
+		DateUpdated: row.DateUpdated,
+	}
+	if row.ParentID != nil {
+		c.ParentID = *row.ParentID
+	}
+	return c
+}
+
+func (s *Repo) queryComments(ctx context.Context, q string, args ...any) ([]*models.Comment, error) {
+	rows, err := s.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	commentRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[commentRow])
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]*models.Comment, 0, len(commentRows))
+	for _, row := range commentRows {
+		c := row.toModel()
+		if err := s.loadComment(c); err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+func (s *Repo) loadComment(c *models.Comment) error {
+	for _, fn := range s.config.CommentLoaders {
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddComment adds a comment to a record. A reply must belong to the same
+// record as its parent and replies to curator comments are curator comments.
+func (s *Repo) AddComment(ctx context.Context, c *models.Comment) error {
+	if c.ParentID != "" {
+		parent, err := s.GetComment(ctx, c.ParentID)
+		if err != nil {
+			return fmt.Errorf("repo.AddComment: %w", err)
+		}
+		if parent.RecordType != c.RecordType || parent.RecordID != c.RecordID {
+			return fmt.Errorf("repo.AddComment: parent %s: %w", c.ParentID, models.ErrNotFound)
+		}
+		if parent.Visibility == models.CommentCurators {
+			c.Visibility = models.CommentCurators
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	c.ID = ulid.Make().String()
+
+	var parentID *string
+	if c.ParentID != "" {
+		parentID = &c.ParentID
+	}
+
+	q := `
+		insert into comments (id, record_type, record_id, parent_id, user_id, body, visibility)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		returning date_created, date_updated;
+	`
+	err := s.conn.QueryRow(ctx, q, c.ID, c.RecordType, c.RecordID, parentID, c.UserID, c.Body, c.Visibility).
+		Scan(&c.DateCreated, &c.DateUpdated)
+	if err != nil {
+		return fmt.Errorf("repo.AddComment: %w", err)
+	}
+
+	if err := s.loadComment(c); err != nil {
+		return fmt.Errorf("repo.AddComment: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Repo) GetComment(ctx context.Context, id string) (*models.Comment, error) {
+	q := `
+		select * from comments where id = $1;
+	`
+	comments, err := s.queryComments(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetComment %s: %w", id, err)
+	}
+	if len(comments) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return comments[0], nil
+}
+
+// GetComments returns the comments on a record, oldest first. Curator
+// comments are left out unless withCuratorComments is set.
+func (s *Repo) GetComments(ctx context.Context, recordType, recordID string, withCuratorComments bool) ([]*models.Comment, error) {
+	q := `
+		select * from comments
+		where record_type = $1 and record_id = $2 and ($3 or visibility = 'shared')
+		order by date_created asc;
+	`
+	comments, err := s.queryComments(ctx, q, recordType, recordID, withCuratorComments)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetComments %s %s: %w", recordType, recordID, err)
+	}
+	return comments, nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+)
+
+// CompactPublicationSnapshots removes redundant publication snapshots according
+// to the policy. Status and lock changes, snapshots involved in a restore and
+// the base snapshots of drafts are always kept.
+func (s *Repo) CompactPublicationSnapshots(ctx context.Context, p snapstore.CompactionPolicy) (*snapstore.CompactionReport, error) {
+	report, err := s.compactSnapshots(ctx, s.publicationStore, "publication", p)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CompactPublicationSnapshots: %w", err)
+	}
+	return report, nil
+}
+
+// CompactDatasetSnapshots removes redundant dataset snapshots according to the
+// policy. Status and lock changes, snapshots involved in a restore and the base
+// snapshots of drafts are always kept.
+func (s *Repo) CompactDatasetSnapshots(ctx context.Context, p snapstore.CompactionPolicy) (*snapstore.CompactionReport, error) {
+	report, err := s.compactSnapshots(ctx, s.datasetStore, "dataset", p)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CompactDatasetSnapshots: %w", err)
+	}
+	return report, nil
+}
+
+func (s *Repo) compactSnapshots(ctx context.Context, store *snapstore.Store, recordType string, p snapstore.CompactionPolicy) (*snapstore.CompactionReport, error) {
+	q := `
+		select snapshot_id, restored_snapshot_id from snapshot_restores where record_type = $1;
+	`
+	rows, err := s.conn.Query(ctx, q, recordType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keep := map[string]bool{}
+	for rows.Next() {
+		var snapshotID, restoredSnapshotID string
+		if err := rows.Scan(&snapshotID, &restoredSnapshotID); err != nil {
+			return nil, err
+		}
+		keep[snapshotID] = true
+		keep[restoredSnapshotID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// the base of a draft is needed to rebase it
+	rows, err = s.conn.Query(ctx, "select base_snapshot_id from snapshot_drafts where store = $1", store.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var snapshotID string
+		if err := rows.Scan(&snapshotID); err != nil {
+			return nil, err
+		}
+		keep[snapshotID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	p.UserField = "user_id"
+	p.KeepFields = []string{"status", "locked"}
+	p.Keep = func(snap *snapstore.Snapshot) bool {
+		return keep[snap.SnapshotID]
+	}
+
+	opts := s.opts
+	opts.Context = ctx
+
+	return store.Compact(p, opts)
+}
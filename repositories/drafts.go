@@ -0,0 +1,285 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/biblio-backoffice/diffing"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+)
+
+// GetPublicationDraft returns the working copy of a publication: the current
+// version with the changes of its pending draft. models.ErrNotFound is
+// returned if there is no draft or the draft changes nothing.
+func (s *Repo) GetPublicationDraft(id string) (*models.Publication, error) {
+	current, err := s.GetPublication(id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPublicationDraft %s: %w", id, err)
+	}
+	snap, err := s.draftToSnapshot(s.publicationStore, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPublicationDraft %s: %w", id, err)
+	}
+	p, err := s.snapshotToPublication(snap)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPublicationDraft %s: %w", id, err)
+	}
+	changes, err := diffing.Publications(current, p)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPublicationDraft %s: %w", id, err)
+	}
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("repo.GetPublicationDraft %s: %w", id, models.ErrNotFound)
+	}
+	return p, nil
+}
+
+// GetPublicationWorkingCopy returns the draft of a publication if there is
+// one, otherwise the current version
+func (s *Repo) GetPublicationWorkingCopy(id string) (*models.Publication, error) {
+	p, err := s.GetPublicationDraft(id)
+	if errors.Is(err, models.ErrNotFound) {
+		return s.GetPublication(id)
+	}
+	return p, err
+}
+
+// EditPublication saves changes made in the backoffice. Changes to a public
+// publication go into a draft until a curator publishes them with
+// PublishPublicationDraft, other publications are updated directly.
+func (s *Repo) EditPublication(snapshotID string, p *models.Publication, u *models.Person) error {
+	current, err := s.GetPublication(p.ID)
+	if err != nil {
+		return fmt.Errorf("repo.EditPublication %s@%s: %w", p.ID, snapshotID, err)
+	}
+	if current.Status != "public" {
+		return s.UpdatePublication(snapshotID, p, u)
+	}
+
+	now := time.Now()
+	p.DateUpdated = &now
+
+	if u != nil {
+		p.UserID = u.ID
+		p.User = u
+		p.LastUserID = u.ID
+		p.LastUser = u
+	} else {
+		p.UserID = ""
+		p.User = nil
+	}
+
+	if err := s.publicationStore.SaveDraft(snapshotID, p.ID, p, s.opts); err != nil {
+		return fmt.Errorf("repo.EditPublication %s@%s: %w", p.ID, snapshotID, err)
+	}
+
+	// changes by researchers go into the review queue
+	if u != nil && !s.CanCurate(u) {
+		err := s.enqueueReview(s.publicationStore, "publication", p.ID, models.ReviewReasonEdited, reviewFacultyIDs(p.RelatedOrganizations), u)
+		if err != nil {
+			return fmt.Errorf("repo.EditPublication %s@%s: %w", p.ID, snapshotID, err)
+		}
+	}
+
+	return nil
+}
+
+// PublishPublicationDraft makes the working copy of a publication the current
+// version and removes the draft
+func (s *Repo) PublishPublicationDraft(ctx context.Context, id string, u *models.Person) (*models.Publication, error) {
+	var p *models.Publication
+
+	err := s.tx(ctx, func(s *Repo) error {
+		var err error
+		p, err = s.publishPublicationDraft(id, u)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.PublishPublicationDraft %s: %w", id, err)
+	}
+
+	return p, nil
+}
+
+// publishPublicationDraft publishes the draft in the transaction of the repo
+func (s *Repo) publishPublicationDraft(id string, u *models.Person) (*models.Publication, error) {
+	draft, err := s.GetPublicationDraft(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := draft.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.UpdatePublication(draft.SnapshotID, draft, u); err != nil {
+		return nil, err
+	}
+	if err := s.publicationStore.DeleteDraft(id, s.opts); err != nil {
+		return nil, err
+	}
+	return draft, nil
+}
+
+func (s *Repo) DiscardPublicationDraft(id string) error {
+	if err := s.publicationStore.DeleteDraft(id, s.opts); err != nil {
+		return fmt.Errorf("repo.DiscardPublicationDraft %s: %w", id, err)
+	}
+	return nil
+}
+
+// GetDatasetDraft returns the working copy of a dataset: the current version
+// with the changes of its pending draft. models.ErrNotFound is returned if
+// there is no draft or the draft changes nothing.
+func (s *Repo) GetDatasetDraft(id string) (*models.Dataset, error) {
+	current, err := s.GetDataset(id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDatasetDraft %s: %w", id, err)
+	}
+	snap, err := s.draftToSnapshot(s.datasetStore, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDatasetDraft %s: %w", id, err)
+	}
+	d, err := s.snapshotToDataset(snap)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDatasetDraft %s: %w", id, err)
+	}
+	changes, err := diffing.Datasets(current, d)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDatasetDraft %s: %w", id, err)
+	}
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("repo.GetDatasetDraft %s: %w", id, models.ErrNotFound)
+	}
+	return d, nil
+}
+
+// GetDatasetWorkingCopy returns the draft of a dataset if there is one,
+// otherwise the current version
+func (s *Repo) GetDatasetWorkingCopy(id string) (*models.Dataset, error) {
+	d, err := s.GetDatasetDraft(id)
+	if errors.Is(err, models.ErrNotFound) {
+		return s.GetDataset(id)
+	}
+	return d, err
+}
+
+// EditDataset saves changes made in the backoffice. Changes to a public
+// dataset go into a draft until a curator publishes them with
+// PublishDatasetDraft, other datasets are updated directly.
+func (s *Repo) EditDataset(snapshotID string, d *models.Dataset, u *models.Person) error {
+	current, err := s.GetDataset(d.ID)
+	if err != nil {
+		return fmt.Errorf("repo.EditDataset %s@%s: %w", d.ID, snapshotID, err)
+	}
+	if current.Status != "public" {
+		return s.UpdateDataset(snapshotID, d, u)
+	}
+
+	now := time.Now()
+	d.DateUpdated = &now
+
+	if u != nil {
+		d.UserID = u.ID
+		d.User = u
+		d.LastUserID = u.ID
+		d.LastUser = u
+	} else {
+		d.UserID = ""
+		d.User = nil
+	}
+
+	if err := s.datasetStore.SaveDraft(snapshotID, d.ID, d, s.opts); err != nil {
+		return fmt.Errorf("repo.EditDataset %s@%s: %w", d.ID, snapshotID, err)
+	}
+
+	// changes by researchers go into the review queue
+	if u != nil && !s.CanCurate(u) {
+		err := s.enqueueReview(s.datasetStore, "dataset", d.ID, models.ReviewReasonEdited, reviewFacultyIDs(d.RelatedOrganizations), u)
+		if err != nil {
+			return fmt.Errorf("repo.EditDataset %s@%s: %w", d.ID, snapshotID, err)
+		}
+	}
+
+	return nil
+}
+
+// PublishDatasetDraft makes the working copy of a dataset the current version
+// and removes the draft
+func (s *Repo) PublishDatasetDraft(ctx context.Context, id string, u *models.Person) (*models.Dataset, error) {
+	var d *models.Dataset
+
+	err := s.tx(ctx, func(s *Repo) error {
+		var err error
+		d, err = s.publishDatasetDraft(id, u)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.PublishDatasetDraft %s: %w", id, err)
+	}
+
+	return d, nil
+}
+
+// publishDatasetDraft publishes the draft in the transaction of the repo
+func (s *Repo) publishDatasetDraft(id string, u *models.Person) (*models.Dataset, error) {
+	draft, err := s.GetDatasetDraft(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := draft.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.UpdateDataset(draft.SnapshotID, draft, u); err != nil {
+		return nil, err
+	}
+	if err := s.datasetStore.DeleteDraft(id, s.opts); err != nil {
+		return nil, err
+	}
+	return draft, nil
+}
+
+func (s *Repo) DiscardDatasetDraft(id string) error {
+	if err := s.datasetStore.DeleteDraft(id, s.opts); err != nil {
+		return fmt.Errorf("repo.DiscardDatasetDraft %s: %w", id, err)
+	}
+	return nil
+}
+
+// draftToSnapshot rebases the draft of a record on its current snapshot
+func (s *Repo) draftToSnapshot(store *snapstore.Store, id string) (*snapstore.Snapshot, error) {
+	current, err := store.GetCurrentSnapshot(id, s.opts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	draft, err := store.GetDraft(id, s.opts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// a draft without base snapshot replaces the current version
+	baseData := current.Data
+	if base, err := store.GetSnapshot(id, draft.BaseSnapshotID, s.opts); err == nil {
+		baseData = base.Data
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	data, err := snapstore.Rebase(baseData, draft.Data, current.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := *current
+	snap.Data = data
+
+	return &snap, nil
+}
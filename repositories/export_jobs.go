@@ -0,0 +1,214 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type exportJobRow struct {
+	ID          string
+	UserID      string
+	Entity      string
+	Format      string
+	SearchArgs  json.RawMessage
+	Status      string
+	Processed   int
+	Total       int
+	FileID      *string
+	FileName    *string
+	ContentType *string
+	Error       *string
+	DateCreated time.Time
+	DateUpdated time.Time
+	DateExpires *time.Time
+}
+
+func (row exportJobRow) toModel() (*models.ExportJob, error) {
+	searchArgs := models.NewSearchArgs()
+	if err := json.Unmarshal(row.SearchArgs, searchArgs); err != nil {
+		return nil, err
+	}
+	return &models.ExportJob{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Entity:      row.Entity,
+		Format:      row.Format,
+		SearchArgs:  searchArgs,
+		Status:      row.Status,
+		Processed:   row.Processed,
+		Total:       row.Total,
+		FileID:      lo.FromPtr(row.FileID),
+		FileName:    lo.FromPtr(row.FileName),
+		ContentType: lo.FromPtr(row.ContentType),
+		Error:       lo.FromPtr(row.Error),
+		DateCreated: row.DateCreated,
+		DateUpdated: row.DateUpdated,
+		DateExpires: row.DateExpires,
+	}, nil
+}
+
+func (r *Repo) queryExportJobs(ctx context.Context, q string, args ...any) ([]*models.ExportJob, error) {
+	rows, err := r.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	jobRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[exportJobRow])
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*models.ExportJob, 0, len(jobRows))
+	for _, row := range jobRows {
+		job, err := row.toModel()
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (r *Repo) AddExportJob(ctx context.Context, job *models.ExportJob) error {
+	job.ID = ulid.Make().String()
+	job.Status = models.ExportJobPending
+
+	searchArgs, err := json.Marshal(job.SearchArgs)
+	if err != nil {
+		return fmt.Errorf("repo.AddExportJob: %w", err)
+	}
+
+	q := `
+		insert into export_jobs (id, user_id, entity, format, search_args, status)
+		values ($1, $2, $3, $4, $5, $6)
+		returning date_created, date_updated;
+	`
+	err = r.conn.QueryRow(ctx, q, job.ID, job.UserID, job.Entity, job.Format, searchArgs, job.Status).
+		Scan(&job.DateCreated, &job.DateUpdated)
+	if err != nil {
+		return fmt.Errorf("repo.AddExportJob: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) GetExportJob(ctx context.Context, id string) (*models.ExportJob, error) {
+	q := `
+		select * from export_jobs where id = $1;
+	`
+	jobs, err := r.queryExportJobs(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetExportJob %s: %w", id, err)
+	}
+	if len(jobs) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return jobs[0], nil
+}
+
+// GetExportJobsByUser returns the most recent export jobs of a user, newest
+// first
+func (r *Repo) GetExportJobsByUser(ctx context.Context, userID string, limit int) ([]*models.ExportJob, error) {
+	q := `
+		select * from export_jobs
+		where user_id = $1
+		order by date_created desc
+		limit $2;
+	`
+	jobs, err := r.queryExportJobs(ctx, q, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetExportJobsByUser %s: %w", userID, err)
+	}
+	return jobs, nil
+}
+
+// ClaimExportJob marks the oldest pending export job as running and returns
+// it. Running jobs that haven't reported progress since staleBefore are
+// assumed to be abandoned by a crashed worker and are claimed again. Nil is
+// returned if there is nothing to do.
+func (r *Repo) ClaimExportJob(ctx context.Context, staleBefore time.Time) (*models.ExportJob, error) {
+	q := `
+		update export_jobs set status = 'running', processed = 0, date_updated = now()
+		where id = (
+			select id from export_jobs
+			where status = 'pending' or (status = 'running' and date_updated < $1)
+			order by date_created
+			limit 1
+			for update skip locked
+		)
+		returning *;
+	`
+	jobs, err := r.queryExportJobs(ctx, q, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ClaimExportJob: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+func (r *Repo) UpdateExportJobProgress(ctx context.Context, id string, processed, total int) error {
+	q := `
+		update export_jobs set processed = $2, total = $3, date_updated = now()
+		where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, processed, total); err != nil {
+		return fmt.Errorf("repo.UpdateExportJobProgress %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Repo) CompleteExportJob(ctx context.Context, job *models.ExportJob) error {
+	q := `
+		update export_jobs
+		set status = 'done', processed = $2, file_id = nullif($3, ''), file_name = nullif($4, ''), content_type = nullif($5, ''), date_expires = $6, date_updated = now()
+		where id = $1;
+	`
+	job.Status = models.ExportJobDone
+	_, err := r.conn.Exec(ctx, q, job.ID, job.Processed, job.FileID, job.FileName, job.ContentType, job.DateExpires)
+	if err != nil {
+		return fmt.Errorf("repo.CompleteExportJob %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+func (r *Repo) FailExportJob(ctx context.Context, id string, jobErr error, expires time.Time) error {
+	q := `
+		update export_jobs set status = 'failed', error = $2, date_expires = $3, date_updated = now()
+		where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, jobErr.Error(), expires); err != nil {
+		return fmt.Errorf("repo.FailExportJob %s: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteExpiredExportJobs removes expired export jobs and returns the files
+// that are no longer referenced by other jobs. The file store deduplicates
+// by checksum, so two identical exports share a file.
+func (r *Repo) DeleteExpiredExportJobs(ctx context.Context) ([]string, error) {
+	q := `
+		with deleted as (
+			delete from export_jobs where date_expires < now() returning file_id
+		)
+		select distinct d.file_id from deleted d
+		where d.file_id is not null and not exists (
+			select 1 from export_jobs j where j.file_id = d.file_id and j.date_expires >= now()
+		);
+	`
+	rows, err := r.conn.Query(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("repo.DeleteExpiredExportJobs: %w", err)
+	}
+	fileIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("repo.DeleteExpiredExportJobs: %w", err)
+	}
+	return fileIDs, nil
+}
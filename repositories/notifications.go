@@ -0,0 +1,342 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type notificationRow struct {
+	ID          string
+	PersonID    string
+	Kind        string
+	RecordType  *string
+	RecordID    *string
+	SnapshotID  *string
+	Title       string
+	ActorID     *string
+	DateCreated time.Time
+	DateRead    *time.Time
+}
+
+func (row notificationRow) toModel() *models.Notification {
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return &models.Notification{
+		ID:          row.ID,
+		PersonID:    row.PersonID,
+		Kind:        row.Kind,
+		RecordType:  deref(row.RecordType),
+		RecordID:    deref(row.RecordID),
+		Title:       row.Title,
+		ActorID:     deref(row.ActorID),
+		DateCreated: row.DateCreated,
+		DateRead:    row.DateRead,
+	}
+}
+
+func (s *Repo) loadNotification(n *models.Notification) error {
+	for _, fn := range s.config.NotificationLoaders {
+		if err := fn(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// owner is a person that gets notified about a record, ids are all known ids
+// of the person and are used to find their proxies
+type owner struct {
+	id  string
+	ids []string
+}
+
+func contributorOwners(owners []owner, contributors []*models.Contributor) []owner {
+	for _, c := range contributors {
+		if c.PersonID == "" {
+			continue
+		}
+		o := owner{id: c.PersonID, ids: []string{c.PersonID}}
+		if c.Person != nil && len(c.Person.IDs) > 0 {
+			o.ids = c.Person.IDs
+		}
+		owners = append(owners, o)
+	}
+	return owners
+}
+
+func publicationOwners(p *models.Publication) []owner {
+	var owners []owner
+	if p.CreatorID != "" {
+		o := owner{id: p.CreatorID, ids: []string{p.CreatorID}}
+		if p.Creator != nil && len(p.Creator.IDs) > 0 {
+			o.ids = p.Creator.IDs
+		}
+		owners = append(owners, o)
+	}
+	owners = contributorOwners(owners, p.Author)
+	owners = contributorOwners(owners, p.Editor)
+	owners = contributorOwners(owners, p.Supervisor)
+	return owners
+}
+
+func datasetOwners(d *models.Dataset) []owner {
+	var owners []owner
+	if d.CreatorID != "" {
+		o := owner{id: d.CreatorID, ids: []string{d.CreatorID}}
+		if d.Creator != nil && len(d.Creator.IDs) > 0 {
+			o.ids = d.Creator.IDs
+		}
+		owners = append(owners, o)
+	}
+	owners = contributorOwners(owners, d.Author)
+	owners = contributorOwners(owners, d.Contributor)
+	return owners
+}
+
+// notificationRecipients returns the owners and their proxies, leaving out the
+// person that made the change
+func (s *Repo) notificationRecipients(ctx context.Context, owners []owner, actor *models.Person) ([]string, error) {
+	var recipients, ownerIDs []string
+	for _, o := range owners {
+		ownerIDs = append(ownerIDs, o.ids...)
+		if actor != nil && intersects(o.ids, actor.IDs) {
+			continue
+		}
+		if !slices.Contains(recipients, o.id) {
+			recipients = append(recipients, o.id)
+		}
+	}
+	if len(ownerIDs) == 0 {
+		return recipients, nil
+	}
+
+	proxyIDs, err := s.ProxyIDs(ctx, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range proxyIDs {
+		if actor != nil && slices.Contains(actor.IDs, id) {
+			continue
+		}
+		if !slices.Contains(recipients, id) {
+			recipients = append(recipients, id)
+		}
+	}
+	return recipients, nil
+}
+
+// addNotifications notifies each person once about the same snapshot
+func (s *Repo) addNotifications(ctx context.Context, personIDs []string, n *models.Notification, snapshotID string) error {
+	q := `
+		insert into notifications (id, person_id, kind, record_type, record_id, snapshot_id, title, actor_id)
+		values ($1, $2, $3, nullif($4, ''), nullif($5, ''), nullif($6, ''), $7, nullif($8, ''))
+		on conflict (person_id, kind, snapshot_id) where snapshot_id is not null do nothing;
+	`
+	for _, personID := range personIDs {
+		_, err := s.conn.Exec(ctx, q, ulid.Make().String(), personID, n.Kind, n.RecordType, n.RecordID, snapshotID, n.Title, n.ActorID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotifyPublicationChange notifies the owners of a publication and their
+// proxies when it is locked, withdrawn or published by a curator. Changes
+// that aren't committed yet are ignored.
+func (s *Repo) NotifyPublicationChange(ctx context.Context, p *models.Publication) error {
+	if p.DateUntil != nil || p.SnapshotID == "" {
+		return nil
+	}
+	current, err := s.GetPublicationSnapshot(p.ID, p.SnapshotID)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.NotifyPublicationChange %s: %w", p.ID, err)
+	}
+	old, err := s.GetPublicationSnapshotBefore(p.ID, *current.DateFrom)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.NotifyPublicationChange %s: %w", p.ID, err)
+	}
+
+	byCurator := p.User != nil && s.CanCurate(p.User)
+	kinds := models.StatusNotificationKinds(old.Status, old.Locked, p.Status, p.Locked, byCurator)
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	recipients, err := s.notificationRecipients(ctx, publicationOwners(p), p.User)
+	if err != nil {
+		return fmt.Errorf("repo.NotifyPublicationChange %s: %w", p.ID, err)
+	}
+	for _, kind := range kinds {
+		n := &models.Notification{Kind: kind, RecordType: "publication", RecordID: p.ID, Title: p.Title, ActorID: p.UserID}
+		if err := s.addNotifications(ctx, recipients, n, p.SnapshotID); err != nil {
+			return fmt.Errorf("repo.NotifyPublicationChange %s: %w", p.ID, err)
+		}
+	}
+	return nil
+}
+
+// NotifyDatasetChange notifies the owners of a dataset and their proxies when
+// it is locked, withdrawn or published by a curator. Changes that aren't
+// committed yet are ignored.
+func (s *Repo) NotifyDatasetChange(ctx context.Context, d *models.Dataset) error {
+	if d.DateUntil != nil || d.SnapshotID == "" {
+		return nil
+	}
+	current, err := s.GetDatasetSnapshot(d.ID, d.SnapshotID)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.NotifyDatasetChange %s: %w", d.ID, err)
+	}
+	old, err := s.GetDatasetSnapshotBefore(d.ID, *current.DateFrom)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.NotifyDatasetChange %s: %w", d.ID, err)
+	}
+
+	byCurator := d.User != nil && s.CanCurate(d.User)
+	kinds := models.StatusNotificationKinds(old.Status, old.Locked, d.Status, d.Locked, byCurator)
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	recipients, err := s.notificationRecipients(ctx, datasetOwners(d), d.User)
+	if err != nil {
+		return fmt.Errorf("repo.NotifyDatasetChange %s: %w", d.ID, err)
+	}
+	for _, kind := range kinds {
+		n := &models.Notification{Kind: kind, RecordType: "dataset", RecordID: d.ID, Title: d.Title, ActorID: d.UserID}
+		if err := s.addNotifications(ctx, recipients, n, d.SnapshotID); err != nil {
+			return fmt.Errorf("repo.NotifyDatasetChange %s: %w", d.ID, err)
+		}
+	}
+	return nil
+}
+
+// notifyCandidateRecord tells the researchers of a new candidate record and
+// their proxies that it is waiting for them
+func (s *Repo) notifyCandidateRecord(ctx context.Context, rec *models.CandidateRecord) error {
+	p := &models.Publication{}
+	if err := json.Unmarshal(rec.Metadata, p); err != nil {
+		return err
+	}
+	var owners []owner
+	owners = contributorOwners(owners, p.Author)
+	owners = contributorOwners(owners, p.Supervisor)
+
+	recipients, err := s.notificationRecipients(ctx, owners, nil)
+	if err != nil {
+		return err
+	}
+	n := &models.Notification{Kind: models.NotificationCandidateRecord, RecordType: "candidate_record", RecordID: rec.ID, Title: p.Title}
+	return s.addNotifications(ctx, recipients, n, "")
+}
+
+func (s *Repo) GetNotification(ctx context.Context, id string) (*models.Notification, error) {
+	q := `
+		select * from notifications where id = $1;
+	`
+	rows, err := s.conn.Query(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetNotification %s: %w", id, err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[notificationRow])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetNotification %s: %w", id, err)
+	}
+	n := row.toModel()
+	if err := s.loadNotification(n); err != nil {
+		return nil, fmt.Errorf("repo.GetNotification %s: %w", id, err)
+	}
+	return n, nil
+}
+
+// GetNotifications returns a page of the notifications of a person with the
+// given ids, newest first
+func (s *Repo) GetNotifications(ctx context.Context, personIDs []string, searchArgs *models.SearchArgs) (int, []*models.Notification, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("*", "COUNT(*) OVER() AS total").
+		From("notifications").
+		Where("person_id = any(?)", personIDs).
+		OrderBy("date_created DESC").
+		Limit(uint64(searchArgs.Limit())).
+		Offset(uint64(searchArgs.Offset()))
+
+	type row struct {
+		notificationRow
+		Total int
+	}
+	rows, err := queryRows[row](s, ctx, query)
+	if err != nil {
+		return 0, nil, fmt.Errorf("repo.GetNotifications: %w", err)
+	}
+	if len(rows) == 0 {
+		return 0, []*models.Notification{}, nil
+	}
+	notifications := make([]*models.Notification, 0, len(rows))
+	for _, r := range rows {
+		n := r.toModel()
+		if err := s.loadNotification(n); err != nil {
+			return 0, nil, fmt.Errorf("repo.GetNotifications: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+	return rows[0].Total, notifications, nil
+}
+
+func (s *Repo) CountUnreadNotifications(ctx context.Context, personIDs []string) (int, error) {
+	q := `
+		select count(*) from notifications where person_id = any($1) and date_read is null;
+	`
+	var n int
+	if err := s.conn.QueryRow(ctx, q, personIDs).Scan(&n); err != nil {
+		return 0, fmt.Errorf("repo.CountUnreadNotifications: %w", err)
+	}
+	return n, nil
+}
+
+func (s *Repo) MarkNotificationRead(ctx context.Context, id string) error {
+	q := `
+		update notifications set date_read = now() where id = $1 and date_read is null;
+	`
+	if _, err := s.conn.Exec(ctx, q, id); err != nil {
+		return fmt.Errorf("repo.MarkNotificationRead %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *Repo) MarkAllNotificationsRead(ctx context.Context, personIDs []string) error {
+	q := `
+		update notifications set date_read = now() where person_id = any($1) and date_read is null;
+	`
+	if _, err := s.conn.Exec(ctx, q, personIDs); err != nil {
+		return fmt.Errorf("repo.MarkAllNotificationsRead: %w", err)
+	}
+	return nil
+}
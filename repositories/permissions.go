@@ -105,6 +105,33 @@ func (s *Repo) CanEditPublication(u *models.Person, p *models.Publication) bool
 	return s.isProxyForPublication(u, p)
 }
 
+// isAssignedCandidateRecord reports if a curator handed the candidate record
+// to the user or to someone the user is a proxy for
+func (s *Repo) isAssignedCandidateRecord(u *models.Person, rec *models.CandidateRecord) bool {
+	if rec.AssignedPersonID == "" {
+		return false
+	}
+	ids := []string{rec.AssignedPersonID}
+	if rec.AssignedPerson != nil && len(rec.AssignedPerson.IDs) > 0 {
+		ids = rec.AssignedPerson.IDs
+	}
+	return intersects(ids, u.IDs) || s.IsProxyFor(u.IDs, ids)
+}
+
+func (s *Repo) CanViewCandidateRecord(u *models.Person, rec *models.CandidateRecord) bool {
+	if s.CanViewPublication(u, rec.Publication) {
+		return true
+	}
+	return u.Active && s.isAssignedCandidateRecord(u, rec)
+}
+
+func (s *Repo) CanEditCandidateRecord(u *models.Person, rec *models.CandidateRecord) bool {
+	if s.CanEditPublication(u, rec.Publication) {
+		return true
+	}
+	return u.Active && s.isAssignedCandidateRecord(u, rec)
+}
+
 func (s *Repo) CanDeletePublication(u *models.Person, p *models.Publication) bool {
 	if !u.Active {
 		return false
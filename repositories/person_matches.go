@@ -0,0 +1,302 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type personMatchRow struct {
+	ID                string
+	CandidateRecordID string
+	Role              string
+	Position          int
+	PersonID          string
+	Score             float64
+	Reasons           []string
+	Status            string
+	StatusPersonID    *string
+	DateCreated       time.Time
+	DateUpdated       time.Time
+}
+
+func (row personMatchRow) toModel() *models.PersonMatch {
+	return &models.PersonMatch{
+		ID:                row.ID,
+		CandidateRecordID: row.CandidateRecordID,
+		Role:              row.Role,
+		Position:          row.Position,
+		PersonID:          row.PersonID,
+		Score:             row.Score,
+		Reasons:           row.Reasons,
+		Status:            row.Status,
+		StatusPersonID:    lo.FromPtr(row.StatusPersonID),
+		DateCreated:       row.DateCreated,
+		DateUpdated:       row.DateUpdated,
+	}
+}
+
+func (r *Repo) queryPersonMatches(ctx context.Context, q string, args ...any) ([]*models.PersonMatch, error) {
+	rows, err := r.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	matchRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[personMatchRow])
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]*models.PersonMatch, 0, len(matchRows))
+	for _, row := range matchRows {
+		m := row.toModel()
+		for _, fn := range r.config.PersonMatchLoaders {
+			if err := fn(m); err != nil {
+				return nil, err
+			}
+		}
+		matches = append(matches, m)
+	}
+	return matches, nil
+}
+
+// SetCandidateRecordPersonMatches replaces the proposed matches of a
+// candidate record. Matches that were confirmed or rejected before are kept
+// and not proposed again.
+func (r *Repo) SetCandidateRecordPersonMatches(ctx context.Context, candidateRecordID string, matches []*models.PersonMatch) error {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("repo.SetCandidateRecordPersonMatches %s: %w", candidateRecordID, err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		delete from candidate_record_person_matches
+		where candidate_record_id = $1 and status = 'proposed';
+	`, candidateRecordID)
+	if err != nil {
+		return fmt.Errorf("repo.SetCandidateRecordPersonMatches %s: %w", candidateRecordID, err)
+	}
+
+	for _, m := range matches {
+		_, err := tx.Exec(ctx, `
+			insert into candidate_record_person_matches (id, candidate_record_id, role, position, person_id, score, reasons)
+			values ($1, $2, $3, $4, $5, $6, $7)
+			on conflict (candidate_record_id, role, position, person_id) do nothing;
+		`, ulid.Make().String(), candidateRecordID, m.Role, m.Position, m.PersonID, m.Score, m.Reasons)
+		if err != nil {
+			return fmt.Errorf("repo.SetCandidateRecordPersonMatches %s: %w", candidateRecordID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("repo.SetCandidateRecordPersonMatches %s: %w", candidateRecordID, err)
+	}
+
+	return nil
+}
+
+// GetCandidateRecordPersonMatches returns the matches of a candidate record
+// by contributor, best match first
+func (r *Repo) GetCandidateRecordPersonMatches(ctx context.Context, candidateRecordID string) ([]*models.PersonMatch, error) {
+	q := `
+		select * from candidate_record_person_matches
+		where candidate_record_id = $1
+		order by array_position(array['author', 'editor', 'supervisor'], role), position, score desc, person_id;
+	`
+	matches, err := r.queryPersonMatches(ctx, q, candidateRecordID)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetCandidateRecordPersonMatches %s: %w", candidateRecordID, err)
+	}
+	return matches, nil
+}
+
+func (r *Repo) GetCandidateRecordPersonMatch(ctx context.Context, id string) (*models.PersonMatch, error) {
+	q := `
+		select * from candidate_record_person_matches where id = $1;
+	`
+	matches, err := r.queryPersonMatches(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetCandidateRecordPersonMatch %s: %w", id, err)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("repo.GetCandidateRecordPersonMatch %s: %w", id, models.ErrNotFound)
+	}
+	return matches[0], nil
+}
+
+// ConfirmCandidateRecordPersonMatch links the contributor to the matched
+// person in the candidate record metadata and rejects the other people that
+// were proposed for the contributor. The person and their proxies are
+// notified of the candidate record.
+func (r *Repo) ConfirmCandidateRecordPersonMatch(ctx context.Context, id string, user *models.Person) error {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		select * from candidate_record_person_matches
+		where id = $1 and status = 'proposed'
+		for update;
+	`, id)
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[personMatchRow])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, models.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	var title string
+	var metadata json.RawMessage
+	err = tx.QueryRow(ctx, `
+		select coalesce(metadata->>'title', ''), metadata from candidate_records
+		where id = $1 and status = 'new'
+		for update;
+	`, row.CandidateRecordID).Scan(&title, &metadata)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, models.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	p := &models.Publication{}
+	if err := json.Unmarshal(metadata, p); err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+	c := linkContributor(p, row.Role, row.Position, row.PersonID)
+	if c == nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, models.ErrNotFound)
+	}
+	if metadata, err = json.Marshal(p); err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		update candidate_records set metadata = $2 where id = $1;
+	`, row.CandidateRecordID, metadata)
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		update candidate_record_person_matches
+		set status = case when id = $1 then 'confirmed' else 'rejected' end, status_person_id = $2, date_updated = now()
+		where candidate_record_id = $3 and role = $4 and position = $5 and status = 'proposed';
+	`, id, user.ID, row.CandidateRecordID, row.Role, row.Position)
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	recipients, err := r.notificationRecipients(ctx, contributorOwners(nil, []*models.Contributor{c}), user)
+	if err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+	n := &models.Notification{Kind: models.NotificationCandidateRecord, RecordType: "candidate_record", RecordID: row.CandidateRecordID, Title: title}
+	if err := r.addNotifications(ctx, recipients, n, ""); err != nil {
+		return fmt.Errorf("repo.ConfirmCandidateRecordPersonMatch %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// linkContributor links a contributor of the candidate record metadata to a
+// person. Nil is returned if there is no such contributor.
+func linkContributor(p *models.Publication, role string, position int, personID string) *models.Contributor {
+	c, err := p.GetContributor(role, position)
+	if err != nil {
+		return nil
+	}
+	c.PersonID = personID
+	c.ExternalPerson = nil
+	return c
+}
+
+// applyConfirmedPersonMatches links the contributors in new candidate record
+// metadata to the people that a curator confirmed before
+func applyConfirmedPersonMatches(ctx context.Context, tx pgx.Tx, candidateRecordID string, metadata json.RawMessage) (json.RawMessage, error) {
+	rows, err := tx.Query(ctx, `
+		select * from candidate_record_person_matches
+		where candidate_record_id = $1 and status = 'confirmed';
+	`, candidateRecordID)
+	if err != nil {
+		return nil, err
+	}
+	matchRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[personMatchRow])
+	if err != nil {
+		return nil, err
+	}
+	if len(matchRows) == 0 {
+		return metadata, nil
+	}
+
+	p := &models.Publication{}
+	if err := json.Unmarshal(metadata, p); err != nil {
+		return nil, err
+	}
+	for _, row := range matchRows {
+		linkContributor(p, row.Role, row.Position, row.PersonID)
+	}
+	return json.Marshal(p)
+}
+
+// RejectCandidateRecordPersonMatch rejects a proposed match, it won't be
+// proposed again
+func (r *Repo) RejectCandidateRecordPersonMatch(ctx context.Context, id string, user *models.Person) error {
+	q := `
+		update candidate_record_person_matches
+		set status = 'rejected', status_person_id = $2, date_updated = now()
+		where id = $1 and status = 'proposed';
+	`
+	res, err := r.conn.Exec(ctx, q, id, user.ID)
+	if err != nil {
+		return fmt.Errorf("repo.RejectCandidateRecordPersonMatch %s: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("repo.RejectCandidateRecordPersonMatch %s: %w", id, models.ErrNotFound)
+	}
+	return nil
+}
+
+// EachNewCandidateRecord calls fn for each candidate record that is still new
+func (r *Repo) EachNewCandidateRecord(ctx context.Context, fn func(*models.CandidateRecord) error) error {
+	q := `
+		select id from candidate_records where status = 'new' order by date_created;
+	`
+	rows, err := r.conn.Query(ctx, q)
+	if err != nil {
+		return fmt.Errorf("repo.EachNewCandidateRecord: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("repo.EachNewCandidateRecord: %w", err)
+	}
+	for _, id := range ids {
+		rec, err := r.GetCandidateRecord(ctx, id)
+		if errors.Is(err, models.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("repo.EachNewCandidateRecord: %w", err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/biblio-backoffice/models"
 )
 
 func (s *Repo) IsProxyFor(proxyIDs []string, personIDs []string) bool {
@@ -122,8 +123,12 @@ func (r *Repo) AddProxyPerson(ctx context.Context, proxyID, personID string) err
 		values ($1, $2)
 		on conflict (proxy_person_id, person_id) do nothing;
 	`
-	_, err := r.conn.Exec(ctx, q, proxyID, personID)
-	return err
+	res, err := r.conn.Exec(ctx, q, proxyID, personID)
+	if err != nil || res.RowsAffected() == 0 {
+		return err
+	}
+	n := &models.Notification{Kind: models.NotificationProxyAdded, ActorID: proxyID}
+	return r.addNotifications(ctx, []string{personID}, n, "")
 }
 
 func (r *Repo) RemoveProxyPerson(ctx context.Context, proxyIDs, personIDs []string) error {
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+var (
+	ErrMergeLocked   = errors.New("can't merge locked publications")
+	ErrMergeHasDraft = errors.New("can't merge publications with unpublished changes")
+)
+
+// MergePublications merges the publication with id mergedID into the
+// publication with id survivorID. The contributors, files, identifiers,
+// links, projects, departments and dataset relations of the merged
+// publication are added to the survivor, the merged publication is deleted and
+// a redirect to the survivor is recorded. The history of both publications is
+// kept. Locked publications and publications with a pending draft can't be
+// merged, ErrMergeLocked or ErrMergeHasDraft is returned.
+func (s *Repo) MergePublications(ctx context.Context, survivorID, mergedID string, u *models.Person) (*models.Publication, error) {
+	if survivorID == mergedID {
+		return nil, fmt.Errorf("repo.MergePublications %s %s: can't merge a publication with itself", survivorID, mergedID)
+	}
+
+	var survivor *models.Publication
+
+	err := s.tx(ctx, func(s *Repo) error {
+		p, err := s.GetPublication(survivorID)
+		if err != nil {
+			return err
+		}
+		merged, err := s.GetPublication(mergedID)
+		if err != nil {
+			return err
+		}
+		if p.Status == "deleted" || merged.Status == "deleted" {
+			return errors.New("can't merge deleted publications")
+		}
+		if p.Locked || merged.Locked {
+			return ErrMergeLocked
+		}
+		// pending changes have to be published or discarded first, they
+		// would otherwise be lost or end up in the survivor without review
+		for _, id := range []string{p.ID, merged.ID} {
+			_, err := s.GetPublicationDraft(id)
+			if err == nil {
+				return ErrMergeHasDraft
+			}
+			if !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+			// drafts that change nothing can go
+			if err := s.publicationStore.DeleteDraft(id, s.opts); err != nil {
+				return err
+			}
+		}
+
+		p.Merge(merged)
+		if err := s.SavePublication(p, u); err != nil {
+			return err
+		}
+
+		for _, rel := range merged.RelatedDataset {
+			d, err := s.GetDataset(rel.ID)
+			if errors.Is(err, models.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			d.RemoveRelatedPublication(merged.ID)
+			if !d.HasRelatedPublication(p.ID) {
+				d.RelatedPublication = append(d.RelatedPublication, models.RelatedPublication{ID: p.ID})
+			}
+			if err := s.SaveDataset(d, u); err != nil {
+				return err
+			}
+		}
+
+		merged.Status = "deleted"
+		merged.RelatedDataset = nil
+		if err := s.SavePublication(merged, u); err != nil {
+			return err
+		}
+
+		// earlier redirects to the merged publication now point to the survivor
+		if err := s.publicationStore.ExecSql(
+			"UPDATE publication_redirects SET target_id = $1 WHERE target_id = $2",
+			[]any{p.ID, merged.ID},
+			s.opts,
+		); err != nil {
+			return err
+		}
+
+		var userID *string
+		if u != nil {
+			userID = &u.ID
+		}
+		if err := s.publicationStore.ExecSql(
+			"INSERT INTO publication_redirects (id, target_id, user_id) VALUES ($1, $2, $3)",
+			[]any{merged.ID, p.ID, userID},
+			s.opts,
+		); err != nil {
+			return err
+		}
+
+		survivor = p
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.MergePublications %s %s: %w", survivorID, mergedID, err)
+	}
+
+	return survivor, nil
+}
+
+// GetPublicationRedirect returns the id of the publication that the
+// publication with the given id was merged into.
+func (s *Repo) GetPublicationRedirect(ctx context.Context, id string) (string, error) {
+	q := `
+		select target_id from publication_redirects where id = $1;
+	`
+	var targetID string
+	err := s.conn.QueryRow(ctx, q, id).Scan(&targetID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("repo.GetPublicationRedirect %s: %w", id, models.ErrNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("repo.GetPublicationRedirect %s: %w", id, err)
+	}
+	return targetID, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/oklog/ulid/v2"
 	"github.com/ugent-library/biblio-backoffice/db"
+	"github.com/ugent-library/biblio-backoffice/diffing"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/biblio-backoffice/mutate"
 	"github.com/ugent-library/biblio-backoffice/snapstore"
@@ -35,17 +36,23 @@ type Repo struct {
 	// sqlc
 	queries *db.Queries
 	conn    *pgxpool.Pool
+	// listeners are called after commit for records saved in a transaction
+	afterCommit *[]func()
 }
 
 type Config struct {
-	Conn                   *pgxpool.Pool
-	PublicationListeners   []PublicationListener
-	DatasetListeners       []DatasetListener
-	PublicationMutators    map[string]PublicationMutator
-	DatasetMutators        map[string]DatasetMutator
-	PublicationLoaders     []PublicationVisitor
-	DatasetLoaders         []DatasetVisitor
-	CandidateRecordLoaders []CandidateRecordVisitor
+	Conn                          *pgxpool.Pool
+	PublicationListeners          []PublicationListener
+	DatasetListeners              []DatasetListener
+	PublicationMutators           map[string]PublicationMutator
+	DatasetMutators               map[string]DatasetMutator
+	PublicationLoaders            []PublicationVisitor
+	DatasetLoaders                []DatasetVisitor
+	CandidateRecordLoaders        []CandidateRecordVisitor
+	CommentLoaders                []CommentVisitor
+	NotificationLoaders           []NotificationVisitor
+	PersonMatchLoaders            []PersonMatchVisitor
+	CandidateRecordBulkJobLoaders []CandidateRecordBulkJobVisitor
 }
 
 type PublicationListener = func(*models.Publication)
@@ -55,6 +62,10 @@ type DatasetMutator = func(*models.Dataset, []string) error
 type PublicationVisitor = func(*models.Publication) error
 type DatasetVisitor = func(*models.Dataset) error
 type CandidateRecordVisitor = func(*models.CandidateRecord) error
+type CommentVisitor = func(*models.Comment) error
+type NotificationVisitor = func(*models.Notification) error
+type PersonMatchVisitor = func(*models.PersonMatch) error
+type CandidateRecordBulkJobVisitor = func(*models.CandidateRecordBulkJob) error
 
 func New(c Config) (*Repo, error) {
 	client := snapstore.New(c.Conn, []string{"publications", "datasets"},
@@ -74,28 +85,62 @@ func New(c Config) (*Repo, error) {
 }
 
 func (s *Repo) publicationNotify(p *models.Publication) {
-	for _, fn := range s.config.PublicationListeners {
-		fn(p)
-	}
+	s.notify(func() {
+		for _, fn := range s.config.PublicationListeners {
+			fn(p)
+		}
+	})
 }
 
 func (s *Repo) datasetNotify(d *models.Dataset) {
-	for _, fn := range s.config.DatasetListeners {
-		fn(d)
+	s.notify(func() {
+		for _, fn := range s.config.DatasetListeners {
+			fn(d)
+		}
+	})
+}
+
+// notify calls listeners right away or, inside a transaction, after commit so
+// that listeners never see changes that are rolled back
+func (s *Repo) notify(fn func()) {
+	if s.afterCommit != nil {
+		*s.afterCommit = append(*s.afterCommit, fn)
+		return
 	}
+	fn()
 }
 
 func (s *Repo) tx(ctx context.Context, fn func(*Repo) error) error {
-	return s.client.Tx(ctx, func(opts snapstore.Options) error {
+	var afterCommit []func()
+
+	err := s.client.Tx(ctx, func(opts snapstore.Options) error {
 		return fn(&Repo{
 			config:           s.config,
 			client:           s.client,
 			publicationStore: s.publicationStore,
 			datasetStore:     s.datasetStore,
 			opts:             opts,
-			queries:          db.New(s.conn),
+			queries:          db.New(opts.Transaction.DB()),
+			afterCommit:      &afterCommit,
 		})
 	})
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range afterCommit {
+		fn()
+	}
+
+	return nil
+}
+
+// db returns the transaction the repo runs in, if any
+func (s *Repo) db() snapstore.DB {
+	if s.opts.Transaction != nil {
+		return s.opts.Transaction.DB()
+	}
+	return s.conn
 }
 
 func (s *Repo) GetPublication(id string) (*models.Publication, error) {
@@ -221,9 +266,11 @@ func (s *Repo) SavePublication(p *models.Publication, u *models.Person) error {
 }
 
 func (s *Repo) UpdatePublication(snapshotID string, p *models.Publication, u *models.Person) error {
-	if oldPublication, err := s.GetPublication(p.ID); err != nil {
+	oldPublication, err := s.GetPublication(p.ID)
+	if err != nil {
 		return fmt.Errorf("repo.UpdatePublication %s@%s: %w", p.ID, snapshotID, err)
-	} else if reflect.DeepEqual(oldPublication, p) {
+	}
+	if reflect.DeepEqual(oldPublication, p) {
 		return nil
 	}
 
@@ -245,13 +292,33 @@ func (s *Repo) UpdatePublication(snapshotID string, p *models.Publication, u *mo
 		p.HasBeenPublic = true
 	}
 
-	snapshotID, err := s.publicationStore.AddAfter(snapshotID, p.ID, p, s.opts)
+	snapshotID, err = s.publicationStore.AddAfter(snapshotID, p.ID, p, s.opts)
 	if err != nil {
 		p.DateUpdated = oldDateUpdated
 		return fmt.Errorf("repo.UpdatePublication %s@%s: %w", p.ID, snapshotID, err)
 	}
 	p.SnapshotID = snapshotID
 
+	// drafts only apply to public records
+	if p.Status != "public" {
+		if err := s.publicationStore.DeleteDraft(p.ID, s.opts); err != nil {
+			return fmt.Errorf("repo.UpdatePublication %s@%s: %w", p.ID, snapshotID, err)
+		}
+	}
+
+	// records that researchers make public go into the review queue
+	if p.Status == "public" && oldPublication.Status != "public" && u != nil && !s.CanCurate(u) {
+		err := s.enqueueReview(s.publicationStore, "publication", p.ID, models.ReviewReasonPublished, reviewFacultyIDs(p.RelatedOrganizations), u)
+		if err != nil {
+			return fmt.Errorf("repo.UpdatePublication %s@%s: %w", p.ID, snapshotID, err)
+		}
+	}
+	if p.Status == "deleted" {
+		if err := s.removeOpenReview(s.publicationStore, "publication", p.ID); err != nil {
+			return fmt.Errorf("repo.UpdatePublication %s@%s: %w", p.ID, snapshotID, err)
+		}
+	}
+
 	for _, fn := range s.config.PublicationLoaders {
 		if err := fn(p); err != nil {
 			return fmt.Errorf("repo.UpdatePublication %s@%s: %w", p.ID, snapshotID, err)
@@ -323,7 +390,7 @@ func (s *Repo) MutatePublication(id string, u *models.Person, muts ...Mutation)
 
 func (s *Repo) PublicationsAfter(t time.Time, limit, offset int) (int, []*models.Publication, error) {
 	n, err := s.publicationStore.CountSql(
-		"SELECT * FROM publications WHERE date_until IS NULL AND date_from >= $1",
+		"SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND date_from >= $1",
 		[]any{t},
 		s.opts,
 	)
@@ -332,7 +399,7 @@ func (s *Repo) PublicationsAfter(t time.Time, limit, offset int) (int, []*models
 	}
 
 	c, err := s.publicationStore.Select(
-		"SELECT * FROM publications WHERE date_until IS NULL AND date_from >= $1 ORDER BY date_from ASC LIMIT $2 OFFSET $3",
+		"SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND date_from >= $1 ORDER BY date_from ASC LIMIT $2 OFFSET $3",
 		[]any{t, limit, offset},
 		s.opts,
 	)
@@ -365,7 +432,7 @@ func (s *Repo) PublicationsAfter(t time.Time, limit, offset int) (int, []*models
 
 func (s *Repo) PublicationsBetween(t1, t2 time.Time, fn func(*models.Publication) bool) error {
 	c, err := s.publicationStore.Select(
-		"SELECT * FROM publications WHERE date_until IS NULL AND date_from >= $1 AND date_from <= $2 ORDER BY date_from ASC",
+		"SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND date_from >= $1 AND date_from <= $2 ORDER BY date_from ASC",
 		[]any{t1, t2},
 		s.opts,
 	)
@@ -421,6 +488,34 @@ func (s *Repo) EachPublication(fn func(*models.Publication) bool) error {
 	return nil
 }
 
+// EachPublicationAt iterates over all publications as they were at time t
+func (s *Repo) EachPublicationAt(t time.Time, fn func(*models.Publication) bool) error {
+	c, err := s.publicationStore.GetAllAt(t, s.opts)
+	if err != nil {
+		return fmt.Errorf("repo.EachPublicationAt: %w", err)
+	}
+	defer c.Close()
+	for c.HasNext() {
+		snap, err := c.Next()
+		if err != nil {
+			return fmt.Errorf("repo.EachPublicationAt: %w", err)
+		}
+		p, err := s.snapshotToPublication(snap)
+		if err != nil {
+			return fmt.Errorf("repo.EachPublicationAt: %w", err)
+		}
+		if ok := fn(p); !ok {
+			break
+		}
+	}
+
+	if c.Err() != nil {
+		return fmt.Errorf("repo.EachPublicationAt: %w", c.Err())
+	}
+
+	return nil
+}
+
 func (s *Repo) EachPublicationSnapshot(fn func(*models.Publication) bool) error {
 	c, err := s.publicationStore.GetAllSnapshots(s.opts)
 	if err != nil {
@@ -449,7 +544,7 @@ func (s *Repo) EachPublicationSnapshot(fn func(*models.Publication) bool) error
 }
 
 func (s *Repo) EachPublicationWithStatus(status string, fn func(*models.Publication) bool) error {
-	sql := `SELECT * FROM publications WHERE date_until IS NULL AND data->>'status' = $1`
+	sql := `SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND data->>'status' = $1`
 
 	c, err := s.publicationStore.Select(sql, []any{status}, s.opts)
 	if err != nil {
@@ -478,10 +573,41 @@ func (s *Repo) EachPublicationWithStatus(status string, fn func(*models.Publicat
 	return nil
 }
 
+func (s *Repo) EachPublicationWithContributor(personID string, fn func(*models.Publication) bool) error {
+	sql := `
+		SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND
+		(data->'author' @> $1::jsonb OR data->'editor' @> $1::jsonb OR data->'supervisor' @> $1::jsonb)
+		`
+	c, err := s.publicationStore.Select(sql, []any{getPersonFilter(personID)}, s.opts)
+	if err != nil {
+		return fmt.Errorf("repo.EachPublicationWithContributor: %w", err)
+	}
+	defer c.Close()
+	for c.HasNext() {
+		snap, err := c.Next()
+		if err != nil {
+			return fmt.Errorf("repo.EachPublicationWithContributor: %w", err)
+		}
+		p, err := s.snapshotToPublication(snap)
+		if err != nil {
+			return fmt.Errorf("repo.EachPublicationWithContributor: %w", err)
+		}
+		if ok := fn(p); !ok {
+			break
+		}
+	}
+
+	if c.Err() != nil {
+		return fmt.Errorf("repo.EachPublicationWithContributor: %w", c.Err())
+	}
+
+	return nil
+}
+
 // TODO add handle with a listener, then this method isn't needed anymore
 func (s *Repo) EachPublicationWithoutHandle(fn func(*models.Publication) bool) error {
 	sql := `
-		SELECT * FROM publications WHERE date_until IS NULL AND
+		SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND
 		data->>'status' = 'public' AND
 		NOT data ? 'handle'
 		`
@@ -553,12 +679,32 @@ func (s *Repo) PublicationHistory(id string, fn func(*models.Publication) bool)
 	return nil
 }
 
+// PublicationChanges returns the changes made by each snapshot in the history of
+// a publication, newest first
+func (s *Repo) PublicationChanges(ctx context.Context, id string) ([]*diffing.Entry, error) {
+	var snapshots []*models.Publication
+	if err := s.PublicationHistory(id, func(p *models.Publication) bool {
+		snapshots = append(snapshots, p)
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("repo.PublicationChanges %s: %w", id, err)
+	}
+	entries, err := diffing.PublicationHistory(snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("repo.PublicationChanges %s: %w", id, err)
+	}
+	if err := s.addRestoresToHistory(ctx, "publication", id, entries); err != nil {
+		return nil, fmt.Errorf("repo.PublicationChanges %s: %w", id, err)
+	}
+	return entries, nil
+}
+
 func (s *Repo) UpdatePublicationEmbargoes() (int, error) {
 	var n int
 	embargoAccessLevel := "info:eu-repo/semantics/embargoedAccess"
 	now := time.Now().Format("2006-01-02")
 	sql := `
-		SELECT * FROM publications WHERE date_until IS NULL AND
+		SELECT snapshot_id, id, data, date_from, date_until FROM publications WHERE date_until IS NULL AND
 		data->'file' IS NOT NULL AND
 		EXISTS(
 			SELECT 1 FROM jsonb_array_elements(data->'file') AS f
@@ -738,9 +884,11 @@ func (s *Repo) SaveDataset(d *models.Dataset, u *models.Person) error {
 }
 
 func (s *Repo) UpdateDataset(snapshotID string, d *models.Dataset, u *models.Person) error {
-	if oldDataset, err := s.GetDataset(d.ID); err != nil {
+	oldDataset, err := s.GetDataset(d.ID)
+	if err != nil {
 		return fmt.Errorf("repo.UpdateDataset %s@%s: %w", d.ID, snapshotID, err)
-	} else if reflect.DeepEqual(oldDataset, d) {
+	}
+	if reflect.DeepEqual(oldDataset, d) {
 		return nil
 	}
 
@@ -762,13 +910,33 @@ func (s *Repo) UpdateDataset(snapshotID string, d *models.Dataset, u *models.Per
 		d.HasBeenPublic = true
 	}
 
-	snapshotID, err := s.datasetStore.AddAfter(snapshotID, d.ID, d, s.opts)
+	snapshotID, err = s.datasetStore.AddAfter(snapshotID, d.ID, d, s.opts)
 	if err != nil {
 		d.DateUpdated = oldDateUpdated
 		return fmt.Errorf("repo.UpdateDataset %s@%s: %w", d.ID, snapshotID, err)
 	}
 	d.SnapshotID = snapshotID
 
+	// drafts only apply to public records
+	if d.Status != "public" {
+		if err := s.datasetStore.DeleteDraft(d.ID, s.opts); err != nil {
+			return fmt.Errorf("repo.UpdateDataset %s@%s: %w", d.ID, snapshotID, err)
+		}
+	}
+
+	// records that researchers make public go into the review queue
+	if d.Status == "public" && oldDataset.Status != "public" && u != nil && !s.CanCurate(u) {
+		err := s.enqueueReview(s.datasetStore, "dataset", d.ID, models.ReviewReasonPublished, reviewFacultyIDs(d.RelatedOrganizations), u)
+		if err != nil {
+			return fmt.Errorf("repo.UpdateDataset %s@%s: %w", d.ID, snapshotID, err)
+		}
+	}
+	if d.Status == "deleted" {
+		if err := s.removeOpenReview(s.datasetStore, "dataset", d.ID); err != nil {
+			return fmt.Errorf("repo.UpdateDataset %s@%s: %w", d.ID, snapshotID, err)
+		}
+	}
+
 	for _, fn := range s.config.DatasetLoaders {
 		if err := fn(d); err != nil {
 			return fmt.Errorf("repo.UpdateDataset %s@%s: %w", d.ID, snapshotID, err)
@@ -818,7 +986,7 @@ func (s *Repo) MutateDataset(id string, u *models.Person, muts ...Mutation) erro
 
 func (s *Repo) DatasetsAfter(t time.Time, limit, offset int) (int, []*models.Dataset, error) {
 	n, err := s.datasetStore.CountSql(
-		"SELECT * FROM datasets WHERE date_until IS NULL AND date_from >= $1",
+		"SELECT snapshot_id, id, data, date_from, date_until FROM datasets WHERE date_until IS NULL AND date_from >= $1",
 		[]any{t},
 		s.opts,
 	)
@@ -827,7 +995,7 @@ func (s *Repo) DatasetsAfter(t time.Time, limit, offset int) (int, []*models.Dat
 	}
 
 	c, err := s.datasetStore.Select(
-		"SELECT * FROM datasets WHERE date_until IS NULL AND date_from >= $1 ORDER BY date_from ASC LIMIT $2 OFFSET $3",
+		"SELECT snapshot_id, id, data, date_from, date_until FROM datasets WHERE date_until IS NULL AND date_from >= $1 ORDER BY date_from ASC LIMIT $2 OFFSET $3",
 		[]any{t, limit, offset},
 		s.opts,
 	)
@@ -860,7 +1028,7 @@ func (s *Repo) DatasetsAfter(t time.Time, limit, offset int) (int, []*models.Dat
 
 func (s *Repo) DatasetsBetween(t1, t2 time.Time, fn func(*models.Dataset) bool) error {
 	c, err := s.datasetStore.Select(
-		"SELECT * FROM datasets WHERE date_until IS NULL AND date_from >= $1 AND date_from <= $2 ORDER BY date_from ASC",
+		"SELECT snapshot_id, id, data, date_from, date_until FROM datasets WHERE date_until IS NULL AND date_from >= $1 AND date_from <= $2 ORDER BY date_from ASC",
 		[]any{t1, t2},
 		s.opts,
 	)
@@ -916,6 +1084,34 @@ func (s *Repo) EachDataset(fn func(*models.Dataset) bool) error {
 	return nil
 }
 
+// EachDatasetAt iterates over all datasets as they were at time t
+func (s *Repo) EachDatasetAt(t time.Time, fn func(*models.Dataset) bool) error {
+	c, err := s.datasetStore.GetAllAt(t, s.opts)
+	if err != nil {
+		return fmt.Errorf("repo.EachDatasetAt: %w", err)
+	}
+	defer c.Close()
+	for c.HasNext() {
+		snap, err := c.Next()
+		if err != nil {
+			return fmt.Errorf("repo.EachDatasetAt: %w", err)
+		}
+		d, err := s.snapshotToDataset(snap)
+		if err != nil {
+			return fmt.Errorf("repo.EachDatasetAt: %w", err)
+		}
+		if ok := fn(d); !ok {
+			break
+		}
+	}
+
+	if c.Err() != nil {
+		return fmt.Errorf("repo.EachDatasetAt: %w", c.Err())
+	}
+
+	return nil
+}
+
 func (s *Repo) EachDatasetSnapshot(fn func(*models.Dataset) bool) error {
 	c, err := s.datasetStore.GetAllSnapshots(s.opts)
 	if err != nil {
@@ -945,7 +1141,7 @@ func (s *Repo) EachDatasetSnapshot(fn func(*models.Dataset) bool) error {
 
 func (s *Repo) EachDatasetWithoutHandle(fn func(*models.Dataset) bool) error {
 	sql := `
-		SELECT * FROM datasets WHERE date_until IS NULL AND
+		SELECT snapshot_id, id, data, date_from, date_until FROM datasets WHERE date_until IS NULL AND
 		data->>'status' = 'public' AND
 		NOT data ? 'handle'
 		`
@@ -1017,12 +1213,32 @@ func (s *Repo) DatasetHistory(id string, fn func(*models.Dataset) bool) error {
 	return nil
 }
 
+// DatasetChanges returns the changes made by each snapshot in the history of
+// a dataset, newest first
+func (s *Repo) DatasetChanges(ctx context.Context, id string) ([]*diffing.Entry, error) {
+	var snapshots []*models.Dataset
+	if err := s.DatasetHistory(id, func(d *models.Dataset) bool {
+		snapshots = append(snapshots, d)
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("repo.DatasetChanges %s: %w", id, err)
+	}
+	entries, err := diffing.DatasetHistory(snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("repo.DatasetChanges %s: %w", id, err)
+	}
+	if err := s.addRestoresToHistory(ctx, "dataset", id, entries); err != nil {
+		return nil, fmt.Errorf("repo.DatasetChanges %s: %w", id, err)
+	}
+	return entries, nil
+}
+
 func (s *Repo) UpdateDatasetEmbargoes() (int, error) {
 	var n int
 	embargoAccessLevel := "info:eu-repo/semantics/embargoedAccess"
 	now := time.Now().Format("2006-01-02")
 	sql := `
-		SELECT * FROM datasets
+		SELECT snapshot_id, id, data, date_from, date_until FROM datasets
 		WHERE date_until is null AND
 		data->>'access_level' = $1 AND
 		data->>'embargo_date' <> '' AND
@@ -1161,20 +1377,75 @@ func (s *Repo) RemovePublicationDataset(p *models.Publication, d *models.Dataset
 	})
 }
 
+// LinkPublicationDataset relates a publication and a dataset like
+// AddPublicationDataset, but saves both records with EditPublication and
+// EditDataset. The link to a public record goes into its draft. Pass the
+// working copies of both records.
+func (s *Repo) LinkPublicationDataset(p *models.Publication, d *models.Dataset, u *models.Person) error {
+	return s.tx(context.Background(), func(s *Repo) error {
+		if !p.HasRelatedDataset(d.ID) {
+			p.RelatedDataset = append(p.RelatedDataset, models.RelatedDataset{ID: d.ID})
+			if err := s.EditPublication(p.SnapshotID, p, u); err != nil {
+				return fmt.Errorf("repo.LinkPublicationDataset %s %s: %w", p.ID, d.ID, err)
+			}
+		}
+		if !d.HasRelatedPublication(p.ID) {
+			d.RelatedPublication = append(d.RelatedPublication, models.RelatedPublication{ID: p.ID})
+			if err := s.EditDataset(d.SnapshotID, d, u); err != nil {
+				return fmt.Errorf("repo.LinkPublicationDataset %s %s: %w", p.ID, d.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// UnlinkPublicationDataset is the counterpart of LinkPublicationDataset
+func (s *Repo) UnlinkPublicationDataset(p *models.Publication, d *models.Dataset, u *models.Person) error {
+	return s.tx(context.Background(), func(s *Repo) error {
+		if p.HasRelatedDataset(d.ID) {
+			p.RemoveRelatedDataset(d.ID)
+			if err := s.EditPublication(p.SnapshotID, p, u); err != nil {
+				return fmt.Errorf("repo.UnlinkPublicationDataset %s %s: %w", p.ID, d.ID, err)
+			}
+		}
+		if d.HasRelatedPublication(p.ID) {
+			d.RemoveRelatedPublication(p.ID)
+			if err := s.EditDataset(d.SnapshotID, d, u); err != nil {
+				return fmt.Errorf("repo.UnlinkPublicationDataset %s %s: %w", p.ID, d.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
 func (s *Repo) PurgeAllPublications() error {
-	return s.publicationStore.PurgeAll(s.opts)
+	if err := s.publicationStore.PurgeAll(s.opts); err != nil {
+		return err
+	}
+	return s.publicationStore.ExecSql("delete from comments where record_type = 'publication'", nil, s.opts)
 }
 
 func (s *Repo) PurgePublication(id string) error {
-	return s.publicationStore.Purge(id, s.opts)
+	if err := s.publicationStore.Purge(id, s.opts); err != nil {
+		return err
+	}
+	return s.publicationStore.ExecSql("delete from comments where record_type = 'publication' and record_id = $1", []any{id}, s.opts)
 }
 
 func (s *Repo) PurgeAllDatasets() error {
-	return s.datasetStore.PurgeAll(s.opts)
+	if err := s.datasetStore.PurgeAll(s.opts); err != nil {
+		return err
+	}
+	return s.datasetStore.ExecSql("delete from comments where record_type = 'dataset'", nil, s.opts)
 }
 
 func (s *Repo) PurgeDataset(id string) error {
-	return s.datasetStore.Purge(id, s.opts)
+	if err := s.datasetStore.Purge(id, s.opts); err != nil {
+		return err
+	}
+	return s.datasetStore.ExecSql("delete from comments where record_type = 'dataset' and record_id = $1", []any{id}, s.opts)
 }
 
 func (s *Repo) snapshotToPublication(snap *snapstore.Snapshot) (*models.Publication, error) {
@@ -0,0 +1,240 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ugent-library/biblio-backoffice/diffing"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+var (
+	ErrEmptyReason       = errors.New("reason can't be empty")
+	ErrSnapshotIsCurrent = errors.New("snapshot has the same content as the current version")
+)
+
+type snapshotRestoreRow struct {
+	SnapshotID         string
+	RecordType         string
+	RecordID           string
+	RestoredSnapshotID string
+	Reason             string
+	UserID             *string
+	DateCreated        time.Time
+}
+
+func (s *Repo) GetPublicationSnapshot(id, snapshotID string) (*models.Publication, error) {
+	snap, err := s.publicationStore.GetSnapshot(id, snapshotID, s.opts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("repo.GetPublicationSnapshot %s@%s: %w", id, snapshotID, models.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPublicationSnapshot %s@%s: %w", id, snapshotID, err)
+	}
+	p, err := s.snapshotToPublication(snap)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPublicationSnapshot %s@%s: %w", id, snapshotID, err)
+	}
+	return p, nil
+}
+
+func (s *Repo) GetDatasetSnapshot(id, snapshotID string) (*models.Dataset, error) {
+	snap, err := s.datasetStore.GetSnapshot(id, snapshotID, s.opts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("repo.GetDatasetSnapshot %s@%s: %w", id, snapshotID, models.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDatasetSnapshot %s@%s: %w", id, snapshotID, err)
+	}
+	d, err := s.snapshotToDataset(snap)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDatasetSnapshot %s@%s: %w", id, snapshotID, err)
+	}
+	return d, nil
+}
+
+// RestorePublication adds a new snapshot with the content of an earlier
+// snapshot. The status, lock and bookkeeping fields of the current version are
+// kept. Validation errors are returned as *okay.Errors.
+func (s *Repo) RestorePublication(ctx context.Context, id, snapshotID, reason string, u *models.Person) (*models.Publication, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("repo.RestorePublication %s@%s: %w", id, snapshotID, ErrEmptyReason)
+	}
+
+	var p *models.Publication
+
+	err := s.tx(ctx, func(s *Repo) error {
+		current, err := s.GetPublication(id)
+		if err != nil {
+			return err
+		}
+		old, err := s.GetPublicationSnapshot(id, snapshotID)
+		if err != nil {
+			return err
+		}
+		if old.SnapshotID == current.SnapshotID {
+			return ErrSnapshotIsCurrent
+		}
+
+		old.SnapshotID = current.SnapshotID
+		old.DateFrom = current.DateFrom
+		old.DateUntil = nil
+		old.DateCreated = current.DateCreated
+		old.DateUpdated = current.DateUpdated
+		old.CreatorID = current.CreatorID
+		old.Creator = current.Creator
+		old.Status = current.Status
+		old.Locked = current.Locked
+		old.HasBeenPublic = current.HasBeenPublic
+		if old.Handle == "" {
+			old.Handle = current.Handle
+		}
+
+		// the user fields of old are those of whoever made that snapshot,
+		// only compare the content
+		changes, err := diffing.Publications(current, old)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return ErrSnapshotIsCurrent
+		}
+
+		if err := old.Validate(); err != nil {
+			return err
+		}
+
+		if err := s.UpdatePublication(current.SnapshotID, old, u); err != nil {
+			return err
+		}
+
+		if err := s.addSnapshotRestore("publication", old.ID, old.SnapshotID, snapshotID, reason, u); err != nil {
+			return err
+		}
+
+		p = old
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.RestorePublication %s@%s: %w", id, snapshotID, err)
+	}
+
+	return p, nil
+}
+
+// RestoreDataset adds a new snapshot with the content of an earlier snapshot.
+// The status, lock and bookkeeping fields of the current version are kept.
+// Validation errors are returned as *okay.Errors.
+func (s *Repo) RestoreDataset(ctx context.Context, id, snapshotID, reason string, u *models.Person) (*models.Dataset, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("repo.RestoreDataset %s@%s: %w", id, snapshotID, ErrEmptyReason)
+	}
+
+	var d *models.Dataset
+
+	err := s.tx(ctx, func(s *Repo) error {
+		current, err := s.GetDataset(id)
+		if err != nil {
+			return err
+		}
+		old, err := s.GetDatasetSnapshot(id, snapshotID)
+		if err != nil {
+			return err
+		}
+		if old.SnapshotID == current.SnapshotID {
+			return ErrSnapshotIsCurrent
+		}
+
+		old.SnapshotID = current.SnapshotID
+		old.DateFrom = current.DateFrom
+		old.DateUntil = nil
+		old.DateCreated = current.DateCreated
+		old.DateUpdated = current.DateUpdated
+		old.CreatorID = current.CreatorID
+		old.Creator = current.Creator
+		old.Status = current.Status
+		old.Locked = current.Locked
+		old.HasBeenPublic = current.HasBeenPublic
+		if old.Handle == "" {
+			old.Handle = current.Handle
+		}
+
+		// the user fields of old are those of whoever made that snapshot,
+		// only compare the content
+		changes, err := diffing.Datasets(current, old)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return ErrSnapshotIsCurrent
+		}
+
+		if err := old.Validate(); err != nil {
+			return err
+		}
+
+		if err := s.UpdateDataset(current.SnapshotID, old, u); err != nil {
+			return err
+		}
+
+		if err := s.addSnapshotRestore("dataset", old.ID, old.SnapshotID, snapshotID, reason, u); err != nil {
+			return err
+		}
+
+		d = old
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.RestoreDataset %s@%s: %w", id, snapshotID, err)
+	}
+
+	return d, nil
+}
+
+func (s *Repo) addSnapshotRestore(recordType, recordID, snapshotID, restoredSnapshotID, reason string, u *models.Person) error {
+	var userID *string
+	if u != nil {
+		userID = &u.ID
+	}
+
+	store := s.publicationStore
+	if recordType == "dataset" {
+		store = s.datasetStore
+	}
+
+	return store.ExecSql(
+		"INSERT INTO snapshot_restores (snapshot_id, record_type, record_id, restored_snapshot_id, reason, user_id) VALUES ($1, $2, $3, $4, $5, $6)",
+		[]any{snapshotID, recordType, recordID, restoredSnapshotID, reason, userID},
+		s.opts,
+	)
+}
+
+// addRestoresToHistory marks the history entries that restored an earlier
+// snapshot
+func (s *Repo) addRestoresToHistory(ctx context.Context, recordType, recordID string, entries []*diffing.Entry) error {
+	q := `
+		select * from snapshot_restores where record_type = $1 and record_id = $2;
+	`
+	rows, err := s.conn.Query(ctx, q, recordType, recordID)
+	if err != nil {
+		return err
+	}
+	restoreRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[snapshotRestoreRow])
+	if err != nil {
+		return err
+	}
+	for _, row := range restoreRows {
+		for _, e := range entries {
+			if e.SnapshotID == row.SnapshotID {
+				e.RestoredFrom = row.RestoredSnapshotID
+				e.Reason = row.Reason
+			}
+		}
+	}
+	return nil
+}
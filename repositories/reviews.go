@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/ugent-library/biblio-backoffice/models"
+	"github.com/ugent-library/biblio-backoffice/snapstore"
+	"github.com/ugent-library/biblio-backoffice/vocabularies"
+)
+
+var ErrReviewClosed = errors.New("review is already approved")
+
+type reviewRow struct {
+	ID          string
+	RecordType  string
+	RecordID    string
+	Status      string
+	Reason      string
+	FacultyIDs  []string
+	UserID      *string
+	AssigneeID  *string
+	ReviewerID  *string
+	Comment     *string
+	DateCreated time.Time
+	DateUpdated time.Time
+}
+
+func (row reviewRow) toModel() *models.Review {
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return &models.Review{
+		ID:          row.ID,
+		RecordType:  row.RecordType,
+		RecordID:    row.RecordID,
+		Status:      row.Status,
+		Reason:      row.Reason,
+		FacultyIDs:  row.FacultyIDs,
+		UserID:      deref(row.UserID),
+		AssigneeID:  deref(row.AssigneeID),
+		ReviewerID:  deref(row.ReviewerID),
+		Comment:     deref(row.Comment),
+		DateCreated: row.DateCreated,
+		DateUpdated: row.DateUpdated,
+	}
+}
+
+func (s *Repo) queryReviews(ctx context.Context, q string, args ...any) ([]*models.Review, error) {
+	rows, err := s.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	reviewRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[reviewRow])
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]*models.Review, 0, len(reviewRows))
+	for _, row := range reviewRows {
+		reviews = append(reviews, row.toModel())
+	}
+	return reviews, nil
+}
+
+// enqueueReview places a record in the review queue. If the record already
+// has an open review, it becomes pending again. It runs in the transaction
+// of the store, if any.
+func (s *Repo) enqueueReview(store *snapstore.Store, recordType, recordID, reason string, facultyIDs []string, u *models.Person) error {
+	q := `
+		insert into reviews (id, record_type, record_id, status, reason, faculty_ids, user_id)
+		values ($1, $2, $3, 'pending', $4, $5, $6)
+		on conflict (record_type, record_id) where status <> 'approved' do update
+		set status = 'pending',
+			reason = case when reviews.status = 'pending' then reviews.reason else excluded.reason end,
+			faculty_ids = excluded.faculty_ids,
+			user_id = excluded.user_id,
+			date_updated = now();
+	`
+	if facultyIDs == nil {
+		facultyIDs = []string{}
+	}
+	return store.ExecSql(q, []any{ulid.Make().String(), recordType, recordID, reason, facultyIDs, u.ID}, s.opts)
+}
+
+// removeOpenReview takes a record out of the review queue
+func (s *Repo) removeOpenReview(store *snapstore.Store, recordType, recordID string) error {
+	q := `
+		delete from reviews where record_type = $1 and record_id = $2 and status <> 'approved';
+	`
+	return store.ExecSql(q, []any{recordType, recordID}, s.opts)
+}
+
+// reviewFacultyIDs returns the faculties a record belongs to through its
+// related organizations
+func reviewFacultyIDs(rels []*models.RelatedOrganization) []string {
+	faculties := vocabularies.Map["faculties"]
+	ids := []string{}
+	for _, rel := range rels {
+		if rel.Organization == nil {
+			if slices.Contains(faculties, rel.OrganizationID) && !slices.Contains(ids, rel.OrganizationID) {
+				ids = append(ids, rel.OrganizationID)
+			}
+			continue
+		}
+		for _, org := range rel.Organization.Tree {
+			if slices.Contains(faculties, org.ID) && !slices.Contains(ids, org.ID) {
+				ids = append(ids, org.ID)
+			}
+		}
+	}
+	return ids
+}
+
+func (s *Repo) GetReview(ctx context.Context, id string) (*models.Review, error) {
+	q := `
+		select * from reviews where id = $1;
+	`
+	reviews, err := s.queryReviews(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetReview %s: %w", id, err)
+	}
+	if len(reviews) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return reviews[0], nil
+}
+
+// GetOpenReview returns the pending or changes requested review of a record
+func (s *Repo) GetOpenReview(ctx context.Context, recordType, recordID string) (*models.Review, error) {
+	q := `
+		select * from reviews where record_type = $1 and record_id = $2 and status <> 'approved';
+	`
+	reviews, err := s.queryReviews(ctx, q, recordType, recordID)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetOpenReview %s %s: %w", recordType, recordID, err)
+	}
+	if len(reviews) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return reviews[0], nil
+}
+
+// GetReviews returns a page of the review queue, oldest first. Supported
+// filters are status, record_type, assignee_id and faculty_id. Only open
+// reviews are returned if there is no status filter.
+func (s *Repo) GetReviews(ctx context.Context, searchArgs *models.SearchArgs) (int, []*models.Review, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := psql.Select("*", "COUNT(*) OVER() AS total").From("reviews")
+
+	if !searchArgs.HasFilter("status") {
+		query = query.Where(sq.NotEq{"status": models.ReviewApproved})
+	}
+	for field, filterValue := range searchArgs.Filters {
+		switch field {
+		case "status", "record_type", "assignee_id":
+			query = query.Where(sq.Eq{field: filterValue})
+		case "faculty_id":
+			query = query.Where("faculty_ids && ?", filterValue)
+		}
+	}
+
+	query = query.
+		OrderBy("date_created ASC").
+		Limit(uint64(searchArgs.Limit())).
+		Offset(uint64(searchArgs.Offset()))
+
+	type row struct {
+		reviewRow
+		Total int
+	}
+	rows, err := queryRows[row](s, ctx, query)
+	if err != nil {
+		return 0, nil, fmt.Errorf("repo.GetReviews: %w", err)
+	}
+	if len(rows) == 0 {
+		return 0, []*models.Review{}, nil
+	}
+	reviews := make([]*models.Review, 0, len(rows))
+	for _, r := range rows {
+		reviews = append(reviews, r.toModel())
+	}
+	return rows[0].Total, reviews, nil
+}
+
+// CountOpenReviews counts the open reviews of a record type by status and
+// faculty. The "all" faculty counts each review once.
+func (s *Repo) CountOpenReviews(ctx context.Context, recordType string) (map[string]map[string]int, error) {
+	q := `
+		select status, f as faculty_id, count(*) from reviews, unnest(faculty_ids) f
+		where record_type = $1 and status <> 'approved'
+		group by status, f
+		union all
+		select status, 'all', count(*) from reviews
+		where record_type = $1 and status <> 'approved'
+		group by status;
+	`
+	rows, err := s.conn.Query(ctx, q, recordType)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CountOpenReviews %s: %w", recordType, err)
+	}
+	defer rows.Close()
+
+	counts := map[string]map[string]int{
+		models.ReviewPending:          {},
+		models.ReviewChangesRequested: {},
+	}
+	for rows.Next() {
+		var status, facultyID string
+		var n int
+		if err := rows.Scan(&status, &facultyID, &n); err != nil {
+			return nil, fmt.Errorf("repo.CountOpenReviews %s: %w", recordType, err)
+		}
+		counts[status][facultyID] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.CountOpenReviews %s: %w", recordType, err)
+	}
+	return counts, nil
+}
+
+// AssignReview assigns an open review to a curator, an empty assigneeID
+// unassigns it
+func (s *Repo) AssignReview(ctx context.Context, id, assigneeID string) error {
+	q := `
+		update reviews set assignee_id = nullif($2, ''), date_updated = now()
+		where id = $1 and status <> 'approved';
+	`
+	res, err := s.conn.Exec(ctx, q, id, assigneeID)
+	if err != nil {
+		return fmt.Errorf("repo.AssignReview %s: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("repo.AssignReview %s: %w", id, ErrReviewClosed)
+	}
+	return nil
+}
+
+// ApproveReview closes an open review and publishes the pending draft of the
+// record, if any. Validation errors of the draft are returned as
+// *okay.Errors and leave the review open.
+func (s *Repo) ApproveReview(ctx context.Context, id string, u *models.Person, comment string) error {
+	review, err := s.GetReview(ctx, id)
+	if err != nil {
+		return fmt.Errorf("repo.ApproveReview %s: %w", id, err)
+	}
+
+	err = s.tx(ctx, func(s *Repo) error {
+		// approval publishes pending edits, whatever the record was queued
+		// for first
+		var err error
+		switch review.RecordType {
+		case "publication":
+			_, err = s.publishPublicationDraft(review.RecordID, u)
+		case "dataset":
+			_, err = s.publishDatasetDraft(review.RecordID, u)
+		}
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			return err
+		}
+		return s.decideReview(ctx, id, models.ReviewApproved, u, comment)
+	})
+	if err != nil {
+		return fmt.Errorf("repo.ApproveReview %s: %w", id, err)
+	}
+	return nil
+}
+
+// RequestReviewChanges sends an open review back to the researcher with a
+// comment. It becomes pending again when the researcher changes the record.
+func (s *Repo) RequestReviewChanges(ctx context.Context, id string, u *models.Person, comment string) error {
+	if err := s.decideReview(ctx, id, models.ReviewChangesRequested, u, comment); err != nil {
+		return fmt.Errorf("repo.RequestReviewChanges %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *Repo) decideReview(ctx context.Context, id, status string, u *models.Person, comment string) error {
+	q := `
+		update reviews set status = $2, reviewer_id = $3, comment = nullif($4, ''), date_updated = now()
+		where id = $1 and status <> 'approved';
+	`
+	res, err := s.db().Exec(ctx, q, id, status, u.ID, comment)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrReviewClosed
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type savedSearchRow struct {
+	ID           string
+	UserID       string
+	Name         string
+	SearchArgs   json.RawMessage
+	Digest       bool
+	LastDigestAt *time.Time
+	DateCreated  time.Time
+	DateUpdated  time.Time
+}
+
+func (row savedSearchRow) toModel() (*models.SavedSearch, error) {
+	searchArgs := models.NewSearchArgs()
+	if err := json.Unmarshal(row.SearchArgs, searchArgs); err != nil {
+		return nil, err
+	}
+	return &models.SavedSearch{
+		ID:           row.ID,
+		UserID:       row.UserID,
+		Name:         row.Name,
+		SearchArgs:   searchArgs,
+		Digest:       row.Digest,
+		LastDigestAt: row.LastDigestAt,
+		DateCreated:  row.DateCreated,
+		DateUpdated:  row.DateUpdated,
+	}, nil
+}
+
+func (r *Repo) querySavedSearches(ctx context.Context, q string, args ...any) ([]*models.SavedSearch, error) {
+	rows, err := r.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	searchRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[savedSearchRow])
+	if err != nil {
+		return nil, err
+	}
+	searches := make([]*models.SavedSearch, 0, len(searchRows))
+	for _, row := range searchRows {
+		s, err := row.toModel()
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, s)
+	}
+	return searches, nil
+}
+
+func (r *Repo) AddSavedSearch(ctx context.Context, s *models.SavedSearch) error {
+	s.ID = ulid.Make().String()
+
+	searchArgs, err := json.Marshal(s.SearchArgs)
+	if err != nil {
+		return fmt.Errorf("repo.AddSavedSearch: %w", err)
+	}
+
+	q := `
+		insert into saved_searches (id, user_id, name, search_args, digest)
+		values ($1, $2, $3, $4, $5)
+		returning date_created, date_updated;
+	`
+	err = r.conn.QueryRow(ctx, q, s.ID, s.UserID, s.Name, searchArgs, s.Digest).
+		Scan(&s.DateCreated, &s.DateUpdated)
+	if err != nil {
+		return fmt.Errorf("repo.AddSavedSearch: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) GetSavedSearch(ctx context.Context, id string) (*models.SavedSearch, error) {
+	q := `
+		select * from saved_searches where id = $1;
+	`
+	searches, err := r.querySavedSearches(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetSavedSearch %s: %w", id, err)
+	}
+	if len(searches) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return searches[0], nil
+}
+
+func (r *Repo) GetSavedSearchesByUser(ctx context.Context, userID string) ([]*models.SavedSearch, error) {
+	q := `
+		select * from saved_searches where user_id = $1 order by lower(name);
+	`
+	searches, err := r.querySavedSearches(ctx, q, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetSavedSearchesByUser %s: %w", userID, err)
+	}
+	return searches, nil
+}
+
+// GetDigestSavedSearches returns all saved searches that want a digest
+func (r *Repo) GetDigestSavedSearches(ctx context.Context) ([]*models.SavedSearch, error) {
+	q := `
+		select * from saved_searches where digest order by user_id, id;
+	`
+	searches, err := r.querySavedSearches(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetDigestSavedSearches: %w", err)
+	}
+	return searches, nil
+}
+
+func (r *Repo) SetSavedSearchDigest(ctx context.Context, id string, digest bool) error {
+	q := `
+		update saved_searches set digest = $2, date_updated = now() where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, digest); err != nil {
+		return fmt.Errorf("repo.SetSavedSearchDigest %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Repo) SetSavedSearchLastDigestAt(ctx context.Context, id string, t time.Time) error {
+	q := `
+		update saved_searches set last_digest_at = $2 where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, t); err != nil {
+		return fmt.Errorf("repo.SetSavedSearchLastDigestAt %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Repo) DeleteSavedSearch(ctx context.Context, id string) error {
+	q := `
+		delete from saved_searches where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id); err != nil {
+		return fmt.Errorf("repo.DeleteSavedSearch %s: %w", id, err)
+	}
+	return nil
+}
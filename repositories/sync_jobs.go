@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type syncJobRow struct {
+	Kind        string
+	RecordID    string
+	Status      string
+	Requeue     bool
+	Attempts    int
+	Error       *string
+	NextAttempt time.Time
+	DateCreated time.Time
+	DateUpdated time.Time
+}
+
+func (row syncJobRow) toModel() *models.SyncJob {
+	return &models.SyncJob{
+		Kind:        row.Kind,
+		RecordID:    row.RecordID,
+		Status:      row.Status,
+		Attempts:    row.Attempts,
+		Error:       lo.FromPtr(row.Error),
+		NextAttempt: row.NextAttempt,
+		DateCreated: row.DateCreated,
+		DateUpdated: row.DateUpdated,
+	}
+}
+
+// AddSyncJob queues a sync of the record. Jobs for the same record are
+// coalesced; if the record is being synced right now, it is synced again
+// afterwards.
+//
+// Like webhook events, jobs are queued by a repository listener after the
+// record is saved. If the process dies in between, the next change to the
+// record queues it again.
+func (r *Repo) AddSyncJob(ctx context.Context, kind, recordID string) error {
+	q := `
+		insert into sync_jobs as j (kind, record_id) values ($1, $2)
+		on conflict (kind, record_id) do update set
+			requeue = j.status = 'running',
+			status = case when j.status = 'running' then j.status else 'pending' end,
+			attempts = case when j.status = 'running' then j.attempts else 0 end,
+			error = case when j.status = 'running' then j.error else null end,
+			next_attempt = case when j.status = 'running' then j.next_attempt else now() end,
+			date_updated = case when j.status = 'running' then j.date_updated else now() end;
+	`
+	if _, err := r.conn.Exec(ctx, q, kind, recordID); err != nil {
+		return fmt.Errorf("repo.AddSyncJob %s %s: %w", kind, recordID, err)
+	}
+	return nil
+}
+
+// ClaimSyncJob marks the job that is due the longest as running and counts
+// the attempt. Running jobs that were claimed before staleBefore are assumed
+// to be abandoned by a crashed worker and are claimed again. Nil is returned
+// if there is nothing to do.
+func (r *Repo) ClaimSyncJob(ctx context.Context, staleBefore time.Time) (*models.SyncJob, error) {
+	q := `
+		update sync_jobs set status = 'running', requeue = false, attempts = attempts + 1, date_updated = now()
+		where (kind, record_id) = (
+			select kind, record_id from sync_jobs
+			where (status = 'pending' and next_attempt <= now()) or (status = 'running' and date_updated < $1)
+			order by next_attempt
+			limit 1
+			for update skip locked
+		)
+		returning *;
+	`
+	rows, err := r.conn.Query(ctx, q, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ClaimSyncJob: %w", err)
+	}
+	jobRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[syncJobRow])
+	if err != nil {
+		return nil, fmt.Errorf("repo.ClaimSyncJob: %w", err)
+	}
+	if len(jobRows) == 0 {
+		return nil, nil
+	}
+	return jobRows[0].toModel(), nil
+}
+
+// CompleteSyncJob removes a finished job, unless the record was queued again
+// while it was running.
+func (r *Repo) CompleteSyncJob(ctx context.Context, kind, recordID string) error {
+	// delete first, a job that is queued again in between is inserted anew
+	q := `
+		delete from sync_jobs where kind = $1 and record_id = $2 and not requeue;
+	`
+	if _, err := r.conn.Exec(ctx, q, kind, recordID); err != nil {
+		return fmt.Errorf("repo.CompleteSyncJob %s %s: %w", kind, recordID, err)
+	}
+	q = `
+		update sync_jobs set status = 'pending', requeue = false, attempts = 0, error = null, next_attempt = now(), date_updated = now()
+		where kind = $1 and record_id = $2 and requeue;
+	`
+	if _, err := r.conn.Exec(ctx, q, kind, recordID); err != nil {
+		return fmt.Errorf("repo.CompleteSyncJob %s %s: %w", kind, recordID, err)
+	}
+	return nil
+}
+
+// RetrySyncJob records a failed attempt, the job is tried again at
+// nextAttempt. A nil nextAttempt gives up on the job. A record that was queued
+// again while the job was running is retried right away.
+func (r *Repo) RetrySyncJob(ctx context.Context, kind, recordID string, syncErr error, nextAttempt *time.Time) error {
+	q := `
+		update sync_jobs set
+			status = case when requeue then 'pending' else $3 end,
+			attempts = case when requeue then 0 else attempts end,
+			next_attempt = case when requeue then now() else coalesce($5, next_attempt) end,
+			requeue = false,
+			error = $4,
+			date_updated = now()
+		where kind = $1 and record_id = $2;
+	`
+	status := models.SyncJobPending
+	if nextAttempt == nil {
+		status = models.SyncJobFailed
+	}
+	if _, err := r.conn.Exec(ctx, q, kind, recordID, status, syncErr.Error(), nextAttempt); err != nil {
+		return fmt.Errorf("repo.RetrySyncJob %s %s: %w", kind, recordID, err)
+	}
+	return nil
+}
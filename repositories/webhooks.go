@@ -0,0 +1,229 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+type webhookDeliveryRow struct {
+	ID             string
+	EventID        string
+	Event          string
+	RecordType     string
+	RecordID       string
+	URL            string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	ResponseStatus *int
+	Error          *string
+	NextAttempt    time.Time
+	DateCreated    time.Time
+	DateUpdated    time.Time
+}
+
+func (row webhookDeliveryRow) toModel() *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:             row.ID,
+		EventID:        row.EventID,
+		Event:          row.Event,
+		RecordType:     row.RecordType,
+		RecordID:       row.RecordID,
+		URL:            row.URL,
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       row.Attempts,
+		ResponseStatus: lo.FromPtr(row.ResponseStatus),
+		Error:          lo.FromPtr(row.Error),
+		NextAttempt:    row.NextAttempt,
+		DateCreated:    row.DateCreated,
+		DateUpdated:    row.DateUpdated,
+	}
+}
+
+func (r *Repo) queryWebhookDeliveries(ctx context.Context, q string, args ...any) ([]*models.WebhookDelivery, error) {
+	rows, err := r.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	deliveryRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[webhookDeliveryRow])
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]*models.WebhookDelivery, 0, len(deliveryRows))
+	for _, row := range deliveryRows {
+		deliveries = append(deliveries, row.toModel())
+	}
+	return deliveries, nil
+}
+
+// AddWebhookEvent queues a delivery of the current state of a record to each
+// url. The event is derived from the status that was last sent for the
+// record. record is the public representation of the record, see
+// frontoffice.MapPublication and frontoffice.MapDataset.
+//
+// Events are queued by a repository listener after the record is saved, not
+// in the same transaction. If the process dies in between, the event is lost;
+// the next change to the record sends its full state again.
+func (r *Repo) AddWebhookEvent(ctx context.Context, recordType, recordID, status string, created bool, record any, urls []string) error {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("repo.AddWebhookEvent %s %s: %w", recordType, recordID, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var oldStatus string
+	err = tx.QueryRow(ctx, `
+		select status from webhook_record_states
+		where record_type = $1 and record_id = $2
+		for update;
+	`, recordType, recordID).Scan(&oldStatus)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("repo.AddWebhookEvent %s %s: %w", recordType, recordID, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		insert into webhook_record_states (record_type, record_id, status)
+		values ($1, $2, $3)
+		on conflict (record_type, record_id) do update set status = excluded.status;
+	`, recordType, recordID, status)
+	if err != nil {
+		return fmt.Errorf("repo.AddWebhookEvent %s %s: %w", recordType, recordID, err)
+	}
+
+	payload := models.WebhookPayload{
+		ID:          ulid.Make().String(),
+		Event:       recordType + "." + models.WebhookEvent(oldStatus, status, created),
+		RecordType:  recordType,
+		RecordID:    recordID,
+		DateCreated: time.Now(),
+		Record:      record,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("repo.AddWebhookEvent %s %s: %w", recordType, recordID, err)
+	}
+
+	for _, url := range urls {
+		_, err := tx.Exec(ctx, `
+			insert into webhook_deliveries (id, event_id, event, record_type, record_id, url, payload, date_created)
+			values ($1, $2, $3, $4, $5, $6, $7, $8);
+		`, ulid.Make().String(), payload.ID, payload.Event, recordType, recordID, url, body, payload.DateCreated)
+		if err != nil {
+			return fmt.Errorf("repo.AddWebhookEvent %s %s: %w", recordType, recordID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("repo.AddWebhookEvent %s %s: %w", recordType, recordID, err)
+	}
+
+	return nil
+}
+
+// ClaimWebhookDelivery marks the delivery that is due the longest as running
+// and counts the attempt. Running deliveries that were claimed before
+// staleBefore are assumed to be abandoned by a crashed worker and are claimed
+// again. Nil is returned if there is nothing to do.
+//
+// Events for a record are delivered to an url in the order they happened: a
+// delivery waits while an earlier one for the same record and url is pending
+// or running. A failed delivery that is requeued later still arrives after
+// newer events, receivers should ignore payloads with a date_created older
+// than the last one they processed for the record.
+func (r *Repo) ClaimWebhookDelivery(ctx context.Context, staleBefore time.Time) (*models.WebhookDelivery, error) {
+	q := `
+		update webhook_deliveries set status = 'running', attempts = attempts + 1, date_updated = now()
+		where id = (
+			select id from webhook_deliveries d
+			where ((status = 'pending' and next_attempt <= now()) or (status = 'running' and date_updated < $1))
+			and not exists (
+				select 1 from webhook_deliveries e
+				where e.record_type = d.record_type and e.record_id = d.record_id and e.url = d.url
+				and e.status in ('pending', 'running')
+				and (e.date_created, e.id) < (d.date_created, d.id)
+			)
+			order by next_attempt
+			limit 1
+			for update skip locked
+		)
+		returning *;
+	`
+	deliveries, err := r.queryWebhookDeliveries(ctx, q, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ClaimWebhookDelivery: %w", err)
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	return deliveries[0], nil
+}
+
+func (r *Repo) CompleteWebhookDelivery(ctx context.Context, id string, responseStatus int) error {
+	q := `
+		update webhook_deliveries set status = 'delivered', response_status = $2, error = null, date_updated = now()
+		where id = $1;
+	`
+	if _, err := r.conn.Exec(ctx, q, id, responseStatus); err != nil {
+		return fmt.Errorf("repo.CompleteWebhookDelivery %s: %w", id, err)
+	}
+	return nil
+}
+
+// RetryWebhookDelivery records a failed attempt, the delivery is tried again
+// at nextAttempt. A nil nextAttempt gives up on the delivery.
+func (r *Repo) RetryWebhookDelivery(ctx context.Context, id string, responseStatus int, deliveryErr error, nextAttempt *time.Time) error {
+	q := `
+		update webhook_deliveries
+		set status = $2, response_status = nullif($3, 0), error = $4, next_attempt = coalesce($5, next_attempt), date_updated = now()
+		where id = $1;
+	`
+	status := models.WebhookDeliveryPending
+	if nextAttempt == nil {
+		status = models.WebhookDeliveryFailed
+	}
+	if _, err := r.conn.Exec(ctx, q, id, status, responseStatus, deliveryErr.Error(), nextAttempt); err != nil {
+		return fmt.Errorf("repo.RetryWebhookDelivery %s: %w", id, err)
+	}
+	return nil
+}
+
+// RequeueWebhookDelivery sends a delivery again as soon as possible
+func (r *Repo) RequeueWebhookDelivery(ctx context.Context, id string) error {
+	q := `
+		update webhook_deliveries set status = 'pending', attempts = 0, next_attempt = now(), date_updated = now()
+		where id = $1 and status <> 'running';
+	`
+	res, err := r.conn.Exec(ctx, q, id)
+	if err != nil {
+		return fmt.Errorf("repo.RequeueWebhookDelivery %s: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("repo.RequeueWebhookDelivery %s: %w", id, models.ErrNotFound)
+	}
+	return nil
+}
+
+// GetWebhookDeliveries returns the most recent deliveries with the given
+// status, newest first. An empty status returns all deliveries.
+func (r *Repo) GetWebhookDeliveries(ctx context.Context, status string, limit int) ([]*models.WebhookDelivery, error) {
+	q := `
+		select * from webhook_deliveries
+		where $1 = '' or status = $1
+		order by date_created desc
+		limit $2;
+	`
+	deliveries, err := r.queryWebhookDeliveries(ctx, q, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetWebhookDeliveries: %w", err)
+	}
+	return deliveries, nil
+}
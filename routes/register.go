@@ -21,15 +21,18 @@ import (
 	"github.com/ugent-library/biblio-backoffice/handlers"
 	"github.com/ugent-library/biblio-backoffice/handlers/authenticating"
 	"github.com/ugent-library/biblio-backoffice/handlers/candidaterecords"
+	"github.com/ugent-library/biblio-backoffice/handlers/comments"
 	"github.com/ugent-library/biblio-backoffice/handlers/dashboard"
 	"github.com/ugent-library/biblio-backoffice/handlers/datasetcreating"
 	"github.com/ugent-library/biblio-backoffice/handlers/datasetediting"
 	"github.com/ugent-library/biblio-backoffice/handlers/datasetexporting"
 	"github.com/ugent-library/biblio-backoffice/handlers/datasetsearching"
 	"github.com/ugent-library/biblio-backoffice/handlers/datasetviewing"
+	"github.com/ugent-library/biblio-backoffice/handlers/exportjobs"
 	"github.com/ugent-library/biblio-backoffice/handlers/frontoffice"
 	"github.com/ugent-library/biblio-backoffice/handlers/impersonating"
 	"github.com/ugent-library/biblio-backoffice/handlers/mediatypes"
+	"github.com/ugent-library/biblio-backoffice/handlers/notifications"
 	"github.com/ugent-library/biblio-backoffice/handlers/proxies"
 	"github.com/ugent-library/biblio-backoffice/handlers/publicationbatch"
 	"github.com/ugent-library/biblio-backoffice/handlers/publicationcreating"
@@ -37,6 +40,8 @@ import (
 	"github.com/ugent-library/biblio-backoffice/handlers/publicationexporting"
 	"github.com/ugent-library/biblio-backoffice/handlers/publicationsearching"
 	"github.com/ugent-library/biblio-backoffice/handlers/publicationviewing"
+	"github.com/ugent-library/biblio-backoffice/handlers/reviews"
+	"github.com/ugent-library/biblio-backoffice/handlers/savedsearches"
 	"github.com/ugent-library/biblio-backoffice/handlers/settings"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/httpx"
@@ -115,6 +120,7 @@ func Register(c Config) {
 		SessionStore: c.SessionStore,
 		SessionName:  c.SessionName,
 		UserService:  c.Services.UserService,
+		ChangeFeed:   c.Services.ChangeFeed,
 	}
 
 	c.Router.Group(func(r *ich.Mux) {
@@ -123,6 +129,7 @@ func Register(c Config) {
 		r.Get("/frontoffice/publication", frontofficeHandler.GetAllPublications)
 		r.Get("/frontoffice/dataset/{id}", frontofficeHandler.GetDataset)
 		r.Get("/frontoffice/dataset", frontofficeHandler.GetAllDatasets)
+		r.Get("/frontoffice/changes", frontofficeHandler.GetChanges)
 	})
 
 	// frontoffice file download
@@ -205,6 +212,12 @@ func Register(c Config) {
 				// dashboard recent activity component
 				r.Get("/dashboard/candidate-records", handlers.CandidateRecords).Name("dashboard_candidate_records")
 
+				// notifications
+				r.Get("/notifications", notifications.Index).Name("notifications")
+				r.Get("/notifications/badge", notifications.Badge).Name("notifications_badge")
+				r.Post("/notifications/read", notifications.MarkAllRead).Name("mark_notifications_read")
+				r.Get("/notifications/{id}", notifications.Show).Name("notification")
+
 				// settings
 				r.Get("/settings/proxy", settings.ProxySettings).Name("proxy_settings")
 
@@ -217,6 +230,17 @@ func Register(c Config) {
 
 					r.Get("/", candidaterecords.CandidateRecords).Name("candidate_records")
 
+					// bulk actions
+					r.Group(func(r *ich.Mux) {
+						r.Use(ctx.RequireCurator)
+
+						r.Get("/bulk/confirm", candidaterecords.ConfirmBulkAction).Name("confirm_bulk_candidate_records")
+						r.Get("/bulk/assignees", candidaterecords.SuggestAssignees).Name("suggest_candidate_record_assignees")
+						r.Post("/bulk", candidaterecords.BulkAction).Name("bulk_candidate_records")
+						r.Get("/bulk/jobs", candidaterecords.BulkJobs).Name("candidate_record_bulk_jobs")
+						r.Get("/bulk/jobs/list", candidaterecords.BulkJobList).Name("candidate_record_bulk_jobs_list")
+					})
+
 					r.Route("/{id}", func(r *ich.Mux) {
 						r.Use(ctx.SetCandidateRecord(c.Services.Repo))
 
@@ -234,6 +258,13 @@ func Register(c Config) {
 							r.Put("/reject", candidaterecords.RejectCandidateRecord).Name("reject_candidate_record")
 							r.Put("/import", candidaterecords.ImportCandidateRecord).Name("import_candidate_record")
 							r.Put("/restore", candidaterecords.RestoreRejectedCandidateRecord).Name("restore_rejected_candidate_record")
+
+							r.Group(func(r *ich.Mux) {
+								r.Use(ctx.RequireCurator)
+
+								r.Put("/person-matches/{match_id}/confirm", candidaterecords.ConfirmPersonMatch).Name("confirm_candidate_record_person_match")
+								r.Put("/person-matches/{match_id}/reject", candidaterecords.RejectPersonMatch).Name("reject_candidate_record_person_match")
+							})
 						})
 					})
 				})
@@ -247,11 +278,22 @@ func Register(c Config) {
 
 						r.Get("/dashboard/datasets/{type}", dashboard.CuratorDatasets).Name("dashboard_datasets")
 						r.Get("/dashboard/publications/{type}", dashboard.CuratorPublications).Name("dashboard_publications")
+						r.Get("/dashboard/reviews/{type}", dashboard.CuratorReviews).Name("dashboard_reviews")
 					})
 
 					r.Post("/dashboard/refresh-apublications/{type}", dashboard.RefreshAPublications).Name("dashboard_refresh_apublications")
 					r.Post("/dashboard/refresh-upublications/{type}", dashboard.RefreshUPublications).Name("dashboard_refresh_upublications")
 
+					// review queue
+					r.Group(func(r *ich.Mux) {
+						r.Use(ctx.SetNav("reviews"))
+						r.Get("/reviews", reviews.Index).Name("reviews")
+					})
+					r.Get("/reviews/{id}", reviews.Show).Name("review")
+					r.Post("/reviews/{id}/assign", reviews.Assign).Name("assign_review")
+					r.Post("/reviews/{id}/approve", reviews.Approve).Name("approve_review")
+					r.Post("/reviews/{id}/request-changes", reviews.RequestChanges).Name("request_review_changes")
+
 					// proxy management
 					r.Get("/proxies/list", proxies.List).Name("proxies_list")
 					r.Get("/proxies/list/suggestions", proxies.ListSuggestions).Name("proxies_list_suggestions")
@@ -269,13 +311,24 @@ func Register(c Config) {
 					r.Post("/impersonation", impersonating.CreateImpersonation).Name("create_impersonation")
 
 					// export datasets
-					r.Get("/dataset.{format}", datasetexporting.ExportByCurationSearch).Name("export_datasets")
+					r.Post("/dataset.{format}", datasetexporting.ExportByCurationSearch).Name("export_datasets")
 
 					// change user role
 					r.Put("/role/{role}", authenticating.UpdateRole).Name("update_role")
 
 					// export publications
-					r.Get("/publication.{format}", publicationexporting.ExportByCurationSearch).Name("export_publications")
+					r.Post("/publication.{format}", publicationexporting.ExportByCurationSearch).Name("export_publications")
+
+					// export jobs
+					r.Get("/exports", exportjobs.Index).Name("export_jobs")
+					r.Get("/exports/list", exportjobs.List).Name("export_jobs_list")
+					r.Get("/exports/{id}/download", exportjobs.Download).Name("download_export_job")
+
+					// saved searches
+					r.Get("/publication/saved-searches", savedsearches.Sidebar).Name("publication_saved_searches")
+					r.Post("/publication/saved-searches", savedsearches.Create).Name("create_saved_search")
+					r.Put("/publication/saved-searches/{id}/digest", savedsearches.ToggleDigest).Name("toggle_saved_search_digest")
+					r.Delete("/publication/saved-searches/{id}", savedsearches.Delete).Name("delete_saved_search")
 
 					// publication batch operations
 					r.With(ctx.SetNav("batch")).
@@ -294,6 +347,9 @@ func Register(c Config) {
 					// search
 					r.Get("/publication", publicationsearching.Search).Name("publications")
 
+					// export own publications
+					r.Get("/publication/export.{format}", publicationexporting.ExportBySearch).Name("export_user_publications")
+
 					// import (wizard part 1 - before save)
 					r.Route("/add-publication", func(r *ich.Mux) {
 						r.Get("/", publicationcreating.Add).Name("publication_add")
@@ -427,6 +483,9 @@ func Register(c Config) {
 							r.Get("/message/edit", publicationediting.EditMessage).Name("publication_edit_message")
 							r.Put("/message", publicationediting.UpdateMessage).Name("publication_update_message")
 
+							// comments
+							r.Post("/comments", comments.AddPublicationComment).Name("publication_add_comment")
+
 							// publish
 							r.Get("/publish/confirm", publicationediting.ConfirmPublish).Name("publication_confirm_publish")
 							r.Post("/publish", publicationediting.Publish).Name("publication_publish")
@@ -450,6 +509,19 @@ func Register(c Config) {
 							r.Get("/reviewer-note/edit", publicationediting.EditReviewerNote).Name("publication_edit_reviewer_note")
 							r.Put("/reviewer-note", publicationediting.UpdateReviewerNote).Name("publication_update_reviewer_note")
 
+							// restore an earlier version
+							r.Get("/snapshots/{snapshot_id}/restore/confirm", publicationediting.ConfirmRestore).Name("publication_confirm_restore")
+							r.Post("/snapshots/{snapshot_id}/restore", publicationediting.Restore).Name("publication_restore")
+
+							// merge into another publication
+							r.Get("/merge/confirm", publicationediting.ConfirmMerge).Name("publication_confirm_merge")
+							r.Post("/merge", publicationediting.Merge).Name("publication_merge")
+
+							// review unpublished changes
+							r.Get("/draft/review", publicationediting.ReviewDraft).Name("publication_review_draft")
+							r.Post("/draft/publish", publicationediting.PublishDraft).Name("publication_publish_draft")
+							r.Post("/draft/discard", publicationediting.DiscardDraft).Name("publication_discard_draft")
+
 							// (un)lock publication
 							r.Post("/lock", publicationediting.Lock).Name("publication_lock")
 							r.Post("/unlock", publicationediting.Unlock).Name("publication_unlock")
@@ -535,6 +607,9 @@ func Register(c Config) {
 							r.Get("/message/edit", datasetediting.EditMessage).Name("dataset_edit_message")
 							r.Put("/message", datasetediting.UpdateMessage).Name("dataset_update_message")
 
+							// comments
+							r.Post("/comments", comments.AddDatasetComment).Name("dataset_add_comment")
+
 							// publish
 							r.Get("/publish/confirm", datasetediting.ConfirmPublish).Name("dataset_confirm_publish")
 							r.Post("/publish", datasetediting.Publish).Name("dataset_publish")
@@ -583,6 +658,15 @@ func Register(c Config) {
 							r.Get("/reviewer-note/edit", datasetediting.EditReviewerNote).Name("dataset_edit_reviewer_note")
 							r.Put("/reviewer-note", datasetediting.UpdateReviewerNote).Name("dataset_update_reviewer_note")
 
+							// restore an earlier version
+							r.Get("/snapshots/{snapshot_id}/restore/confirm", datasetediting.ConfirmRestore).Name("dataset_confirm_restore")
+							r.Post("/snapshots/{snapshot_id}/restore", datasetediting.Restore).Name("dataset_restore")
+
+							// review unpublished changes
+							r.Get("/draft/review", datasetediting.ReviewDraft).Name("dataset_review_draft")
+							r.Post("/draft/publish", datasetediting.PublishDraft).Name("dataset_publish_draft")
+							r.Post("/draft/discard", datasetediting.DiscardDraft).Name("dataset_discard_draft")
+
 							// (un)lock dataset
 							r.Post("/lock", datasetediting.Lock).Name("dataset_lock")
 							r.Post("/unlock", datasetediting.Unlock).Name("dataset_unlock")
@@ -56,7 +56,7 @@ func (s *server) GetDataset(ctx context.Context, req *api.GetDatasetRequest) (*a
 func (s *server) GetAllDatasets(req *api.GetAllDatasetsRequest, stream api.Biblio_GetAllDatasetsServer) (err error) {
 	var callbackErr error
 
-	streamErr := s.services.Repo.EachDataset(func(d *models.Dataset) bool {
+	fn := func(d *models.Dataset) bool {
 		j, err := json.Marshal(d)
 		if err != nil {
 			grpcError := status.New(codes.Internal, fmt.Errorf("could not marshal dataset with id %s: %v", d.ID, err).Error())
@@ -89,7 +89,18 @@ func (s *server) GetAllDatasets(req *api.GetAllDatasetsRequest, stream api.Bibli
 		}
 
 		return true
-	})
+	}
+
+	var streamErr error
+	if req.At != "" {
+		at, err := time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "could not parse timestamp %s: %v", req.At, err)
+		}
+		streamErr = s.services.Repo.EachDatasetAt(at, fn)
+	} else {
+		streamErr = s.services.Repo.EachDataset(fn)
+	}
 
 	if streamErr != nil {
 		return status.Errorf(codes.Internal, "could not get all datasets: %v", streamErr)
@@ -391,6 +402,189 @@ func (s *server) GetDatasetHistory(req *api.GetDatasetHistoryRequest, stream api
 	return nil
 }
 
+func (s *server) GetDatasetChanges(req *api.GetDatasetChangesRequest, stream api.Biblio_GetDatasetChangesServer) error {
+	entries, err := s.services.Repo.DatasetChanges(stream.Context(), req.Id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get dataset changes: %v", err)
+	}
+
+	for _, e := range entries {
+		j, err := json.Marshal(e)
+		if err != nil {
+			grpcErr := status.New(codes.Internal, fmt.Errorf("could not marshal history entry: %s", err).Error())
+			if err = stream.Send(&api.GetDatasetChangesResponse{
+				Response: &api.GetDatasetChangesResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "could not get dataset changes: %v", err)
+			}
+			continue
+		}
+
+		if err = stream.Send(&api.GetDatasetChangesResponse{
+			Response: &api.GetDatasetChangesResponse_Entry{
+				Entry: &api.HistoryEntry{
+					Payload: j,
+				},
+			},
+		}); err != nil {
+			return status.Errorf(codes.Internal, "could not get dataset changes: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RestoreDataset(ctx context.Context, req *api.RestoreDatasetRequest) (*api.RestoreDatasetResponse, error) {
+	d, err := s.services.Repo.RestoreDataset(ctx, req.Id, req.SnapshotId, req.Reason, nil)
+
+	var validationErrs *okay.Errors
+	var conflict *snapstore.Conflict
+	var grpcErr *status.Status
+	switch {
+	case errors.Is(err, repositories.ErrEmptyReason):
+		grpcErr = status.New(codes.InvalidArgument, "reason can't be empty")
+	case errors.Is(err, repositories.ErrSnapshotIsCurrent):
+		grpcErr = status.New(codes.FailedPrecondition, fmt.Errorf("snapshot %s has the same content as the current version of dataset %s", req.SnapshotId, req.Id).Error())
+	case errors.Is(err, models.ErrNotFound):
+		grpcErr = status.New(codes.NotFound, fmt.Errorf("could not find dataset with id %s and snapshot id %s", req.Id, req.SnapshotId).Error())
+	case errors.As(err, &validationErrs):
+		grpcErr = status.New(codes.InvalidArgument, fmt.Errorf("failed to validate dataset %s: %v", req.Id, validationErrs).Error())
+	case errors.As(err, &conflict):
+		grpcErr = status.New(codes.Aborted, fmt.Errorf("failed to restore dataset: conflict detected for dataset %s: %v", req.Id, err).Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "could not restore dataset with id %s to snapshot %s: %s", req.Id, req.SnapshotId, err)
+	}
+	if grpcErr != nil {
+		return &api.RestoreDatasetResponse{
+			Response: &api.RestoreDatasetResponse_Error{
+				Error: grpcErr.Proto(),
+			},
+		}, nil
+	}
+
+	j, err := json.Marshal(d)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not marshal dataset with id %s: %v", d.ID, err)
+	}
+
+	return &api.RestoreDatasetResponse{
+		Response: &api.RestoreDatasetResponse_Dataset{
+			Dataset: &api.Dataset{
+				Payload: j,
+			},
+		},
+	}, nil
+}
+
+func (s *server) GetDatasetComments(req *api.GetDatasetCommentsRequest, stream api.Biblio_GetDatasetCommentsServer) error {
+	if _, err := s.services.Repo.GetDataset(req.Id); err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			grpcErr := status.New(codes.NotFound, fmt.Errorf("could not find dataset with id %s", req.Id).Error())
+			if err = stream.Send(&api.GetDatasetCommentsResponse{
+				Response: &api.GetDatasetCommentsResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "could not get dataset comments: %v", err)
+			}
+			return nil
+		}
+		return status.Errorf(codes.Internal, "could not get dataset comments: %v", err)
+	}
+
+	comments, err := s.services.Repo.GetComments(stream.Context(), "dataset", req.Id, true)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get dataset comments: %v", err)
+	}
+
+	for _, c := range comments {
+		j, err := json.Marshal(c)
+		if err != nil {
+			grpcErr := status.New(codes.Internal, fmt.Errorf("could not marshal comment: %s", err).Error())
+			if err = stream.Send(&api.GetDatasetCommentsResponse{
+				Response: &api.GetDatasetCommentsResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "could not get dataset comments: %v", err)
+			}
+			continue
+		}
+
+		if err = stream.Send(&api.GetDatasetCommentsResponse{
+			Response: &api.GetDatasetCommentsResponse_Comment{
+				Comment: &api.Comment{
+					Payload: j,
+				},
+			},
+		}); err != nil {
+			return status.Errorf(codes.Internal, "could not get dataset comments: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) AddDatasetComment(ctx context.Context, req *api.AddDatasetCommentRequest) (*api.AddDatasetCommentResponse, error) {
+	var grpcErr *status.Status
+
+	_, err := s.services.Repo.GetDataset(req.Id)
+	if errors.Is(err, models.ErrNotFound) {
+		grpcErr = status.New(codes.NotFound, fmt.Errorf("could not find dataset with id %s", req.Id).Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get dataset with id %s: %s", req.Id, err)
+	}
+
+	c := &models.Comment{
+		RecordType: "dataset",
+		RecordID:   req.Id,
+		ParentID:   req.ParentId,
+		UserID:     req.UserId,
+		Body:       strings.TrimSpace(req.Body),
+		Visibility: req.Visibility,
+	}
+	if c.Visibility == "" {
+		c.Visibility = models.CommentShared
+	}
+
+	if grpcErr == nil {
+		err = s.services.Repo.AddComment(ctx, c)
+
+		var validationErrs *okay.Errors
+		switch {
+		case errors.As(err, &validationErrs):
+			grpcErr = status.New(codes.InvalidArgument, fmt.Errorf("failed to validate comment: %v", validationErrs).Error())
+		case errors.Is(err, models.ErrNotFound):
+			grpcErr = status.New(codes.NotFound, fmt.Errorf("could not find comment %s on dataset %s", req.ParentId, req.Id).Error())
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "could not add comment to dataset with id %s: %s", req.Id, err)
+		}
+	}
+
+	if grpcErr != nil {
+		return &api.AddDatasetCommentResponse{
+			Response: &api.AddDatasetCommentResponse_Error{
+				Error: grpcErr.Proto(),
+			},
+		}, nil
+	}
+
+	j, err := json.Marshal(c)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not marshal comment: %v", err)
+	}
+
+	return &api.AddDatasetCommentResponse{
+		Response: &api.AddDatasetCommentResponse_Comment{
+			Comment: &api.Comment{
+				Payload: j,
+			},
+		},
+	}, nil
+}
+
 func (s *server) PurgeDataset(ctx context.Context, req *api.PurgeDatasetRequest) (*api.PurgeDatasetResponse, error) {
 	_, err := s.services.Repo.GetDataset(req.Id)
 
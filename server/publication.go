@@ -13,6 +13,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	api "github.com/ugent-library/biblio-backoffice/api/v1"
 	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/duplicates"
 	"github.com/ugent-library/biblio-backoffice/models"
 	"github.com/ugent-library/biblio-backoffice/repositories"
 	"github.com/ugent-library/biblio-backoffice/snapstore"
@@ -56,7 +57,7 @@ func (s *server) GetPublication(ctx context.Context, req *api.GetPublicationRequ
 func (s *server) GetAllPublications(req *api.GetAllPublicationsRequest, stream api.Biblio_GetAllPublicationsServer) (err error) {
 	var callbackErr error
 
-	streamErr := s.services.Repo.EachPublication(func(p *models.Publication) bool {
+	fn := func(p *models.Publication) bool {
 		j, err := json.Marshal(p)
 		if err != nil {
 			grpcError := status.New(codes.Internal, fmt.Errorf("could not marshal publication with id %s: %v", p.ID, err).Error())
@@ -89,7 +90,18 @@ func (s *server) GetAllPublications(req *api.GetAllPublicationsRequest, stream a
 		}
 
 		return true
-	})
+	}
+
+	var streamErr error
+	if req.At != "" {
+		at, err := time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "could not parse timestamp %s: %v", req.At, err)
+		}
+		streamErr = s.services.Repo.EachPublicationAt(at, fn)
+	} else {
+		streamErr = s.services.Repo.EachPublication(fn)
+	}
 
 	if streamErr != nil {
 		return status.Errorf(codes.Internal, "could not get all publications: %v", streamErr)
@@ -238,6 +250,28 @@ func (s *server) AddPublications(stream api.Biblio_AddPublicationsServer) error
 			continue
 		}
 
+		if req.CheckDuplicates {
+			matches, err := duplicates.NewDetector(duplicates.Scope(s.services.PublicationSearchIndex, true)).Find(p)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to check duplicates: %s", err)
+			}
+			if len(matches) > 0 {
+				dups := make([]string, len(matches))
+				for i, m := range matches {
+					dups[i] = fmt.Sprintf("%s (%s)", m.Publication.ID, strings.Join(m.Reasons, ", "))
+				}
+				grpcErr := status.New(codes.AlreadyExists, fmt.Errorf("publication %s at line %d has possible duplicates: %s", p.ID, seq, strings.Join(dups, ", ")).Error())
+				if err = stream.Send(&api.AddPublicationsResponse{
+					Response: &api.AddPublicationsResponse_Error{
+						Error: grpcErr.Proto(),
+					},
+				}); err != nil {
+					return status.Errorf(codes.Internal, "failed to add publications: %v", err)
+				}
+				continue
+			}
+		}
+
 		if err := s.services.Repo.SavePublication(p, nil); err != nil {
 			grpcErr := status.New(codes.InvalidArgument, fmt.Errorf("failed to store publication %s at line %d: %s", p.ID, seq, err).Error())
 			if err = stream.Send(&api.AddPublicationsResponse{
@@ -404,6 +438,40 @@ func (s *server) GetPublicationHistory(req *api.GetPublicationHistoryRequest, st
 	return nil
 }
 
+func (s *server) GetPublicationChanges(req *api.GetPublicationChangesRequest, stream api.Biblio_GetPublicationChangesServer) error {
+	entries, err := s.services.Repo.PublicationChanges(stream.Context(), req.Id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get publication changes: %v", err)
+	}
+
+	for _, e := range entries {
+		j, err := json.Marshal(e)
+		if err != nil {
+			grpcErr := status.New(codes.Internal, fmt.Errorf("could not marshal history entry: %s", err).Error())
+			if err = stream.Send(&api.GetPublicationChangesResponse{
+				Response: &api.GetPublicationChangesResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "could not get publication changes: %v", err)
+			}
+			continue
+		}
+
+		if err = stream.Send(&api.GetPublicationChangesResponse{
+			Response: &api.GetPublicationChangesResponse_Entry{
+				Entry: &api.HistoryEntry{
+					Payload: j,
+				},
+			},
+		}); err != nil {
+			return status.Errorf(codes.Internal, "could not get publication changes: %v", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *server) PurgePublication(ctx context.Context, req *api.PurgePublicationRequest) (*api.PurgePublicationResponse, error) {
 	_, err := s.services.Repo.GetPublication(req.Id)
 
@@ -462,6 +530,215 @@ func (s *server) PurgeAllPublications(ctx context.Context, req *api.PurgeAllPubl
 	}, nil
 }
 
+func (s *server) MergePublications(ctx context.Context, req *api.MergePublicationsRequest) (*api.MergePublicationsResponse, error) {
+	if req.SurvivorId == req.MergedId {
+		grpcErr := status.New(codes.InvalidArgument, fmt.Errorf("can't merge publication with id %s into itself", req.MergedId).Error())
+		return &api.MergePublicationsResponse{
+			Response: &api.MergePublicationsResponse_Error{
+				Error: grpcErr.Proto(),
+			},
+		}, nil
+	}
+
+	for _, id := range []string{req.SurvivorId, req.MergedId} {
+		p, err := s.services.Repo.GetPublication(id)
+		if errors.Is(err, models.ErrNotFound) {
+			grpcErr := status.New(codes.NotFound, fmt.Errorf("could not find publication with id %s", id).Error())
+			return &api.MergePublicationsResponse{
+				Response: &api.MergePublicationsResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}, nil
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not get publication with id %s: %s", id, err)
+		}
+		if p.Status == "deleted" {
+			grpcErr := status.New(codes.FailedPrecondition, fmt.Errorf("publication with id %s is deleted", id).Error())
+			return &api.MergePublicationsResponse{
+				Response: &api.MergePublicationsResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}, nil
+		}
+	}
+
+	p, err := s.services.Repo.MergePublications(ctx, req.SurvivorId, req.MergedId, nil)
+	if errors.Is(err, repositories.ErrMergeLocked) || errors.Is(err, repositories.ErrMergeHasDraft) {
+		grpcErr := status.New(codes.FailedPrecondition, err.Error())
+		return &api.MergePublicationsResponse{
+			Response: &api.MergePublicationsResponse_Error{
+				Error: grpcErr.Proto(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not merge publication with id %s into %s: %s", req.MergedId, req.SurvivorId, err)
+	}
+
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not marshal publication with id %s: %v", p.ID, err)
+	}
+
+	return &api.MergePublicationsResponse{
+		Response: &api.MergePublicationsResponse_Publication{
+			Publication: &api.Publication{
+				Payload: j,
+			},
+		},
+	}, nil
+}
+
+func (s *server) RestorePublication(ctx context.Context, req *api.RestorePublicationRequest) (*api.RestorePublicationResponse, error) {
+	p, err := s.services.Repo.RestorePublication(ctx, req.Id, req.SnapshotId, req.Reason, nil)
+
+	var validationErrs *okay.Errors
+	var conflict *snapstore.Conflict
+	var grpcErr *status.Status
+	switch {
+	case errors.Is(err, repositories.ErrEmptyReason):
+		grpcErr = status.New(codes.InvalidArgument, "reason can't be empty")
+	case errors.Is(err, repositories.ErrSnapshotIsCurrent):
+		grpcErr = status.New(codes.FailedPrecondition, fmt.Errorf("snapshot %s has the same content as the current version of publication %s", req.SnapshotId, req.Id).Error())
+	case errors.Is(err, models.ErrNotFound):
+		grpcErr = status.New(codes.NotFound, fmt.Errorf("could not find publication with id %s and snapshot id %s", req.Id, req.SnapshotId).Error())
+	case errors.As(err, &validationErrs):
+		grpcErr = status.New(codes.InvalidArgument, fmt.Errorf("failed to validate publication %s: %v", req.Id, validationErrs).Error())
+	case errors.As(err, &conflict):
+		grpcErr = status.New(codes.Aborted, fmt.Errorf("failed to restore publication: conflict detected for publication %s: %v", req.Id, err).Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "could not restore publication with id %s to snapshot %s: %s", req.Id, req.SnapshotId, err)
+	}
+	if grpcErr != nil {
+		return &api.RestorePublicationResponse{
+			Response: &api.RestorePublicationResponse_Error{
+				Error: grpcErr.Proto(),
+			},
+		}, nil
+	}
+
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not marshal publication with id %s: %v", p.ID, err)
+	}
+
+	return &api.RestorePublicationResponse{
+		Response: &api.RestorePublicationResponse_Publication{
+			Publication: &api.Publication{
+				Payload: j,
+			},
+		},
+	}, nil
+}
+
+func (s *server) GetPublicationComments(req *api.GetPublicationCommentsRequest, stream api.Biblio_GetPublicationCommentsServer) error {
+	if _, err := s.services.Repo.GetPublication(req.Id); err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			grpcErr := status.New(codes.NotFound, fmt.Errorf("could not find publication with id %s", req.Id).Error())
+			if err = stream.Send(&api.GetPublicationCommentsResponse{
+				Response: &api.GetPublicationCommentsResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "could not get publication comments: %v", err)
+			}
+			return nil
+		}
+		return status.Errorf(codes.Internal, "could not get publication comments: %v", err)
+	}
+
+	comments, err := s.services.Repo.GetComments(stream.Context(), "publication", req.Id, true)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get publication comments: %v", err)
+	}
+
+	for _, c := range comments {
+		j, err := json.Marshal(c)
+		if err != nil {
+			grpcErr := status.New(codes.Internal, fmt.Errorf("could not marshal comment: %s", err).Error())
+			if err = stream.Send(&api.GetPublicationCommentsResponse{
+				Response: &api.GetPublicationCommentsResponse_Error{
+					Error: grpcErr.Proto(),
+				},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "could not get publication comments: %v", err)
+			}
+			continue
+		}
+
+		if err = stream.Send(&api.GetPublicationCommentsResponse{
+			Response: &api.GetPublicationCommentsResponse_Comment{
+				Comment: &api.Comment{
+					Payload: j,
+				},
+			},
+		}); err != nil {
+			return status.Errorf(codes.Internal, "could not get publication comments: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) AddPublicationComment(ctx context.Context, req *api.AddPublicationCommentRequest) (*api.AddPublicationCommentResponse, error) {
+	var grpcErr *status.Status
+
+	_, err := s.services.Repo.GetPublication(req.Id)
+	if errors.Is(err, models.ErrNotFound) {
+		grpcErr = status.New(codes.NotFound, fmt.Errorf("could not find publication with id %s", req.Id).Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get publication with id %s: %s", req.Id, err)
+	}
+
+	c := &models.Comment{
+		RecordType: "publication",
+		RecordID:   req.Id,
+		ParentID:   req.ParentId,
+		UserID:     req.UserId,
+		Body:       strings.TrimSpace(req.Body),
+		Visibility: req.Visibility,
+	}
+	if c.Visibility == "" {
+		c.Visibility = models.CommentShared
+	}
+
+	if grpcErr == nil {
+		err = s.services.Repo.AddComment(ctx, c)
+
+		var validationErrs *okay.Errors
+		switch {
+		case errors.As(err, &validationErrs):
+			grpcErr = status.New(codes.InvalidArgument, fmt.Errorf("failed to validate comment: %v", validationErrs).Error())
+		case errors.Is(err, models.ErrNotFound):
+			grpcErr = status.New(codes.NotFound, fmt.Errorf("could not find comment %s on publication %s", req.ParentId, req.Id).Error())
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "could not add comment to publication with id %s: %s", req.Id, err)
+		}
+	}
+
+	if grpcErr != nil {
+		return &api.AddPublicationCommentResponse{
+			Response: &api.AddPublicationCommentResponse_Error{
+				Error: grpcErr.Proto(),
+			},
+		}, nil
+	}
+
+	j, err := json.Marshal(c)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not marshal comment: %v", err)
+	}
+
+	return &api.AddPublicationCommentResponse{
+		Response: &api.AddPublicationCommentResponse_Comment{
+			Comment: &api.Comment{
+				Payload: j,
+			},
+		},
+	}, nil
+}
+
 func (s *server) ValidatePublications(stream api.Biblio_ValidatePublicationsServer) error {
 	var seq int32
 
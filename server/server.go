@@ -18,38 +18,50 @@ type server struct {
 	services *backends.Services
 }
 
+// listPermissions returns the roles that may call each method. API accounts
+// are not people, so adding a comment in the name of a user is an admin only
+// operation.
 func listPermissions() map[string][]string {
 	const biblioServicePath = "/biblio.v1.Biblio/"
 
 	return map[string][]string{
-		biblioServicePath + "AddDatasets":           {"admin"},
-		biblioServicePath + "AddFile":               {"admin"},
-		biblioServicePath + "AddPublications":       {"admin"},
-		biblioServicePath + "CleanupPublications":   {"admin"},
-		biblioServicePath + "ExistsFile":            {"admin", "curator"},
-		biblioServicePath + "GetAllDatasets":        {"admin", "curator"},
-		biblioServicePath + "GetAllPublications":    {"admin", "curator"},
-		biblioServicePath + "GetDataset":            {"admin", "curator"},
-		biblioServicePath + "GetDatasetHistory":     {"admin", "curator"},
-		biblioServicePath + "GetFile":               {"admin", "curator"},
-		biblioServicePath + "GetPublication":        {"admin", "curator"},
-		biblioServicePath + "GetPublicationHistory": {"admin", "curator"},
-		biblioServicePath + "ImportDatasets":        {"admin"},
-		biblioServicePath + "ImportPublications":    {"admin"},
-		biblioServicePath + "PurgeAllDatasets":      {"admin"},
-		biblioServicePath + "PurgeAllPublications":  {"admin"},
-		biblioServicePath + "PurgeDataset":          {"admin"},
-		biblioServicePath + "PurgePublication":      {"admin"},
-		biblioServicePath + "ReindexDatasets":       {"admin"},
-		biblioServicePath + "ReindexPublications":   {"admin"},
-		biblioServicePath + "Relate":                {"admin"},
-		biblioServicePath + "SearchDatasets":        {"admin", "curator"},
-		biblioServicePath + "SearchPublications":    {"admin", "curator"},
-		biblioServicePath + "TransferPublications":  {"admin"},
-		biblioServicePath + "UpdateDataset":         {"admin"},
-		biblioServicePath + "UpdatePublication":     {"admin"},
-		biblioServicePath + "ValidateDatasets":      {"admin", "curator"},
-		biblioServicePath + "ValidatePublications":  {"admin", "curator"},
+		biblioServicePath + "AddDatasetComment":      {"admin"},
+		biblioServicePath + "AddDatasets":            {"admin"},
+		biblioServicePath + "AddFile":                {"admin"},
+		biblioServicePath + "AddPublicationComment":  {"admin"},
+		biblioServicePath + "AddPublications":        {"admin"},
+		biblioServicePath + "CleanupPublications":    {"admin"},
+		biblioServicePath + "ExistsFile":             {"admin", "curator"},
+		biblioServicePath + "GetAllDatasets":         {"admin", "curator"},
+		biblioServicePath + "GetAllPublications":     {"admin", "curator"},
+		biblioServicePath + "GetDataset":             {"admin", "curator"},
+		biblioServicePath + "GetDatasetChanges":      {"admin", "curator"},
+		biblioServicePath + "GetDatasetComments":     {"admin", "curator"},
+		biblioServicePath + "GetDatasetHistory":      {"admin", "curator"},
+		biblioServicePath + "GetFile":                {"admin", "curator"},
+		biblioServicePath + "GetPublication":         {"admin", "curator"},
+		biblioServicePath + "GetPublicationChanges":  {"admin", "curator"},
+		biblioServicePath + "GetPublicationComments": {"admin", "curator"},
+		biblioServicePath + "GetPublicationHistory":  {"admin", "curator"},
+		biblioServicePath + "ImportDatasets":         {"admin"},
+		biblioServicePath + "ImportPublications":     {"admin"},
+		biblioServicePath + "MergePublications":      {"admin"},
+		biblioServicePath + "PurgeAllDatasets":       {"admin"},
+		biblioServicePath + "PurgeAllPublications":   {"admin"},
+		biblioServicePath + "PurgeDataset":           {"admin"},
+		biblioServicePath + "PurgePublication":       {"admin"},
+		biblioServicePath + "ReindexDatasets":        {"admin"},
+		biblioServicePath + "ReindexPublications":    {"admin"},
+		biblioServicePath + "Relate":                 {"admin"},
+		biblioServicePath + "RestoreDataset":         {"admin"},
+		biblioServicePath + "RestorePublication":     {"admin"},
+		biblioServicePath + "SearchDatasets":         {"admin", "curator"},
+		biblioServicePath + "SearchPublications":     {"admin", "curator"},
+		biblioServicePath + "TransferPublications":   {"admin"},
+		biblioServicePath + "UpdateDataset":          {"admin"},
+		biblioServicePath + "UpdatePublication":      {"admin"},
+		biblioServicePath + "ValidateDatasets":       {"admin", "curator"},
+		biblioServicePath + "ValidatePublications":   {"admin", "curator"},
 	}
 }
 
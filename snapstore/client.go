@@ -3,12 +3,9 @@ package snapstore
 // IDEAS:
 // - snapshots table with type column
 // - versioning strategies: update in place unless user changed, status changed, abort if no changes, …
-// - compaction method
 // - introduce internal and external relations
 // - add a method to get all snapshots for a given id
-// - add a method to view a store at a given date
 // - add date_created column to the table
-// - draft versions with affinity_id: https://github.com/ugent-library/biblio-backoffice/commit/419b5ccd5de83b1010a2b629d72a526d2e33ae67
 // - improve the api:
 // tx := client.BeginTx(ctx)
 // tx.Store("publications").Add(ctx, id, &models.Publication{})
@@ -74,6 +71,11 @@ type Transaction struct {
 	db DB
 }
 
+// DB returns the transaction so that other queries can take part in it
+func (t *Transaction) DB() DB {
+	return t.db
+}
+
 type Options struct {
 	Context     context.Context
 	Transaction *Transaction
@@ -324,6 +326,21 @@ func (s *Store) GetCurrentSnapshot(id string, o Options) (*Snapshot, error) {
 	return &snap, nil
 }
 
+func (s *Store) GetSnapshot(id, snapshotID string, o Options) (*Snapshot, error) {
+	ctx, db := s.ctxAndDb(o)
+
+	sql := `SELECT snapshot_id, data, date_from, date_until FROM ` + s.table + `
+	WHERE id = $1 AND snapshot_id = $2`
+
+	snap := Snapshot{ID: id}
+
+	if err := db.QueryRow(ctx, sql, id, snapshotID).Scan(&snap.SnapshotID, &snap.Data, &snap.DateFrom, &snap.DateUntil); err != nil {
+		return nil, err
+	}
+
+	return &snap, nil
+}
+
 func (s *Store) GetSnapshotBefore(id string, dateFrom time.Time, o Options) (*Snapshot, error) {
 	ctx, db := s.ctxAndDb(o)
 
@@ -369,14 +386,30 @@ func (s *Store) GetAll(o Options) (*Cursor, error) {
 	return &Cursor{rows}, nil
 }
 
+// GetAllAt returns the snapshots that were current at time t
+func (s *Store) GetAllAt(t time.Time, o Options) (*Cursor, error) {
+	ctx, db := s.ctxAndDb(o)
+
+	sql := "SELECT snapshot_id, id, data, date_from, date_until FROM " + s.table +
+		" WHERE date_from <= $1 AND (date_until IS NULL OR date_until > $1)"
+
+	rows, err := db.Query(ctx, sql, t)
+	if err != nil {
+		return nil, err
+	}
+	return &Cursor{rows}, nil
+}
+
 func (s *Store) Purge(id string, o Options) error {
 	ctx, db := s.ctxAndDb(o)
 
 	sql := "DELETE FROM " + s.table + " WHERE id = $1"
 
-	_, err := db.Exec(ctx, sql, id)
+	if _, err := db.Exec(ctx, sql, id); err != nil {
+		return err
+	}
 
-	return err
+	return s.DeleteDraft(id, o)
 }
 
 func (s *Store) PurgeAll(o Options) error {
@@ -384,7 +417,11 @@ func (s *Store) PurgeAll(o Options) error {
 
 	sql := "TRUNCATE " + s.table
 
-	_, err := db.Exec(ctx, sql)
+	if _, err := db.Exec(ctx, sql); err != nil {
+		return err
+	}
+
+	_, err := db.Exec(ctx, "DELETE FROM snapshot_drafts WHERE store = $1", s.name)
 
 	return err
 }
@@ -407,6 +444,16 @@ func (s *Store) ctxAndDb(o Options) (context.Context, DB) {
 	return ctx, db
 }
 
+// ExecSql executes sql in the transaction of o, if any. Use it to keep
+// related tables in sync with the store.
+func (s *Store) ExecSql(sql string, values []any, o Options) error {
+	ctx, db := s.ctxAndDb(o)
+
+	_, err := db.Exec(ctx, sql, values...)
+
+	return err
+}
+
 func (s *Store) Select(sql string, values []any, options Options) (*Cursor, error) {
 	var (
 		ctx context.Context
@@ -0,0 +1,215 @@
+package snapstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// CompactionPolicy decides which snapshots Compact removes. The first and the
+// current snapshot of a record are always kept, and so is the snapshot before
+// the current one. A removed snapshot is absorbed by its successor, whose
+// date_from is moved back. The current snapshot never absorbs one, its
+// date_from keeps telling when the record last changed. A point in time lookup in
+// the period of a removed snapshot returns the newer content, which is the
+// content that survived the edits in that period.
+type CompactionPolicy struct {
+	// Window collapses consecutive snapshots by the same user that are less
+	// than Window apart into the last one. Zero disables collapsing.
+	Window time.Duration
+	// snapshots that were replaced longer than RetainFor ago are thinned out
+	// to the last snapshot in each RetainWindow, regardless of the user. Zero
+	// disables thinning.
+	RetainFor    time.Duration
+	RetainWindow time.Duration
+	// UserField is the data field that holds the user who made the snapshot
+	UserField string
+	// a snapshot that changes one of the KeepFields, or whose successor
+	// does, is always kept
+	KeepFields []string
+	// Keep can protect individual snapshots from removal
+	Keep func(*Snapshot) bool
+	// DryRun only reports what would be removed
+	DryRun bool
+}
+
+type CompactionReport struct {
+	Records   int
+	Snapshots int
+	Removed   int
+}
+
+// Compact applies the compaction policy to all records with more than one
+// snapshot. Each record is compacted in its own transaction.
+func (s *Store) Compact(p CompactionPolicy, o Options) (*CompactionReport, error) {
+	ctx, db := s.ctxAndDb(o)
+
+	sql := "SELECT id FROM " + s.table + " GROUP BY id HAVING count(*) > 1"
+
+	rows, err := db.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &CompactionReport{}
+	now := time.Now()
+
+	for _, id := range ids {
+		snaps, err := s.history(id, o)
+		if err != nil {
+			return nil, err
+		}
+
+		removed, err := compactable(snaps, p, now)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Records++
+		report.Snapshots += len(snaps)
+		report.Removed += len(removed)
+
+		if p.DryRun || len(removed) == 0 {
+			continue
+		}
+
+		if err := s.removeSnapshots(snaps, removed, o); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// history returns the snapshots of a record, oldest first
+func (s *Store) history(id string, o Options) ([]*Snapshot, error) {
+	ctx, db := s.ctxAndDb(o)
+
+	sql := "SELECT snapshot_id, id, data, date_from, date_until FROM " + s.table +
+		" WHERE id = $1 ORDER BY date_from ASC"
+
+	rows, err := db.Query(ctx, sql, id)
+	if err != nil {
+		return nil, err
+	}
+	c := &Cursor{rows}
+	defer c.Close()
+
+	var snaps []*Snapshot
+	for c.HasNext() {
+		snap, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+	}
+
+	return snaps, c.Err()
+}
+
+// removeSnapshots deletes the removed snapshots and lets the next kept
+// snapshot cover their period
+func (s *Store) removeSnapshots(snaps []*Snapshot, removed map[int]bool, o Options) error {
+	ctx, db := s.ctxAndDb(o)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	sqlDelete := "DELETE FROM " + s.table + " WHERE snapshot_id = $1"
+	sqlUpdate := "UPDATE " + s.table + " SET date_from = $1 WHERE snapshot_id = $2"
+
+	// the current snapshot and its predecessor are never removed
+	next := snaps[len(snaps)-2]
+	for i := len(snaps) - 3; i > 0; i-- {
+		snap := snaps[i]
+		if !removed[i] {
+			next = snap
+			continue
+		}
+		if _, err := tx.Exec(ctx, sqlDelete, snap.SnapshotID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, sqlUpdate, snap.DateFrom, next.SnapshotID); err != nil {
+			return err
+		}
+		next.DateFrom = snap.DateFrom
+	}
+
+	return tx.Commit(ctx)
+}
+
+// compactable returns the indexes of the snapshots, oldest first, that the
+// policy removes
+func compactable(snaps []*Snapshot, p CompactionPolicy, now time.Time) (map[int]bool, error) {
+	removed := map[int]bool{}
+
+	if len(snaps) < 4 {
+		return removed, nil
+	}
+
+	fields := make([]map[string]json.RawMessage, len(snaps))
+	for i, snap := range snaps {
+		if err := json.Unmarshal(snap.Data, &fields[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// index of the last kept snapshot
+	prev := 0
+	for i := 1; i < len(snaps)-2; i++ {
+		snap, next := snaps[i], snaps[i+1]
+
+		if p.Keep != nil && p.Keep(snap) {
+			prev = i
+			continue
+		}
+
+		// the successor takes over the period of a removed snapshot, so
+		// it can't differ in a keep field either
+		changesKeepField := false
+		for _, f := range p.KeepFields {
+			if !bytes.Equal(fields[prev][f], fields[i][f]) || !bytes.Equal(fields[i][f], fields[i+1][f]) {
+				changesKeepField = true
+				break
+			}
+		}
+		if changesKeepField {
+			prev = i
+			continue
+		}
+
+		gap := next.DateFrom.Sub(*snap.DateFrom)
+
+		sameUser := p.UserField != "" && bytes.Equal(fields[i][p.UserField], fields[i+1][p.UserField])
+		if p.Window > 0 && sameUser && gap < p.Window {
+			removed[i] = true
+			continue
+		}
+
+		if p.RetainFor > 0 && p.RetainWindow > 0 && snap.DateUntil.Before(now.Add(-p.RetainFor)) &&
+			snap.DateFrom.Truncate(p.RetainWindow).Equal(next.DateFrom.Truncate(p.RetainWindow)) {
+			removed[i] = true
+			continue
+		}
+
+		prev = i
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,190 @@
+package snapstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+var t0 = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type testEdit struct {
+	user   string
+	status string
+	at     time.Duration
+}
+
+func testSnapshots(edits ...testEdit) []*Snapshot {
+	snaps := make([]*Snapshot, len(edits))
+	for i, e := range edits {
+		data, _ := json.Marshal(map[string]string{"user_id": e.user, "status": e.status})
+		from := t0.Add(e.at)
+		snaps[i] = &Snapshot{SnapshotID: fmt.Sprintf("s%d", i), ID: "1", Data: data, DateFrom: &from}
+		if i > 0 {
+			snaps[i-1].DateUntil = &from
+		}
+	}
+	return snaps
+}
+
+func removedIDs(snaps []*Snapshot, removed map[int]bool) []string {
+	ids := []string{}
+	for i, snap := range snaps {
+		if removed[i] {
+			ids = append(ids, snap.SnapshotID)
+		}
+	}
+	return ids
+}
+
+func TestCompactableWindow(t *testing.T) {
+	snaps := testSnapshots(
+		testEdit{"alice", "private", 0},
+		testEdit{"alice", "private", 1 * time.Minute},
+		testEdit{"alice", "private", 2 * time.Minute},
+		testEdit{"alice", "private", 3 * time.Minute},
+		testEdit{"bob", "private", 4 * time.Minute},
+		testEdit{"bob", "private", time.Hour},
+	)
+	p := CompactionPolicy{Window: 10 * time.Minute, UserField: "user_id"}
+
+	removed, err := compactable(snaps, p, t0.Add(2*time.Hour))
+	require.NoError(t, err)
+	// the first snapshot, the last edit of each burst and the current
+	// snapshot are kept
+	require.Equal(t, []string{"s1", "s2"}, removedIDs(snaps, removed))
+}
+
+func TestCompactableKeepsStatusChanges(t *testing.T) {
+	snaps := testSnapshots(
+		testEdit{"alice", "private", 0},
+		testEdit{"alice", "public", 1 * time.Minute},
+		testEdit{"alice", "public", 2 * time.Minute},
+		testEdit{"alice", "public", 3 * time.Minute},
+		testEdit{"bob", "public", time.Hour},
+	)
+	p := CompactionPolicy{Window: 10 * time.Minute, UserField: "user_id", KeepFields: []string{"status"}}
+
+	removed, err := compactable(snaps, p, t0.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{"s2"}, removedIDs(snaps, removed))
+
+	p.Keep = func(snap *Snapshot) bool { return snap.SnapshotID == "s2" }
+	removed, err = compactable(snaps, p, t0.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, removedIDs(snaps, removed))
+	p.Keep = nil
+
+	// a snapshot can't be absorbed by a successor with another status
+	snaps = testSnapshots(
+		testEdit{"alice", "private", 0},
+		testEdit{"alice", "private", 1 * time.Minute},
+		testEdit{"alice", "public", 2 * time.Minute},
+		testEdit{"alice", "public", 3 * time.Minute},
+		testEdit{"bob", "public", time.Hour},
+	)
+	removed, err = compactable(snaps, p, t0.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, removedIDs(snaps, removed))
+}
+
+func TestCompactableKeepsCurrentDateFrom(t *testing.T) {
+	snaps := testSnapshots(
+		testEdit{"alice", "private", 0},
+		testEdit{"alice", "private", 1 * time.Minute},
+		testEdit{"alice", "private", 2 * time.Minute},
+	)
+	p := CompactionPolicy{Window: 10 * time.Minute, UserField: "user_id"}
+
+	// removing s1 would move the date_from of the current snapshot back
+	removed, err := compactable(snaps, p, t0.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, removedIDs(snaps, removed))
+}
+
+func TestCompactableRetention(t *testing.T) {
+	day := 24 * time.Hour
+	snaps := testSnapshots(
+		testEdit{"alice", "private", 0},
+		testEdit{"bob", "private", 1 * time.Hour},
+		testEdit{"alice", "private", 2 * time.Hour},
+		testEdit{"bob", "private", 2 * day},
+		testEdit{"alice", "private", 2*day + time.Hour},
+		testEdit{"bob", "private", 100 * day},
+		testEdit{"alice", "private", 100*day + time.Hour},
+	)
+	p := CompactionPolicy{RetainFor: 30 * day, RetainWindow: day, UserField: "user_id"}
+
+	removed, err := compactable(snaps, p, t0.Add(101*day))
+	require.NoError(t, err)
+	// recent edits are left alone
+	require.Equal(t, []string{"s1", "s3"}, removedIDs(snaps, removed))
+}
+
+// TestCompact runs against the Postgres database in
+// BIBLIO_BACKOFFICE_TEST_PG_CONN and is skipped if it isn't set
+func TestCompact(t *testing.T) {
+	conn := os.Getenv("BIBLIO_BACKOFFICE_TEST_PG_CONN")
+	if conn == "" {
+		t.Skip("BIBLIO_BACKOFFICE_TEST_PG_CONN is not set")
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, conn)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	_, err = pool.Exec(ctx, `create table snapstore_compact_test (
+		snapshot_id text primary key,
+		id text not null,
+		data jsonb not null,
+		date_from timestamptz not null default now(),
+		date_until timestamptz
+	)`)
+	require.NoError(t, err)
+	defer pool.Exec(ctx, "drop table if exists snapstore_compact_test")
+
+	snaps := testSnapshots(
+		testEdit{"alice", "private", 0},
+		testEdit{"alice", "private", 1 * time.Minute},
+		testEdit{"alice", "private", 2 * time.Minute},
+		testEdit{"bob", "public", 3 * time.Minute},
+	)
+	for _, snap := range snaps {
+		_, err := pool.Exec(ctx,
+			"insert into snapstore_compact_test (snapshot_id, id, data, date_from, date_until) values ($1, $2, $3, $4, $5)",
+			snap.SnapshotID, snap.ID, snap.Data, snap.DateFrom, snap.DateUntil,
+		)
+		require.NoError(t, err)
+	}
+
+	store := New(pool, []string{"snapstore_compact_test"}).Store("snapstore_compact_test")
+	p := CompactionPolicy{Window: 10 * time.Minute, UserField: "user_id", KeepFields: []string{"status"}}
+
+	p.DryRun = true
+	report, err := store.Compact(p, Options{})
+	require.NoError(t, err)
+	require.Equal(t, &CompactionReport{Records: 1, Snapshots: 4, Removed: 1}, report)
+
+	p.DryRun = false
+	report, err = store.Compact(p, Options{})
+	require.NoError(t, err)
+	require.Equal(t, &CompactionReport{Records: 1, Snapshots: 4, Removed: 1}, report)
+
+	history, err := store.history("1", Options{})
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, "s0", history[0].SnapshotID)
+	require.Equal(t, "s2", history[1].SnapshotID)
+	require.Equal(t, "s3", history[2].SnapshotID)
+	// the next kept snapshot covers the period of the removed one
+	require.True(t, history[0].DateUntil.Equal(*history[1].DateFrom))
+	require.True(t, history[1].DateFrom.Equal(t0.Add(1*time.Minute)))
+}
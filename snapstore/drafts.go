@@ -0,0 +1,125 @@
+package snapstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Draft is a pending working copy of a record. It is based on the snapshot
+// that was current when the draft was started and doesn't change the record
+// until it is published with AddAfter.
+type Draft struct {
+	ID             string
+	BaseSnapshotID string
+	Data           json.RawMessage
+	DateCreated    *time.Time
+	DateUpdated    *time.Time
+}
+
+func (d *Draft) Scan(data any) error {
+	return json.Unmarshal(d.Data, data)
+}
+
+func (s *Store) GetDraft(id string, o Options) (*Draft, error) {
+	ctx, db := s.ctxAndDb(o)
+
+	sql := `SELECT base_snapshot_id, data, date_created, date_updated FROM snapshot_drafts
+	WHERE store = $1 AND id = $2`
+
+	d := Draft{ID: id}
+
+	if err := db.QueryRow(ctx, sql, s.name, id).Scan(&d.BaseSnapshotID, &d.Data, &d.DateCreated, &d.DateUpdated); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// SaveDraft creates or replaces the draft of a record. snapshotID must be the
+// current snapshot of the record, otherwise a *Conflict is returned.
+func (s *Store) SaveDraft(snapshotID, id string, data any, o Options) error {
+	if snapshotID == "" {
+		return errors.New("snapshot id is empty")
+	}
+	if id == "" {
+		return errors.New("id is empty")
+	}
+
+	d, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	ctx, db := s.ctxAndDb(o)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var currentSnapshotID string
+
+	sqlCurrent := `SELECT snapshot_id FROM ` + s.table + `
+	WHERE id = $1 AND date_until IS NULL
+	FOR UPDATE`
+
+	if err := tx.QueryRow(ctx, sqlCurrent, id).Scan(&currentSnapshotID); err != nil {
+		return err
+	}
+
+	if currentSnapshotID != snapshotID {
+		return &Conflict{}
+	}
+
+	sqlUpsert := `INSERT INTO snapshot_drafts (store, id, base_snapshot_id, data) VALUES ($1, $2, $3, $4)
+	ON CONFLICT (store, id) DO UPDATE
+	SET base_snapshot_id = excluded.base_snapshot_id, data = excluded.data, date_updated = now()`
+
+	if _, err := tx.Exec(ctx, sqlUpsert, s.name, id, snapshotID, d); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) DeleteDraft(id string, o Options) error {
+	ctx, db := s.ctxAndDb(o)
+
+	sql := "DELETE FROM snapshot_drafts WHERE store = $1 AND id = $2"
+
+	_, err := db.Exec(ctx, sql, s.name, id)
+
+	return err
+}
+
+// Rebase applies the changes a draft made to its base snapshot to the current
+// snapshot, field by field. Fields changed by the draft take precedence over
+// fields that changed in the current snapshot.
+func Rebase(base, draft, current json.RawMessage) (json.RawMessage, error) {
+	var baseFields, draftFields, currentFields map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(draft, &draftFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(current, &currentFields); err != nil {
+		return nil, err
+	}
+
+	for k, v := range draftFields {
+		if !bytes.Equal(v, baseFields[k]) {
+			currentFields[k] = v
+		}
+	}
+	for k := range baseFields {
+		if _, ok := draftFields[k]; !ok {
+			delete(currentFields, k)
+		}
+	}
+
+	return json.Marshal(currentFields)
+}
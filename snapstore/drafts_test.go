@@ -0,0 +1,26 @@
+package snapstore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebase(t *testing.T) {
+	base := json.RawMessage(`{"title": "Old", "year": "2020", "locked": false, "note": "remove me"}`)
+	// the draft changes the title and removes the note
+	draft := json.RawMessage(`{"title": "New", "year": "2020", "locked": false}`)
+	// the record was locked and the year changed since the draft was started
+	current := json.RawMessage(`{"title": "Old", "year": "2021", "locked": true, "note": "remove me"}`)
+
+	data, err := Rebase(base, draft, current)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"title": "New", "year": "2021", "locked": true}`, string(data))
+
+	// fields changed by the draft win
+	current = json.RawMessage(`{"title": "Other", "year": "2020", "locked": false, "note": "remove me"}`)
+	data, err = Rebase(base, draft, current)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"title": "New", "year": "2020", "locked": false}`, string(data))
+}
@@ -0,0 +1,49 @@
+package syncing
+
+import (
+	"fmt"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/backends/datacite"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+// RegisterDatasetDOI mints a DOI for datasets without one, or updates the
+// metadata of a DOI that was minted by us before
+func RegisterDatasetDOI(services *backends.Services, baseURL string, d *models.Dataset) (string, error) {
+	doi := d.Identifiers.Get("DOI")
+
+	if doi != "" && !services.DOIService.Manages(doi) {
+		return "", fmt.Errorf("dataset %s already has doi %s", d.ID, doi)
+	}
+
+	if doi != "" {
+		return doi, upsertDatasetDOI(services, baseURL, d, doi)
+	}
+
+	doi = services.DOIService.NewDOI(d.ID)
+	if d.Identifiers == nil {
+		d.Identifiers = models.Values{}
+	}
+	d.Identifiers.Set("DOI", doi)
+
+	// storing the doi queues the dataset again, but we don't want to store a
+	// doi that isn't registered
+	if err := upsertDatasetDOI(services, baseURL, d, doi); err != nil {
+		return "", err
+	}
+
+	if err := services.Repo.UpdateDataset(d.SnapshotID, d, nil); err != nil {
+		return "", err
+	}
+
+	return doi, nil
+}
+
+func upsertDatasetDOI(services *backends.Services, baseURL string, d *models.Dataset, doi string) error {
+	metadata, err := datacite.NewEncoder(services.Repo, baseURL).EncodeDataset(d)
+	if err != nil {
+		return err
+	}
+	return services.DOIService.UpsertDOI(doi, baseURL+"/dataset/"+d.ID, metadata, d.Status == "public")
+}
@@ -0,0 +1,183 @@
+// Package syncing pushes publications and datasets to external services like
+// ORCID and DataCite in the background. Saving a record only queues a job in
+// the database, a slow or unavailable service never holds up the request or
+// transaction that changed the record.
+package syncing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	// running jobs older than this are picked up again
+	staleAfter = 10 * time.Minute
+
+	minBackoff = time.Minute
+	maxBackoff = 6 * time.Hour
+
+	maxAttempts = 10
+)
+
+// Backoff returns the time to wait after a failed attempt
+func Backoff(attempts int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+type Worker struct {
+	services     *backends.Services
+	logger       *slog.Logger
+	baseURL      string
+	pollInterval time.Duration
+}
+
+// NewWorker returns a worker that links records to their frontoffice page
+// at baseURL
+func NewWorker(services *backends.Services, logger *slog.Logger, baseURL string) *Worker {
+	return &Worker{
+		services:     services,
+		logger:       logger,
+		baseURL:      baseURL,
+		pollInterval: 5 * time.Second,
+	}
+}
+
+// Start runs due jobs until ctx is cancelled. It is safe to run a worker in
+// every server instance, a job is only claimed once.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			j, err := w.services.Repo.ClaimSyncJob(ctx, time.Now().Add(-staleAfter))
+			if err != nil {
+				w.logger.Error("syncing: claim failed", "error", err)
+				break
+			}
+			if j == nil {
+				break
+			}
+			w.Run(ctx, j)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run syncs a claimed job and records the outcome
+func (w *Worker) Run(ctx context.Context, j *models.SyncJob) {
+	err := w.sync(j)
+	if err == nil {
+		if err := w.services.Repo.CompleteSyncJob(ctx, j.Kind, j.RecordID); err != nil {
+			w.logger.Error("syncing: can't complete job", "kind", j.Kind, "id", j.RecordID, "error", err)
+		}
+		return
+	}
+
+	var nextAttempt *time.Time
+	if j.Attempts < maxAttempts {
+		t := time.Now().Add(Backoff(j.Attempts))
+		nextAttempt = &t
+		w.logger.Warn("syncing: sync failed, retrying", "kind", j.Kind, "id", j.RecordID, "attempts", j.Attempts, "error", err)
+	} else {
+		w.logger.Error("syncing: sync failed, giving up", "kind", j.Kind, "id", j.RecordID, "attempts", j.Attempts, "error", err)
+	}
+
+	if err := w.services.Repo.RetrySyncJob(ctx, j.Kind, j.RecordID, err, nextAttempt); err != nil {
+		w.logger.Error("syncing: can't record failed job", "kind", j.Kind, "id", j.RecordID, "error", err)
+	}
+}
+
+func (w *Worker) sync(j *models.SyncJob) error {
+	switch j.Kind {
+	case models.SyncORCIDWorks:
+		return w.syncORCIDWorks(j.RecordID)
+	case models.SyncDOI:
+		return w.syncDOI(j.RecordID)
+	default:
+		return fmt.Errorf("unknown sync job kind %q", j.Kind)
+	}
+}
+
+// syncORCIDWorks pushes the current version of a publication to the ORCID
+// records of its contributors and stores the resulting put codes in place.
+// Storing the put codes queues the publication once more, that pass finds
+// nothing left to change.
+func (w *Worker) syncORCIDWorks(id string) error {
+	if w.services.ORCIDWorkService == nil {
+		return errors.New("orcid work sync is disabled")
+	}
+
+	repo := w.services.Repo
+	p, err := repo.GetPublication(id)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if p.Status != "public" && len(p.ORCIDWork) == 0 {
+		return nil
+	}
+
+	changed, syncErr := w.services.ORCIDWorkService.SyncPublication(p)
+	if changed {
+		// the publication may have changed during the sync, only the put
+		// codes are ours to write
+		current, err := repo.GetPublication(id)
+		if err != nil {
+			return errors.Join(syncErr, err)
+		}
+		current.ORCIDWork = p.ORCIDWork
+		if err := repo.UpdatePublicationInPlace(current); err != nil {
+			return errors.Join(syncErr, err)
+		}
+	}
+	return syncErr
+}
+
+// syncDOI mints a DOI for a public dataset without one or sends the current
+// metadata of a DOI we manage. Minting is only queued when a dataset is
+// published.
+func (w *Worker) syncDOI(id string) error {
+	if w.services.DOIService == nil {
+		return errors.New("doi registration is disabled")
+	}
+
+	d, err := w.services.Repo.GetDataset(id)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	doi := d.Identifiers.Get("DOI")
+	if doi == "" && d.Status != "public" {
+		return nil
+	}
+	if doi != "" && !w.services.DOIService.Manages(doi) {
+		return nil
+	}
+
+	_, err = RegisterDatasetDOI(w.services, w.baseURL, d)
+	return err
+}
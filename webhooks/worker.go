@@ -0,0 +1,162 @@
+// Package webhooks delivers publication and dataset lifecycle events to
+// downstream systems. Deliveries are queued in the database by a repository
+// listener and sent in the background with retries. Delivery is at least once
+// after an event is queued, but an event can be lost if the process stops
+// between saving a record and queueing it.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ugent-library/biblio-backoffice/backends"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+const (
+	// the hex encoded HMAC-SHA256 of the request body, prefixed with sha256=
+	SignatureHeader = "X-Biblio-Signature"
+	EventHeader     = "X-Biblio-Event"
+	// the event id, the same for all endpoints and for retries
+	EventIDHeader = "X-Biblio-Event-Id"
+
+	// running deliveries older than this are picked up again
+	staleAfter = 5 * time.Minute
+
+	minBackoff = 30 * time.Second
+	maxBackoff = 6 * time.Hour
+)
+
+// Sign returns the signature of a request body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a request body, receivers can use it
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Backoff returns how long to wait after a failed attempt, doubling from 30
+// seconds up to 6 hours
+func Backoff(attempts int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+// Send posts a delivery and returns the response status. Responses outside
+// the 2xx range are an error.
+func Send(ctx context.Context, client *http.Client, secret string, d *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "biblio-backoffice")
+	req.Header.Set(EventHeader, d.Event)
+	req.Header.Set(EventIDHeader, d.EventID)
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, d.Payload))
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook %s responded with %s", d.URL, res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+type Worker struct {
+	services     *backends.Services
+	logger       *slog.Logger
+	secret       string
+	maxAttempts  int
+	client       *http.Client
+	pollInterval time.Duration
+}
+
+// NewWorker returns a worker that signs payloads with secret and gives up on
+// a delivery after maxAttempts
+func NewWorker(services *backends.Services, logger *slog.Logger, secret string, maxAttempts int) *Worker {
+	return &Worker{
+		services:     services,
+		logger:       logger,
+		secret:       secret,
+		maxAttempts:  maxAttempts,
+		client:       &http.Client{Timeout: 30 * time.Second},
+		pollInterval: 5 * time.Second,
+	}
+}
+
+// Start sends due deliveries until ctx is cancelled. It is safe to run a
+// worker in every server instance, a delivery is only claimed once.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			d, err := w.services.Repo.ClaimWebhookDelivery(ctx, time.Now().Add(-staleAfter))
+			if err != nil {
+				w.logger.Error("webhooks: claim failed", "error", err)
+				break
+			}
+			if d == nil {
+				break
+			}
+			w.Run(ctx, d)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run sends a claimed delivery and records the outcome
+func (w *Worker) Run(ctx context.Context, d *models.WebhookDelivery) {
+	status, err := Send(ctx, w.client, w.secret, d)
+	if err == nil {
+		if err := w.services.Repo.CompleteWebhookDelivery(ctx, d.ID, status); err != nil {
+			w.logger.Error("webhooks: can't mark delivery as delivered", "id", d.ID, "error", err)
+		}
+		return
+	}
+
+	var nextAttempt *time.Time
+	if d.Attempts < w.maxAttempts {
+		t := time.Now().Add(Backoff(d.Attempts))
+		nextAttempt = &t
+		w.logger.Warn("webhooks: delivery failed, retrying", "id", d.ID, "event", d.Event, "url", d.URL, "attempts", d.Attempts, "error", err)
+	} else {
+		w.logger.Error("webhooks: delivery failed, giving up", "id", d.ID, "event", d.Event, "url", d.URL, "attempts", d.Attempts, "error", err)
+	}
+
+	if err := w.services.Repo.RetryWebhookDelivery(ctx, d.ID, status, err, nextAttempt); err != nil {
+		w.logger.Error("webhooks: can't record failed delivery", "id", d.ID, "error", err)
+	}
+}
@@ -0,0 +1,55 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ugent-library/biblio-backoffice/models"
+)
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, Backoff(1))
+	require.Equal(t, time.Minute, Backoff(2))
+	require.Equal(t, 4*time.Minute, Backoff(4))
+	require.Equal(t, 6*time.Hour, Backoff(20))
+}
+
+func TestSend(t *testing.T) {
+	secret := "s3cr3t"
+
+	var gotBody []byte
+	var gotHeader http.Header
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header
+		if !Verify(secret, gotBody, r.Header.Get(SignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	d := &models.WebhookDelivery{
+		EventID: "01HX",
+		Event:   "publication.published",
+		URL:     receiver.URL,
+		Payload: []byte(`{"id":"01HX","event":"publication.published"}`),
+	}
+
+	status, err := Send(context.Background(), receiver.Client(), secret, d)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, status)
+	require.Equal(t, string(d.Payload), string(gotBody))
+	require.Equal(t, "publication.published", gotHeader.Get(EventHeader))
+	require.Equal(t, "01HX", gotHeader.Get(EventIDHeader))
+
+	status, err = Send(context.Background(), receiver.Client(), "wrong", d)
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, status)
+}